@@ -0,0 +1,19 @@
+-- In-app notifications. actor_id is the user who caused the event (NULL for
+-- system events such as reputation milestones); target_type/target_id point
+-- at the post the notification is about.
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    actor_id BIGINT NULL REFERENCES users(id) ON DELETE SET NULL,
+    type VARCHAR(32) NOT NULL CHECK (type IN ('answer', 'comment', 'mention', 'accepted_answer', 'reputation_milestone')),
+    target_type VARCHAR(16) NULL CHECK (target_type IN ('question', 'answer', 'comment')),
+    target_id BIGINT NULL,
+    excerpt TEXT NOT NULL DEFAULT '',
+    milestone INTEGER NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE NULL,
+    CHECK ((target_type IS NULL) = (target_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE email_outbox DROP COLUMN IF EXISTS unsubscribe_url;
+ALTER TABLE notifications DROP COLUMN IF EXISTS emailed_at;
+DROP TABLE IF EXISTS notification_digests;
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,24 @@
+-- How each user wants to receive each notification type. Types without a row
+-- use the default (in_app).
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL CHECK (type IN ('answer', 'comment', 'mention', 'accepted_answer', 'reputation_milestone')),
+    delivery VARCHAR(16) NOT NULL CHECK (delivery IN ('in_app', 'email', 'daily', 'weekly', 'off')),
+    PRIMARY KEY (user_id, type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_preferences_delivery ON notification_preferences (delivery);
+
+-- When each user last got a digest, so that with several instances running
+-- only one of them sends it.
+CREATE TABLE IF NOT EXISTS notification_digests (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    cadence VARCHAR(16) NOT NULL CHECK (cadence IN ('daily', 'weekly')),
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (user_id, cadence)
+);
+
+-- Set once a notification was emailed, instantly or in a digest.
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS emailed_at TIMESTAMP WITH TIME ZONE NULL;
+
+ALTER TABLE email_outbox ADD COLUMN IF NOT EXISTS unsubscribe_url TEXT NULL;
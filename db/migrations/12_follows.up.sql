@@ -0,0 +1,33 @@
+-- What each user follows. target_id is the ID of the question, tag, category
+-- or user named by target_type.
+CREATE TABLE IF NOT EXISTS follows (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'tag', 'category', 'user')),
+    target_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    PRIMARY KEY (user_id, target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_follows_target ON follows (target_type, target_id);
+
+-- New content for feeds. type is the kind of post created and post_id its ID.
+CREATE TABLE IF NOT EXISTS activities (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id BIGINT NULL REFERENCES users(id) ON DELETE SET NULL,
+    type VARCHAR(16) NOT NULL CHECK (type IN ('question', 'answer', 'comment')),
+    post_id BIGINT NOT NULL,
+    question_id BIGINT NOT NULL,
+    excerpt TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Everything an activity happened in (its author, question, categories and
+-- tags), matched against follows to build feeds.
+CREATE TABLE IF NOT EXISTS activity_targets (
+    activity_id BIGINT NOT NULL REFERENCES activities(id) ON DELETE CASCADE,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'tag', 'category', 'user')),
+    target_id BIGINT NOT NULL,
+    PRIMARY KEY (activity_id, target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_activity_targets_target ON activity_targets (target_type, target_id, activity_id DESC);
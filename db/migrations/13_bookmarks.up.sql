@@ -0,0 +1,25 @@
+-- Named folders for bookmarks. Private unless is_public is set, in which case
+-- anyone with the ID can view the collection.
+CREATE TABLE IF NOT EXISTS bookmark_collections (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    UNIQUE (user_id, name)
+);
+
+-- Saved questions and answers. Bookmarks outside any collection have a NULL
+-- collection_id; position orders them within their collection.
+CREATE TABLE IF NOT EXISTS bookmarks (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    collection_id BIGINT NULL REFERENCES bookmark_collections(id) ON DELETE SET NULL,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'answer')),
+    target_id BIGINT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    UNIQUE (user_id, target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmarks_collection ON bookmarks (user_id, collection_id, position);
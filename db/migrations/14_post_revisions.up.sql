@@ -0,0 +1,15 @@
+-- Every saved version of a question or answer, numbered from 1 per post.
+-- title is only set for questions.
+CREATE TABLE IF NOT EXISTS post_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'answer')),
+    target_id BIGINT NOT NULL,
+    revision INTEGER NOT NULL,
+    author_id BIGINT NULL REFERENCES users(id) ON DELETE SET NULL,
+    title VARCHAR(255) NULL,
+    body TEXT NOT NULL,
+    summary VARCHAR(300) NOT NULL DEFAULT '',
+    rollback_of INTEGER NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    UNIQUE (target_type, target_id, revision)
+);
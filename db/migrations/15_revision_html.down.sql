@@ -0,0 +1,2 @@
+ALTER TABLE post_revisions DROP COLUMN IF EXISTS render_version;
+ALTER TABLE post_revisions DROP COLUMN IF EXISTS body_html;
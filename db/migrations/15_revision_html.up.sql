@@ -0,0 +1,5 @@
+-- Rendered, sanitized HTML of body, cached next to the Markdown source.
+-- render_version is the renderer version that produced it; older versions
+-- are rendered again on read.
+ALTER TABLE post_revisions ADD COLUMN IF NOT EXISTS body_html TEXT NOT NULL DEFAULT '';
+ALTER TABLE post_revisions ADD COLUMN IF NOT EXISTS render_version INTEGER NOT NULL DEFAULT 0;
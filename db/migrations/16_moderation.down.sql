@@ -0,0 +1,21 @@
+DELETE FROM notifications WHERE type = 'moderator_warning';
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('answer', 'comment', 'mention', 'accepted_answer', 'reputation_milestone'));
+
+DELETE FROM notification_preferences WHERE type = 'moderator_warning';
+ALTER TABLE notification_preferences DROP CONSTRAINT IF EXISTS notification_preferences_type_check;
+ALTER TABLE notification_preferences ADD CONSTRAINT notification_preferences_type_check
+    CHECK (type IN ('answer', 'comment', 'mention', 'accepted_answer', 'reputation_milestone'));
+
+DROP TABLE IF EXISTS flags;
+DROP TABLE IF EXISTS moderation_actions;
+
+-- Enum values cannot be dropped, so the type is recreated without moderator.
+UPDATE users SET role = 'user' WHERE role = 'moderator';
+ALTER TYPE user_role RENAME TO user_role_old;
+CREATE TYPE user_role AS ENUM ('user', 'admin');
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE user_role USING role::text::user_role;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'user';
+DROP TYPE user_role_old;
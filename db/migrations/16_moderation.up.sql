@@ -0,0 +1,41 @@
+ALTER TYPE user_role ADD VALUE IF NOT EXISTS 'moderator';
+
+-- What a moderator did about a post and why. user_id is the user a warning
+-- went to.
+CREATE TABLE IF NOT EXISTS moderation_actions (
+    id BIGSERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'answer', 'comment')),
+    target_id BIGINT NOT NULL,
+    moderator_id BIGINT NULL REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('dismiss', 'delete', 'lock', 'warn')),
+    reason VARCHAR(500) NOT NULL,
+    user_id BIGINT NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_actions_target ON moderation_actions (target_type, target_id);
+
+-- Reports of posts that break the rules, one per reporter and post. A flag
+-- is pending until a moderation action resolves it.
+CREATE TABLE IF NOT EXISTS flags (
+    id BIGSERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'answer', 'comment')),
+    target_id BIGINT NOT NULL,
+    reporter_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(16) NOT NULL CHECK (reason IN ('spam', 'offensive', 'duplicate', 'low_quality')),
+    details VARCHAR(500) NOT NULL DEFAULT '',
+    action_id BIGINT NULL REFERENCES moderation_actions(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE NULL,
+    UNIQUE (target_type, target_id, reporter_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_flags_pending ON flags (target_type, target_id) WHERE resolved_at IS NULL;
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('answer', 'comment', 'mention', 'accepted_answer', 'reputation_milestone', 'moderator_warning'));
+
+ALTER TABLE notification_preferences DROP CONSTRAINT IF EXISTS notification_preferences_type_check;
+ALTER TABLE notification_preferences ADD CONSTRAINT notification_preferences_type_check
+    CHECK (type IN ('answer', 'comment', 'mention', 'accepted_answer', 'reputation_milestone', 'moderator_warning'));
@@ -0,0 +1,38 @@
+-- Everything a role can be allowed to do. Code checks permissions by name.
+CREATE TABLE IF NOT EXISTS permissions (
+    name VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role user_role NOT NULL,
+    permission VARCHAR(64) NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO permissions (name, description) VALUES
+    ('category:write', 'Create and edit categories'),
+    ('flag:review', 'See the moderation queue and dismiss flags'),
+    ('post:delete', 'Delete posts of other users'),
+    ('post:lock', 'Lock posts'),
+    ('post:rollback', 'Roll back posts of other users'),
+    ('user:warn', 'Warn users about their posts'),
+    ('user:ban', 'Ban users'),
+    ('role:assign', 'Assign roles and change role permissions')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('moderator', 'flag:review'),
+    ('moderator', 'post:delete'),
+    ('moderator', 'post:lock'),
+    ('moderator', 'post:rollback'),
+    ('moderator', 'user:warn'),
+    ('admin', 'category:write'),
+    ('admin', 'flag:review'),
+    ('admin', 'post:delete'),
+    ('admin', 'post:lock'),
+    ('admin', 'post:rollback'),
+    ('admin', 'user:warn'),
+    ('admin', 'user:ban'),
+    ('admin', 'role:assign')
+ON CONFLICT (role, permission) DO NOTHING;
@@ -0,0 +1,2 @@
+-- The renamed logins are kept; there is nothing to undo.
+SELECT 1;
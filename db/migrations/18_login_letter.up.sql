@@ -0,0 +1,4 @@
+-- Logins now need at least one letter so they never look like a user ID.
+-- Logins made only of digits could not be looked up by login anyway, since
+-- /api/users/{id|login} reads digits as an ID.
+UPDATE users SET login = 'member' || id WHERE login !~ '[A-Za-z]';
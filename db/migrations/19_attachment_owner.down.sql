@@ -0,0 +1,5 @@
+DELETE FROM attachments WHERE user_id IS NULL;
+ALTER TABLE attachments DROP CONSTRAINT IF EXISTS attachments_user_id_fkey;
+ALTER TABLE attachments ALTER COLUMN user_id SET NOT NULL;
+ALTER TABLE attachments ADD CONSTRAINT attachments_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
@@ -0,0 +1,8 @@
+-- Deleting a user used to cascade to their attachment rows and leave the
+-- stored objects behind. The rows now lose their owner instead, and the
+-- garbage collector deletes unreferenced ownerless uploads together with
+-- their objects right away.
+ALTER TABLE attachments DROP CONSTRAINT IF EXISTS attachments_user_id_fkey;
+ALTER TABLE attachments ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE attachments ADD CONSTRAINT attachments_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL;
@@ -0,0 +1,7 @@
+-- Unsubscribe links carry the version current when they were sent; raising
+-- it invalidates every link already emailed. Users without a row are at
+-- version 0.
+CREATE TABLE IF NOT EXISTS unsubscribe_versions (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL DEFAULT 0
+);
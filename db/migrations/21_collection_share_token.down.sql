@@ -0,0 +1,2 @@
+ALTER TABLE bookmark_collections DROP CONSTRAINT IF EXISTS bookmark_collections_share_token_key;
+ALTER TABLE bookmark_collections DROP COLUMN IF EXISTS share_token;
@@ -0,0 +1,9 @@
+-- Public collections are shared by a random token instead of their
+-- sequential ID, which anyone could enumerate. The token is set while a
+-- collection is public and cleared when it becomes private again.
+ALTER TABLE bookmark_collections ADD COLUMN IF NOT EXISTS share_token VARCHAR(32) NULL;
+ALTER TABLE bookmark_collections ADD CONSTRAINT bookmark_collections_share_token_key UNIQUE (share_token);
+
+UPDATE bookmark_collections
+SET share_token = replace(gen_random_uuid()::text, '-', '')
+WHERE is_public AND share_token IS NULL;
@@ -0,0 +1,3 @@
+-- A banned user cannot sign in or use the permissions of their role. The
+-- column holds when the ban started and is cleared when it is lifted.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned_at TIMESTAMPTZ NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE activity_targets DROP CONSTRAINT IF EXISTS activity_targets_target_type_check;
+ALTER TABLE activity_targets ADD CONSTRAINT activity_targets_target_type_check
+    CHECK (target_type IN ('question', 'tag', 'category', 'user'));
+
+ALTER TABLE follows DROP CONSTRAINT IF EXISTS follows_target_type_check;
+ALTER TABLE follows ADD CONSTRAINT follows_target_type_check
+    CHECK (target_type IN ('question', 'tag', 'category', 'user'));
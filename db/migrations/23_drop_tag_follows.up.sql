@@ -0,0 +1,11 @@
+-- There is no tags table to follow, so tag follows pointed at nothing.
+DELETE FROM follows WHERE target_type = 'tag';
+DELETE FROM activity_targets WHERE target_type = 'tag';
+
+ALTER TABLE follows DROP CONSTRAINT IF EXISTS follows_target_type_check;
+ALTER TABLE follows ADD CONSTRAINT follows_target_type_check
+    CHECK (target_type IN ('question', 'category', 'user'));
+
+ALTER TABLE activity_targets DROP CONSTRAINT IF EXISTS activity_targets_target_type_check;
+ALTER TABLE activity_targets ADD CONSTRAINT activity_targets_target_type_check
+    CHECK (target_type IN ('question', 'category', 'user'));
@@ -0,0 +1,22 @@
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS title VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS body TEXT NOT NULL DEFAULT '';
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL;
+
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS body TEXT NOT NULL DEFAULT '';
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL;
+
+UPDATE questions q
+SET title = COALESCE(r.title, ''), body = r.body, updated_at = r.created_at
+FROM post_revisions r
+WHERE r.target_type = 'question' AND r.target_id = q.id
+  AND r.revision = (SELECT MAX(revision) FROM post_revisions WHERE target_type = 'question' AND target_id = q.id);
+
+UPDATE answers a
+SET body = r.body, updated_at = r.created_at
+FROM post_revisions r
+WHERE r.target_type = 'answer' AND r.target_id = a.id
+  AND r.revision = (SELECT MAX(revision) FROM post_revisions WHERE target_type = 'answer' AND target_id = a.id);
+
+ALTER TABLE questions ALTER COLUMN title DROP DEFAULT;
+ALTER TABLE questions ALTER COLUMN body DROP DEFAULT;
+ALTER TABLE answers ALTER COLUMN body DROP DEFAULT;
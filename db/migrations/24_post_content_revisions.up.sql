@@ -0,0 +1,22 @@
+-- A post's title and body are now its latest revision in post_revisions.
+-- Posts saved before revisions were recorded get theirs as revision 1.
+INSERT INTO post_revisions (target_type, target_id, revision, author_id, title, body, created_at)
+SELECT 'question', q.id, 1, q.author_id, q.title, q.body, q.updated_at
+FROM questions q
+WHERE NOT EXISTS (
+    SELECT 1 FROM post_revisions r WHERE r.target_type = 'question' AND r.target_id = q.id
+);
+
+INSERT INTO post_revisions (target_type, target_id, revision, author_id, body, created_at)
+SELECT 'answer', a.id, 1, a.author_id, a.body, a.updated_at
+FROM answers a
+WHERE NOT EXISTS (
+    SELECT 1 FROM post_revisions r WHERE r.target_type = 'answer' AND r.target_id = a.id
+);
+
+ALTER TABLE questions DROP COLUMN IF EXISTS title;
+ALTER TABLE questions DROP COLUMN IF EXISTS body;
+ALTER TABLE questions DROP COLUMN IF EXISTS updated_at;
+
+ALTER TABLE answers DROP COLUMN IF EXISTS body;
+ALTER TABLE answers DROP COLUMN IF EXISTS updated_at;
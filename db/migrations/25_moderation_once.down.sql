@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_moderation_actions_once;
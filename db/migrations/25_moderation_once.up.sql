@@ -0,0 +1,22 @@
+-- A post is deleted or locked at most once. Duplicates left by moderators
+-- acting at the same time are folded into the first action.
+WITH ranked AS (
+    SELECT id, MIN(id) OVER (PARTITION BY target_type, target_id, action) AS first_id
+    FROM moderation_actions
+    WHERE action IN ('delete', 'lock')
+)
+UPDATE flags SET action_id = ranked.first_id
+FROM ranked
+WHERE flags.action_id = ranked.id AND ranked.id <> ranked.first_id;
+
+DELETE FROM moderation_actions later
+USING moderation_actions earlier
+WHERE later.action IN ('delete', 'lock')
+  AND later.target_type = earlier.target_type
+  AND later.target_id = earlier.target_id
+  AND later.action = earlier.action
+  AND later.id > earlier.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_moderation_actions_once
+    ON moderation_actions (target_type, target_id, action)
+    WHERE action IN ('delete', 'lock');
@@ -0,0 +1,7 @@
+ALTER TABLE notifications DROP COLUMN IF EXISTS question_id, DROP COLUMN IF EXISTS answer_id, DROP COLUMN IF EXISTS comment_id;
+ALTER TABLE flags DROP COLUMN IF EXISTS question_id, DROP COLUMN IF EXISTS answer_id, DROP COLUMN IF EXISTS comment_id;
+ALTER TABLE post_revisions DROP COLUMN IF EXISTS question_id, DROP COLUMN IF EXISTS answer_id;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS question_id, DROP COLUMN IF EXISTS answer_id;
+DROP INDEX IF EXISTS idx_activities_question;
+ALTER TABLE activities DROP CONSTRAINT IF EXISTS activities_question_id_fkey, DROP COLUMN IF EXISTS answer_id, DROP COLUMN IF EXISTS comment_id;
+ALTER TABLE follows DROP COLUMN IF EXISTS question_id;
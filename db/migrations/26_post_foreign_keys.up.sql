@@ -0,0 +1,88 @@
+-- Rows that point at a question, answer or comment go away with it. As for
+-- comments and votes, generated columns repeat target_id for the matching
+-- target_type so that a foreign key can cascade. Moderation actions are kept
+-- as the record of what moderators did.
+DELETE FROM follows f
+WHERE f.target_type = 'question' AND NOT EXISTS (SELECT 1 FROM questions q WHERE q.id = f.target_id);
+
+ALTER TABLE follows
+    ADD COLUMN IF NOT EXISTS question_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'question' THEN target_id END) STORED
+        REFERENCES questions(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_follows_question ON follows (question_id);
+
+DELETE FROM activities a
+WHERE NOT EXISTS (SELECT 1 FROM questions q WHERE q.id = a.question_id)
+   OR (a.type = 'answer' AND NOT EXISTS (SELECT 1 FROM answers p WHERE p.id = a.post_id))
+   OR (a.type = 'comment' AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.id = a.post_id));
+
+ALTER TABLE activities
+    ADD CONSTRAINT activities_question_id_fkey FOREIGN KEY (question_id) REFERENCES questions(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS answer_id BIGINT GENERATED ALWAYS AS (CASE WHEN type = 'answer' THEN post_id END) STORED
+        REFERENCES answers(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS comment_id BIGINT GENERATED ALWAYS AS (CASE WHEN type = 'comment' THEN post_id END) STORED
+        REFERENCES comments(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_activities_question ON activities (question_id);
+CREATE INDEX IF NOT EXISTS idx_activities_answer ON activities (answer_id);
+CREATE INDEX IF NOT EXISTS idx_activities_comment ON activities (comment_id);
+
+DELETE FROM bookmarks b
+WHERE (b.target_type = 'question' AND NOT EXISTS (SELECT 1 FROM questions q WHERE q.id = b.target_id))
+   OR (b.target_type = 'answer' AND NOT EXISTS (SELECT 1 FROM answers p WHERE p.id = b.target_id));
+
+ALTER TABLE bookmarks
+    ADD COLUMN IF NOT EXISTS question_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'question' THEN target_id END) STORED
+        REFERENCES questions(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS answer_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'answer' THEN target_id END) STORED
+        REFERENCES answers(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_bookmarks_question ON bookmarks (question_id);
+CREATE INDEX IF NOT EXISTS idx_bookmarks_answer ON bookmarks (answer_id);
+
+DELETE FROM post_revisions r
+WHERE (r.target_type = 'question' AND NOT EXISTS (SELECT 1 FROM questions q WHERE q.id = r.target_id))
+   OR (r.target_type = 'answer' AND NOT EXISTS (SELECT 1 FROM answers p WHERE p.id = r.target_id));
+
+ALTER TABLE post_revisions
+    ADD COLUMN IF NOT EXISTS question_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'question' THEN target_id END) STORED
+        REFERENCES questions(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS answer_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'answer' THEN target_id END) STORED
+        REFERENCES answers(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_post_revisions_question ON post_revisions (question_id);
+CREATE INDEX IF NOT EXISTS idx_post_revisions_answer ON post_revisions (answer_id);
+
+DELETE FROM flags f
+WHERE (f.target_type = 'question' AND NOT EXISTS (SELECT 1 FROM questions q WHERE q.id = f.target_id))
+   OR (f.target_type = 'answer' AND NOT EXISTS (SELECT 1 FROM answers p WHERE p.id = f.target_id))
+   OR (f.target_type = 'comment' AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.id = f.target_id));
+
+ALTER TABLE flags
+    ADD COLUMN IF NOT EXISTS question_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'question' THEN target_id END) STORED
+        REFERENCES questions(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS answer_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'answer' THEN target_id END) STORED
+        REFERENCES answers(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS comment_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'comment' THEN target_id END) STORED
+        REFERENCES comments(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_flags_question ON flags (question_id);
+CREATE INDEX IF NOT EXISTS idx_flags_answer ON flags (answer_id);
+CREATE INDEX IF NOT EXISTS idx_flags_comment ON flags (comment_id);
+
+DELETE FROM notifications n
+WHERE (n.target_type = 'question' AND NOT EXISTS (SELECT 1 FROM questions q WHERE q.id = n.target_id))
+   OR (n.target_type = 'answer' AND NOT EXISTS (SELECT 1 FROM answers p WHERE p.id = n.target_id))
+   OR (n.target_type = 'comment' AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.id = n.target_id));
+
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS question_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'question' THEN target_id END) STORED
+        REFERENCES questions(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS answer_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'answer' THEN target_id END) STORED
+        REFERENCES answers(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS comment_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'comment' THEN target_id END) STORED
+        REFERENCES comments(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_question ON notifications (question_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_answer ON notifications (answer_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_comment ON notifications (comment_id);
@@ -0,0 +1,5 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS has_password BOOLEAN DEFAULT TRUE NOT NULL;
+
+-- Accounts created through Google sign-in use their email as login (registration
+-- only allows alphanumeric logins) and were given a random password nobody knows.
+UPDATE users SET has_password = FALSE WHERE google_id IS NOT NULL AND login = email;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS login_history;
+ALTER TABLE users DROP COLUMN IF EXISTS login_changed_at;
+DROP INDEX IF EXISTS users_login_lower_key;
@@ -0,0 +1,25 @@
+-- Google sign-in used to copy the email into login; derive an alphanumeric
+-- login from the local part instead, suffixed with the id to keep it unique.
+UPDATE users
+SET login = LEFT(regexp_replace(split_part(email, '@', 1), '[^A-Za-z0-9]', '', 'g'), 12) || id
+WHERE login LIKE '%@%';
+
+-- Keep the oldest account on each case-insensitive login, suffix the others.
+UPDATE users u
+SET login = LEFT(u.login, 12) || u.id
+FROM users older
+WHERE lower(older.login) = lower(u.login)
+  AND older.id < u.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_login_lower_key ON users (lower(login));
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS login_changed_at TIMESTAMP WITH TIME ZONE NULL;
+
+CREATE TABLE IF NOT EXISTS login_history (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    login VARCHAR(255) NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_history_login ON login_history (lower(login));
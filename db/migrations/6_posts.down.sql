@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS answers;
+DROP TABLE IF EXISTS question_categories;
+DROP TABLE IF EXISTS questions;
@@ -0,0 +1,22 @@
+-- Images uploaded for questions and answers. target_type/target_id stay NULL
+-- until a saved post references the upload; unreferenced rows older than the
+-- configured TTL are garbage-collected together with their stored object.
+CREATE TABLE IF NOT EXISTS attachments (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    storage_key VARCHAR(255) UNIQUE NOT NULL,
+    url TEXT NOT NULL,
+    content_type VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    target_type VARCHAR(16) NULL CHECK (target_type IN ('question', 'answer')),
+    target_id BIGINT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    attached_at TIMESTAMP WITH TIME ZONE NULL,
+    CHECK ((target_type IS NULL) = (target_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_user_id ON attachments (user_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_target ON attachments (target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_orphaned ON attachments (created_at) WHERE target_id IS NULL;
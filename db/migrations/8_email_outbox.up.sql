@@ -0,0 +1,19 @@
+-- Rendered emails waiting for delivery. The worker leases due rows by moving
+-- next_attempt_at forward, so a crashed worker's rows are retried after the
+-- lease. Rows that exhaust their attempts are kept with status 'dead'.
+CREATE TABLE IF NOT EXISTS email_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    recipient VARCHAR(255) NOT NULL,
+    subject TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    status VARCHAR(16) DEFAULT 'pending' NOT NULL CHECK (status IN ('pending', 'sent', 'dead')),
+    attempts INTEGER DEFAULT 0 NOT NULL,
+    last_error TEXT NULL,
+    next_attempt_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_outbox_due ON email_outbox (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_email_outbox_sent_at ON email_outbox (sent_at) WHERE status = 'sent';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS votes;
+ALTER TABLE questions DROP COLUMN IF EXISTS accepted_answer_id;
+DROP TABLE IF EXISTS comments;
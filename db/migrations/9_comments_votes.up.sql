@@ -0,0 +1,41 @@
+-- Comments are left under a question or an answer. question_id and
+-- answer_id repeat target_id for the matching target_type so that comments
+-- go away with their post.
+CREATE TABLE IF NOT EXISTS comments (
+    id BIGSERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'answer')),
+    target_id BIGINT NOT NULL,
+    author_id BIGINT NULL REFERENCES users(id) ON DELETE SET NULL,
+    body VARCHAR(600) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    question_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'question' THEN target_id END) STORED
+        REFERENCES questions(id) ON DELETE CASCADE,
+    answer_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'answer' THEN target_id END) STORED
+        REFERENCES answers(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_target ON comments (target_type, target_id, id);
+CREATE INDEX IF NOT EXISTS idx_comments_author ON comments (author_id);
+CREATE INDEX IF NOT EXISTS idx_comments_question ON comments (question_id);
+CREATE INDEX IF NOT EXISTS idx_comments_answer ON comments (answer_id);
+
+ALTER TABLE questions
+    ADD COLUMN IF NOT EXISTS accepted_answer_id BIGINT NULL REFERENCES answers(id) ON DELETE SET NULL;
+
+-- One vote per user and post. The author's rating moves with every vote.
+CREATE TABLE IF NOT EXISTS votes (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('question', 'answer')),
+    target_id BIGINT NOT NULL,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    question_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'question' THEN target_id END) STORED
+        REFERENCES questions(id) ON DELETE CASCADE,
+    answer_id BIGINT GENERATED ALWAYS AS (CASE WHEN target_type = 'answer' THEN target_id END) STORED
+        REFERENCES answers(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_votes_target ON votes (target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_votes_question ON votes (question_id);
+CREATE INDEX IF NOT EXISTS idx_votes_answer ON votes (answer_id);
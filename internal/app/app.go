@@ -4,14 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
 	"github.com/RofaBR/Go-Usof/internal/handler"
+	"github.com/RofaBR/Go-Usof/internal/mail"
+	"github.com/RofaBR/Go-Usof/internal/mail/transport"
+	"github.com/RofaBR/Go-Usof/internal/markdown"
 	"github.com/RofaBR/Go-Usof/internal/middleware"
 	"github.com/RofaBR/Go-Usof/internal/repositories"
 	"github.com/RofaBR/Go-Usof/internal/router"
 	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/internal/storage/blob"
 	"github.com/RofaBR/Go-Usof/internal/storage/postgres"
 	"github.com/RofaBR/Go-Usof/internal/storage/redis"
 	"github.com/RofaBR/Go-Usof/pkg/logger"
@@ -25,6 +32,10 @@ type App struct {
 	server *http.Server
 	db     *postgres.Postgres
 	redis  *redis.Redis
+	mailer domain.MailTransport
+
+	// stopWorkers cancels the background jobs started in New.
+	stopWorkers context.CancelFunc
 }
 
 func New() (*App, error) {
@@ -51,15 +62,87 @@ func New() (*App, error) {
 	log.Info("Initializing repositories")
 	repos := repositories.NewRepository(db, redisClient)
 
+	log.Info("initializing object storage", "driver", cfg.Storage.Driver)
+	store, err := blob.New(context.Background(), cfg.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize storage: %w", err)
+	}
+
+	log.Info("loading email templates")
+	renderer, err := mail.NewRenderer(cfg.Sender.FromName, cfg.BaseURL, cfg.Sender.DefaultLocale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load email templates: %w", err)
+	}
+
+	log.Info("initializing markdown renderer", "highlight_style", cfg.Markdown.HighlightStyle)
+	md, err := markdown.New(cfg.Markdown.HighlightStyle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize markdown renderer: %w", err)
+	}
+
+	log.Info("initializing mail transport", "transport", cfg.Sender.Transport)
+	mailer, err := transport.New(cfg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize mail transport: %w", err)
+	}
+
 	log.Info("Initializing services")
-	svc := services.NewServices(log, repos, cfg)
+	svc := services.NewServices(log, repos, store, mailer, renderer, md, cfg)
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	go svc.Attachment.RunGarbageCollector(workersCtx)
+	go svc.MailOutbox.Run(workersCtx)
+	go svc.Hub.Run(workersCtx)
+	go svc.NotificationEmail.RunDigests(workersCtx)
 
 	log.Info("initializing handlers")
-	handlers := handler.NewHandler(log, svc)
+	handlers := handler.NewHandler(log, svc, cfg)
 
 	gin.SetMode(cfg.Mode)
-	authMW := middleware.AuthMiddleware(svc.Token)
-	r := router.SetupRouter(log, handlers, authMW)
+	mw := &router.Middlewares{
+		CORS:         middleware.CORSMiddleware(cfg.CORS),
+		Auth:         middleware.AuthMiddleware(svc.Token),
+		StreamAuth:   middleware.StreamAuthMiddleware(svc.Token),
+		OptionalAuth: middleware.OptionalAuthMiddleware(svc.Token),
+		CSRF:         middleware.CSRFMiddleware(),
+		Locale:       middleware.LocaleMiddleware(renderer.Locales()),
+		Permission: func(permission string) gin.HandlerFunc {
+			return middleware.RequirePermission(svc.Permission, permission)
+		},
+	}
+	if cfg.RateLimit.Enabled {
+		var limiter middleware.RateLimiter
+		if cfg.RateLimit.Backend == "memory" {
+			memory := middleware.NewMemoryRateLimiter()
+			go memory.RunJanitor(workersCtx, time.Minute)
+			limiter = memory
+		} else {
+			limiter = middleware.NewRedisRateLimiter(redisClient.Client)
+		}
+		mw.AuthLimit = middleware.RateLimitMiddleware(limiter, middleware.RateLimitPolicy{
+			Name:   "auth",
+			Limit:  cfg.RateLimit.AuthLimit,
+			Window: time.Duration(cfg.RateLimit.AuthWindow) * time.Second,
+		}, log)
+		mw.APILimit = middleware.MethodRateLimitMiddleware(limiter,
+			middleware.RateLimitPolicy{
+				Name:   "read",
+				Limit:  cfg.RateLimit.ReadLimit,
+				Window: time.Duration(cfg.RateLimit.ReadWindow) * time.Second,
+			},
+			middleware.RateLimitPolicy{
+				Name:   "write",
+				Limit:  cfg.RateLimit.WriteLimit,
+				Window: time.Duration(cfg.RateLimit.WriteWindow) * time.Second,
+			},
+			log,
+		)
+	}
+	var mediaDir string
+	if cfg.Storage.Driver == "local" {
+		mediaDir = cfg.Storage.LocalDir
+	}
+	r := router.SetupRouter(log, handlers, mw, mediaDir)
 
 	server := &http.Server{
 		Addr:    ":" + cfg.Port,
@@ -73,9 +156,25 @@ func New() (*App, error) {
 		server: server,
 		db:     db,
 		redis:  redisClient,
+		mailer: mailer,
+
+		stopWorkers: stopWorkers,
 	}, nil
 }
 
+// Handler returns the HTTP handler serving the API, so tests can call it
+// without listening on a port.
+func (a *App) Handler() http.Handler {
+	return a.router
+}
+
+// Mailer returns the transport emails are delivered through. With
+// MAIL_TRANSPORT=memory it is a *transport.Capture whose emails tests can
+// read, e.g. to follow a verification link.
+func (a *App) Mailer() domain.MailTransport {
+	return a.mailer
+}
+
 func (a *App) Run() error {
 	a.logger.Info("starting server",
 		"port", a.config.Port,
@@ -96,6 +195,16 @@ func (a *App) Shutdown(ctx context.Context) error {
 		return fmt.Errorf("server shutdown error: %w", err)
 	}
 
+	a.logger.Info("stopping background workers")
+	a.stopWorkers()
+
+	if closer, ok := a.mailer.(io.Closer); ok {
+		a.logger.Info("closing mail transport")
+		if err := closer.Close(); err != nil {
+			a.logger.Error("failed to close mail transport", "error", err)
+		}
+	}
+
 	a.logger.Info("closing database connection")
 	a.db.Close()
 
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/mail/transport"
+)
+
+// newTestApp starts the application against the database and Redis from the
+// environment. It needs migrated services, so it only runs when
+// USOF_INTEGRATION is set.
+func newTestApp(t *testing.T) *App {
+	t.Helper()
+	if os.Getenv("USOF_INTEGRATION") == "" {
+		t.Skip("set USOF_INTEGRATION=1 with DATABASE_URL and REDIS_* pointing at migrated services")
+	}
+	t.Setenv("MAIL_TRANSPORT", "memory")
+	t.Setenv("MAIL_OUTBOX_POLL_INTERVAL", "1")
+	t.Setenv("RATE_LIMIT_ENABLED", "false")
+
+	a, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		a.Shutdown(ctx)
+	})
+	return a
+}
+
+func TestRegisterSendsVerificationLink(t *testing.T) {
+	a := newTestApp(t)
+	capture, ok := a.Mailer().(*transport.Capture)
+	if !ok {
+		t.Fatalf("mailer is %T, want *transport.Capture", a.Mailer())
+	}
+
+	suffix := time.Now().UnixNano()
+	email := fmt.Sprintf("it%d@example.com", suffix)
+	body, _ := json.Marshal(map[string]string{
+		"login":    fmt.Sprintf("it%d", suffix%1_000_000_000),
+		"email":    email,
+		"password": "Correct-Horse-42-battery",
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/register", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	a.Handler().ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("register: status %d: %s", w.Code, w.Body)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	msg, err := capture.WaitFor(ctx, email)
+	if err != nil {
+		t.Fatalf("no verification email: %v", err)
+	}
+
+	var link string
+	for _, l := range transport.Links(msg) {
+		if strings.Contains(l, "/api/auth/verify?") {
+			link = l
+		}
+	}
+	if link == "" {
+		t.Fatalf("verification email has no verify link: %s", msg.Text)
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("bad link %q: %v", link, err)
+	}
+
+	w = httptest.NewRecorder()
+	a.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("verify: status %d: %s", w.Code, w.Body)
+	}
+}
@@ -1,23 +1,40 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
 
 type Config struct {
-	Port          string       `validate:"required"`
-	LogLevel      string       `validate:"required,oneof=debug info warn error"`
-	Mode          string       `validate:"required,oneof=debug release test"`
-	DatabaseURL   string       `validate:"required"`
-	Redis         RedisConfig  `validate:"required"`
-	JWT           JWTConfig    `validate:"required"`
-	Sender        SenderConfig `validate:"required"`
-	CloudinaryURL string       `validate:"required"`
-	OAuth2        OAuth2Config `validate:"required"`
+	Port         string               `validate:"required"`
+	BaseURL      string               `validate:"required,url"` // public URL of the API, without /api
+	LogLevel     string               `validate:"required,oneof=debug info warn error"`
+	Mode         string               `validate:"required,oneof=debug release test"`
+	DatabaseURL  string               `validate:"required"`
+	Redis        RedisConfig          `validate:"required"`
+	JWT          JWTConfig            `validate:"required"`
+	Sender       SenderConfig         `validate:"required"`
+	MailOutbox   MailOutboxConfig     `validate:"required"`
+	Storage      StorageConfig        `validate:"required"`
+	Image        ImageConfig          `validate:"required"`
+	Attachment   AttachmentConfig     `validate:"required"`
+	Notification NotificationConfig   `validate:"required"`
+	Markdown     MarkdownConfig       `validate:"required"`
+	Permission   PermissionConfig     `validate:"required"`
+	OAuth2       OAuth2Config         `validate:"required"`
+	LoginGuard   LoginGuardConfig     `validate:"required"`
+	RateLimit    RateLimitConfig      `validate:"required"`
+	CORS         CORSConfig           `validate:"required"`
+	Cookie       CookieConfig         `validate:"required"`
+	Password     PasswordPolicyConfig `validate:"required"`
+	PasswordHash PasswordHashConfig   `validate:"required"`
+	Username     UsernameConfig       `validate:"required"`
 }
 
 type RedisConfig struct {
@@ -33,13 +50,20 @@ type JWTConfig struct {
 	AccessTTL     int    `validate:"required,gt=0"`
 	RefreshTTL    int    `validate:"required,gt=0"`
 	RefreshMaxTTL int    `validate:"required,gt=0,gtefield=RefreshTTL"`
+	ReauthWindow  int    `validate:"required,gt=0"` // minutes
 }
 
 type SenderConfig struct {
-	FromEmail string `validate:"required,email"`
-	Password  string `validate:"required"`
-	SMTPHost  string `validate:"required"`
-	SMTPPort  int    `validate:"required,min=1,max=65535"`
+	Transport       string `validate:"oneof=smtp maildir memory"`
+	FromEmail       string `validate:"required,email"`
+	FromName        string `validate:"required"` // also the product name used in email templates
+	Password        string `validate:"required_if=Transport smtp"`
+	SMTPHost        string `validate:"required_if=Transport smtp"`
+	SMTPPort        int    `validate:"required,min=1,max=65535"`
+	SMTPPoolSize    int    `validate:"gte=0"`         // idle connections kept open
+	SMTPIdleTimeout int    `validate:"required,gt=0"` // seconds
+	MaildirPath     string `validate:"required_if=Transport maildir"`
+	DefaultLocale   string `validate:"required"` // must have templates in internal/mail/templates
 }
 
 type OAuth2Config struct {
@@ -48,13 +72,155 @@ type OAuth2Config struct {
 	RedirectURI  string `validate:"required,url"`
 }
 
+type LoginGuardConfig struct {
+	MaxAccountAttempts int `validate:"required,gt=0"`
+	MaxIPAttempts      int `validate:"required,gt=0"`
+	AttemptWindow      int `validate:"required,gt=0"`                      // minutes
+	LockoutDuration    int `validate:"required,gt=0"`                      // minutes
+	BackoffThreshold   int `validate:"gte=0"`                              // failures before backoff starts
+	BackoffBase        int `validate:"required,gt=0"`                      // seconds
+	BackoffMax         int `validate:"required,gt=0,gtefield=BackoffBase"` // seconds
+}
+
+type RateLimitConfig struct {
+	Enabled     bool
+	Backend     string `validate:"required,oneof=redis memory"`
+	AuthLimit   int    `validate:"required,gt=0"`
+	AuthWindow  int    `validate:"required,gt=0"` // seconds
+	ReadLimit   int    `validate:"required,gt=0"`
+	ReadWindow  int    `validate:"required,gt=0"` // seconds
+	WriteLimit  int    `validate:"required,gt=0"`
+	WriteWindow int    `validate:"required,gt=0"` // seconds
+}
+
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string `validate:"required,min=1"`
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           int `validate:"gte=0"` // seconds
+}
+
+type CookieConfig struct {
+	Secure   bool
+	SameSite string `validate:"required,oneof=lax strict none"`
+	Domain   string
+	Path     string `validate:"required"`
+}
+
+type PasswordPolicyConfig struct {
+	MinLength           int `validate:"required,gt=0"`
+	MaxLength           int `validate:"required,gtefield=MinLength"` // bytes
+	MinCharClasses      int `validate:"gte=0,lte=4"`
+	BreachedDatasetPath string
+}
+
+type PasswordHashConfig struct {
+	Algorithm         string `validate:"oneof=argon2id bcrypt"`
+	Argon2Memory      int    `validate:"required,gte=8192"` // KiB
+	Argon2Iterations  int    `validate:"required,gt=0"`
+	Argon2Parallelism int    `validate:"required,gt=0,lte=255"`
+	Argon2SaltLength  int    `validate:"required,gte=16"`
+	Argon2KeyLength   int    `validate:"required,gte=16"`
+	BcryptCost        int    `validate:"gte=10,lte=31"`
+}
+
+type UsernameConfig struct {
+	Reserved       []string // in addition to the built-in list
+	ChangeCooldown int      `validate:"gte=0"` // days
+	RedirectPeriod int      `validate:"gte=0"` // days
+}
+
+// MailOutboxConfig tunes the background delivery of queued emails. Failed
+// sends are retried after RetryBase*2^(attempt-1) seconds, capped at
+// RetryMax, until MaxAttempts is reached.
+type MailOutboxConfig struct {
+	PollInterval int `validate:"required,gt=0"` // seconds
+	BatchSize    int `validate:"required,gt=0"`
+	MaxAttempts  int `validate:"required,gt=0"`
+	RetryBase    int `validate:"required,gt=0"`               // seconds
+	RetryMax     int `validate:"required,gtefield=RetryBase"` // seconds
+	SendTimeout  int `validate:"required,gt=0"`               // seconds, also the lease on claimed emails
+	Retention    int `validate:"required,gt=0"`               // days sent emails are kept
+}
+
+// StorageConfig selects where uploaded files are kept. PublicURL is the base
+// URL objects are served from; for the local driver the API serves LocalDir
+// itself under /media.
+type StorageConfig struct {
+	Driver        string `validate:"oneof=local s3 cloudinary"`
+	PublicURL     string `validate:"omitempty,url"`
+	LocalDir      string `validate:"required_if=Driver local"`
+	S3            S3Config
+	CloudinaryURL string `validate:"required_if=Driver cloudinary"`
+}
+
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	PathStyle bool // required by most self-hosted S3-compatible servers
+}
+
+type ImageConfig struct {
+	MaxUploadSize int64 `validate:"required,gt=0"`            // bytes
+	AvatarSize    int   `validate:"required,gte=32,lte=2048"` // pixels, avatars are square
+	MinDimension  int   `validate:"required,gt=0"`            // pixels, per side
+	MaxDimension  int   `validate:"required,gtefield=MinDimension"`
+	MaxPixels     int   `validate:"required,gt=0"` // width*height, guards against decompression bombs
+	JPEGQuality   int   `validate:"required,gte=1,lte=100"`
+}
+
+// AttachmentConfig limits images uploaded for questions and answers.
+// Uploads never referenced by a saved post are deleted after OrphanTTL.
+type AttachmentConfig struct {
+	MaxFilesPerUser int   `validate:"required,gt=0"`
+	MaxBytesPerUser int64 `validate:"required,gt=0"` // bytes
+	OrphanTTL       int   `validate:"required,gt=0"` // hours
+	GCInterval      int   `validate:"required,gt=0"` // minutes
+}
+
+// NotificationConfig tunes the notification stream and emails. Reconnecting
+// clients get at most StreamReplayLimit missed notifications replayed.
+// Digests go out at DigestHour UTC, weekly ones on DigestWeekday (0 is
+// Sunday). UnsubscribeSecret signs unsubscribe links and defaults to the JWT
+// access secret.
+type NotificationConfig struct {
+	StreamHeartbeat   int    `validate:"required,gt=0"` // seconds
+	StreamReplayLimit int    `validate:"required,gt=0"`
+	DigestHour        int    `validate:"gte=0,lte=23"`
+	DigestWeekday     int    `validate:"gte=0,lte=6"`
+	DigestMaxItems    int    `validate:"required,gt=0"`
+	UnsubscribeSecret string `validate:"required"`
+}
+
+// MarkdownConfig controls rendering of post bodies. HighlightStyle names the
+// Chroma style served as the code highlighting stylesheet.
+type MarkdownConfig struct {
+	HighlightStyle string `validate:"required"`
+	MaxLength      int    `validate:"required,gt=0"` // characters accepted in post bodies and previews
+}
+
+// PermissionConfig controls how long role permissions are cached in Redis.
+// Changes made through the admin endpoints clear the cache right away.
+type PermissionConfig struct {
+	CacheTTL int `validate:"required,gt=0"` // seconds
+}
+
 var validate = validator.New()
 
 func New() (*Config, error) {
+	mode := getEnv("GIN_MODE", "debug")
+
 	config := &Config{
 		Port:        getEnv("PORT", "8080"),
+		BaseURL:     strings.TrimSuffix(getEnv("BASE_URL", ""), "/"),
 		LogLevel:    getEnv("LOG_LEVEL", "info"),
-		Mode:        getEnv("GIN_MODE", "debug"),
+		Mode:        mode,
 		DatabaseURL: getEnv("DATABASE_URL", ""),
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -68,19 +234,138 @@ func New() (*Config, error) {
 			AccessTTL:     getEnvAsInt("JWT_ACCESS_TTL", 15),
 			RefreshTTL:    getEnvAsInt("JWT_REFRESH_TTL", 7),
 			RefreshMaxTTL: getEnvAsInt("JWT_REFRESH_MAX_TTL", 30),
+			ReauthWindow:  getEnvAsInt("JWT_REAUTH_WINDOW", 5),
 		},
 		Sender: SenderConfig{
-			FromEmail: getEnv("SENDER_EMAIL", ""),
-			Password:  getEnv("SENDER_PASSWORD", ""),
-			SMTPHost:  getEnv("SMTP_HOST", "smtp.gmail.com"),
-			SMTPPort:  getEnvAsInt("SMTP_PORT", 587),
+			Transport:       getEnv("MAIL_TRANSPORT", "smtp"),
+			FromEmail:       getEnv("SENDER_EMAIL", ""),
+			FromName:        getEnv("SENDER_NAME", "Usof"),
+			Password:        getEnv("SENDER_PASSWORD", ""),
+			SMTPHost:        getEnv("SMTP_HOST", "smtp.gmail.com"),
+			SMTPPort:        getEnvAsInt("SMTP_PORT", 587),
+			SMTPPoolSize:    getEnvAsInt("SMTP_POOL_SIZE", 2),
+			SMTPIdleTimeout: getEnvAsInt("SMTP_IDLE_TIMEOUT", 30),
+			MaildirPath:     getEnv("MAIL_MAILDIR_PATH", "./maildir"),
+			DefaultLocale:   getEnv("MAIL_DEFAULT_LOCALE", "en"),
+		},
+		MailOutbox: MailOutboxConfig{
+			PollInterval: getEnvAsInt("MAIL_OUTBOX_POLL_INTERVAL", 5),
+			BatchSize:    getEnvAsInt("MAIL_OUTBOX_BATCH_SIZE", 20),
+			MaxAttempts:  getEnvAsInt("MAIL_OUTBOX_MAX_ATTEMPTS", 8),
+			RetryBase:    getEnvAsInt("MAIL_OUTBOX_RETRY_BASE", 30),
+			RetryMax:     getEnvAsInt("MAIL_OUTBOX_RETRY_MAX", 3600),
+			SendTimeout:  getEnvAsInt("MAIL_OUTBOX_SEND_TIMEOUT", 60),
+			Retention:    getEnvAsInt("MAIL_OUTBOX_RETENTION", 7),
+		},
+		Storage: StorageConfig{
+			Driver:    getEnv("STORAGE_DRIVER", "local"),
+			PublicURL: getEnv("STORAGE_PUBLIC_URL", ""),
+			LocalDir:  getEnv("STORAGE_LOCAL_DIR", "./uploads"),
+			S3: S3Config{
+				Endpoint:  getEnv("S3_ENDPOINT", ""),
+				Region:    getEnv("S3_REGION", "us-east-1"),
+				Bucket:    getEnv("S3_BUCKET", ""),
+				AccessKey: getEnv("S3_ACCESS_KEY", ""),
+				SecretKey: getEnv("S3_SECRET_KEY", ""),
+				UseSSL:    getEnvAsBool("S3_USE_SSL", true),
+				PathStyle: getEnvAsBool("S3_PATH_STYLE", false),
+			},
+			CloudinaryURL: getEnv("CLOUDINARY_URL", ""),
+		},
+		Image: ImageConfig{
+			MaxUploadSize: int64(getEnvAsInt("IMAGE_MAX_UPLOAD_SIZE", 5<<20)),
+			AvatarSize:    getEnvAsInt("AVATAR_SIZE", 400),
+			MinDimension:  getEnvAsInt("IMAGE_MIN_DIMENSION", 32),
+			MaxDimension:  getEnvAsInt("IMAGE_MAX_DIMENSION", 8192),
+			MaxPixels:     getEnvAsInt("IMAGE_MAX_PIXELS", 40_000_000),
+			JPEGQuality:   getEnvAsInt("IMAGE_JPEG_QUALITY", 85),
+		},
+		Attachment: AttachmentConfig{
+			MaxFilesPerUser: getEnvAsInt("ATTACHMENT_MAX_FILES", 200),
+			MaxBytesPerUser: int64(getEnvAsInt("ATTACHMENT_MAX_BYTES", 100<<20)),
+			OrphanTTL:       getEnvAsInt("ATTACHMENT_ORPHAN_TTL", 24),
+			GCInterval:      getEnvAsInt("ATTACHMENT_GC_INTERVAL", 60),
+		},
+		Notification: NotificationConfig{
+			StreamHeartbeat:   getEnvAsInt("NOTIFICATION_STREAM_HEARTBEAT", 25),
+			StreamReplayLimit: getEnvAsInt("NOTIFICATION_STREAM_REPLAY_LIMIT", 100),
+			DigestHour:        getEnvAsInt("NOTIFICATION_DIGEST_HOUR", 8),
+			DigestWeekday:     getEnvAsInt("NOTIFICATION_DIGEST_WEEKDAY", 1),
+			DigestMaxItems:    getEnvAsInt("NOTIFICATION_DIGEST_MAX_ITEMS", 50),
+			UnsubscribeSecret: getEnv("NOTIFICATION_UNSUBSCRIBE_SECRET", getEnv("JWT_ACCESS_SECRET", "")),
+		},
+		Markdown: MarkdownConfig{
+			HighlightStyle: getEnv("MARKDOWN_HIGHLIGHT_STYLE", "github"),
+			MaxLength:      getEnvAsInt("MARKDOWN_MAX_LENGTH", 30000),
+		},
+		Permission: PermissionConfig{
+			CacheTTL: getEnvAsInt("PERMISSION_CACHE_TTL", 300),
 		},
-		CloudinaryURL: getEnv("CLOUDINARY_URL", ""),
 		OAuth2: OAuth2Config{
 			ClientID:     getEnv("OAUTH2_CLIENT_ID", ""),
 			ClientSecret: getEnv("OAUTH2_CLIENT_SECRET", ""),
 			RedirectURI:  getEnv("OAUTH2_REDIRECT_URI", "http://localhost:8080/api/auth/google/callback"),
 		},
+		LoginGuard: LoginGuardConfig{
+			MaxAccountAttempts: getEnvAsInt("LOGIN_MAX_ACCOUNT_ATTEMPTS", 5),
+			MaxIPAttempts:      getEnvAsInt("LOGIN_MAX_IP_ATTEMPTS", 20),
+			AttemptWindow:      getEnvAsInt("LOGIN_ATTEMPT_WINDOW", 15),
+			LockoutDuration:    getEnvAsInt("LOGIN_LOCKOUT_DURATION", 30),
+			BackoffThreshold:   getEnvAsInt("LOGIN_BACKOFF_THRESHOLD", 2),
+			BackoffBase:        getEnvAsInt("LOGIN_BACKOFF_BASE", 1),
+			BackoffMax:         getEnvAsInt("LOGIN_BACKOFF_MAX", 60),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:     getEnvAsBool("RATE_LIMIT_ENABLED", true),
+			Backend:     getEnv("RATE_LIMIT_BACKEND", "redis"),
+			AuthLimit:   getEnvAsInt("RATE_LIMIT_AUTH_LIMIT", 10),
+			AuthWindow:  getEnvAsInt("RATE_LIMIT_AUTH_WINDOW", 60),
+			ReadLimit:   getEnvAsInt("RATE_LIMIT_READ_LIMIT", 300),
+			ReadWindow:  getEnvAsInt("RATE_LIMIT_READ_WINDOW", 60),
+			WriteLimit:  getEnvAsInt("RATE_LIMIT_WRITE_LIMIT", 60),
+			WriteWindow: getEnvAsInt("RATE_LIMIT_WRITE_WINDOW", 60),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   getEnvAsSlice("CORS_ALLOWED_ORIGINS", defaultCORSOrigins(mode)),
+			AllowedMethods:   getEnvAsSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}),
+			AllowedHeaders:   getEnvAsSlice("CORS_ALLOWED_HEADERS", []string{"Authorization", "Content-Type", "X-CSRF-Token"}),
+			ExposedHeaders:   getEnvAsSlice("CORS_EXPOSED_HEADERS", []string{"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"}),
+			AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", true),
+			MaxAge:           getEnvAsInt("CORS_MAX_AGE", 600),
+		},
+		Cookie: CookieConfig{
+			Secure:   getEnvAsBool("COOKIE_SECURE", mode == "release"),
+			SameSite: strings.ToLower(getEnv("COOKIE_SAMESITE", "lax")),
+			Domain:   getEnv("COOKIE_DOMAIN", ""),
+			Path:     getEnv("COOKIE_PATH", "/"),
+		},
+		Password: PasswordPolicyConfig{
+			MinLength:           getEnvAsInt("PASSWORD_MIN_LENGTH", 8),
+			MaxLength:           getEnvAsInt("PASSWORD_MAX_LENGTH", 128),
+			MinCharClasses:      getEnvAsInt("PASSWORD_MIN_CHAR_CLASSES", 3),
+			BreachedDatasetPath: getEnv("PASSWORD_BREACHED_DATASET", ""),
+		},
+		Username: UsernameConfig{
+			Reserved:       getEnvAsSlice("USERNAME_RESERVED", nil),
+			ChangeCooldown: getEnvAsInt("USERNAME_CHANGE_COOLDOWN", 30),
+			RedirectPeriod: getEnvAsInt("USERNAME_REDIRECT_PERIOD", 90),
+		},
+		PasswordHash: PasswordHashConfig{
+			Algorithm:         getEnv("PASSWORD_HASH_ALGORITHM", "argon2id"),
+			Argon2Memory:      getEnvAsInt("ARGON2_MEMORY", 64*1024),
+			Argon2Iterations:  getEnvAsInt("ARGON2_ITERATIONS", 3),
+			Argon2Parallelism: getEnvAsInt("ARGON2_PARALLELISM", 2),
+			Argon2SaltLength:  getEnvAsInt("ARGON2_SALT_LENGTH", 16),
+			Argon2KeyLength:   getEnvAsInt("ARGON2_KEY_LENGTH", 32),
+			BcryptCost:        getEnvAsInt("BCRYPT_COST", 10),
+		},
+	}
+
+	if config.BaseURL == "" {
+		config.BaseURL = "http://localhost:" + config.Port
+	}
+	if config.Storage.Driver == "local" && config.Storage.PublicURL == "" {
+		config.Storage.PublicURL = config.BaseURL + "/media"
 	}
 
 	if err := config.validate(); err != nil {
@@ -94,9 +379,33 @@ func (c *Config) validate() error {
 	if err := validate.Struct(c); err != nil {
 		return formatValidationErrors(err)
 	}
+	if c.CORS.AllowCredentials && slices.Contains(c.CORS.AllowedOrigins, "*") {
+		return errors.New("CORS: wildcard origin cannot be combined with credentials")
+	}
+	if c.Cookie.SameSite == "none" && !c.Cookie.Secure {
+		return errors.New("cookie: SameSite=None requires secure cookies")
+	}
+	if c.Storage.Driver == "s3" && (c.Storage.S3.Endpoint == "" || c.Storage.S3.Bucket == "") {
+		return errors.New("storage: S3_ENDPOINT and S3_BUCKET are required for the s3 driver")
+	}
+	if c.Mode == "release" && c.Sender.Transport == "memory" {
+		return errors.New("mail: the memory transport discards emails and cannot be used in release mode")
+	}
+	if c.PasswordHash.Algorithm == "bcrypt" && c.Password.MaxLength > 72 {
+		return errors.New("password: bcrypt cannot hash passwords longer than 72 bytes")
+	}
 	return nil
 }
 
+// defaultCORSOrigins allows the usual SPA dev servers outside of release mode.
+// Release deployments must list their origins explicitly.
+func defaultCORSOrigins(mode string) []string {
+	if mode == "release" {
+		return nil
+	}
+	return []string{"http://localhost:3000", "http://localhost:5173"}
+}
+
 func formatValidationErrors(err error) error {
 	if validationErrors, ok := err.(validator.ValidationErrors); ok {
 		for _, err := range validationErrors {
@@ -124,3 +433,29 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return value
 }
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	valueStr := getEnv(key, "")
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsSlice(key string, defaultValue []string) []string {
+	valueStr := getEnv(key, "")
+	if valueStr == "" {
+		return defaultValue
+	}
+	var values []string
+	for _, v := range strings.Split(valueStr, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	AttachmentTargetQuestion = "question"
+	AttachmentTargetAnswer   = "answer"
+)
+
+// Attachment is an image uploaded for a question or answer. TargetType and
+// TargetID are empty until a saved post references it. UserID is 0 once the
+// uploader's account has been deleted.
+type Attachment struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Key         string    `json:"-"`
+	URL         string    `json:"url"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Width       int       `json:"width"`
+	Height      int       `json:"height"`
+	TargetType  string    `json:"target_type,omitempty"`
+	TargetID    int64     `json:"target_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type AttachmentQuota struct {
+	MaxFiles int
+	MaxBytes int64
+}
+
+type AttachmentUsage struct {
+	Files int   `json:"files"`
+	Bytes int64 `json:"bytes"`
+}
+
+type AttachmentRepository interface {
+	// Create inserts a unless the user's uploads would exceed quota, in which
+	// case it returns false and inserts nothing.
+	Create(ctx context.Context, a *Attachment, quota AttachmentQuota) (bool, error)
+	GetByID(ctx context.Context, id int64) (*Attachment, error)
+	ListByUser(ctx context.Context, userID int64) ([]*Attachment, error)
+	ListByTarget(ctx context.Context, targetType string, targetID int64) ([]*Attachment, error)
+	Usage(ctx context.Context, userID int64) (AttachmentUsage, error)
+	// SetTarget makes ids the attachments of a post: the user's unreferenced
+	// uploads among ids are linked and previously linked ones not in ids are
+	// released. It returns how many of ids can be linked; unless that is all
+	// of them nothing is changed.
+	SetTarget(ctx context.Context, userID int64, targetType string, targetID int64, ids []int64) (int, error)
+	// ListOrphaned returns unreferenced uploads created before the cutoff,
+	// and unreferenced uploads of deleted users regardless of age, with IDs
+	// above afterID in ID order.
+	ListOrphaned(ctx context.Context, before time.Time, afterID int64, limit int) ([]*Attachment, error)
+	Delete(ctx context.Context, id int64) error
+}
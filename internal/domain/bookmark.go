@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	BookmarkTargetQuestion = "question"
+	BookmarkTargetAnswer   = "answer"
+)
+
+// Bookmark saves a question or answer for UserID. CollectionID is nil for
+// bookmarks outside any collection; Position orders bookmarks within their
+// collection.
+type Bookmark struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"-"`
+	CollectionID *int64    `json:"collection_id"`
+	TargetType   string    `json:"target_type"`
+	TargetID     int64     `json:"target_id"`
+	Position     int       `json:"position"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// BookmarkCollection is a named folder of bookmarks. Public collections are
+// visible to anyone who knows their ShareToken, which is random so that
+// shared collections can't be enumerated.
+type BookmarkCollection struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	Name       string    `json:"name"`
+	Public     bool      `json:"public"`
+	ShareToken string    `json:"share_token,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type BookmarkRepository interface {
+	Create(ctx context.Context, bookmark *Bookmark) error
+	GetByID(ctx context.Context, id int64) (*Bookmark, error)
+	GetByTarget(ctx context.Context, userID int64, targetType string, targetID int64) (*Bookmark, error)
+	// List returns userID's bookmarks in collectionID, or outside any
+	// collection when it is nil, ordered by position.
+	List(ctx context.Context, userID int64, collectionID *int64) ([]*Bookmark, error)
+	// NextPosition returns the position after the last bookmark in
+	// collectionID.
+	NextPosition(ctx context.Context, userID int64, collectionID *int64) (int, error)
+	Move(ctx context.Context, id int64, collectionID *int64, position int) error
+	// Reorder sets the position of each bookmark to its index in ids.
+	Reorder(ctx context.Context, userID int64, ids []int64) error
+	Delete(ctx context.Context, userID, id int64) (bool, error)
+	Count(ctx context.Context, userID int64) (int, error)
+}
+
+type BookmarkCollectionRepository interface {
+	Create(ctx context.Context, collection *BookmarkCollection) error
+	GetByID(ctx context.Context, id int64) (*BookmarkCollection, error)
+	GetByName(ctx context.Context, userID int64, name string) (*BookmarkCollection, error)
+	GetByShareToken(ctx context.Context, token string) (*BookmarkCollection, error)
+	List(ctx context.Context, userID int64) ([]*BookmarkCollection, error)
+	Update(ctx context.Context, collection *BookmarkCollection) error
+	// Delete deletes the collection and appends its bookmarks, in their
+	// order, to the owner's bookmarks outside collections.
+	Delete(ctx context.Context, id int64) error
+	Count(ctx context.Context, userID int64) (int, error)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	CommentTargetQuestion = "question"
+	CommentTargetAnswer   = "answer"
+)
+
+// Comment is a short note under a question or answer. Unlike posts it has
+// no revisions. AuthorID is 0 once the author's account has been deleted.
+type Comment struct {
+	ID         int64
+	TargetType string
+	TargetID   int64
+	AuthorID   int64
+	Body       string
+	CreatedAt  time.Time
+}
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment *Comment) error
+	GetByID(ctx context.Context, id int64) (*Comment, error)
+	// List returns the comments of a post oldest first, leaving out those a
+	// moderator deleted.
+	List(ctx context.Context, targetType string, targetID int64) ([]*Comment, error)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "errors"
+
+// ErrUniqueViolation matches a *UniqueViolationError with errors.Is.
+var ErrUniqueViolation = errors.New("unique constraint violation")
+
+// UniqueViolationError is returned by repositories when a write conflicts
+// with a unique constraint, typically because a concurrent request won the
+// race past the service's own check. Constraint names the violated
+// constraint or index.
+type UniqueViolationError struct {
+	Constraint string
+}
+
+func (e *UniqueViolationError) Error() string {
+	return "unique constraint violation: " + e.Constraint
+}
+
+func (e *UniqueViolationError) Is(target error) bool {
+	return target == ErrUniqueViolation
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Kinds of things a user can follow.
+const (
+	FollowQuestion = "question"
+	FollowCategory = "category"
+	FollowUser     = "user"
+)
+
+// FollowTypes lists every kind of follow target.
+var FollowTypes = []string{FollowQuestion, FollowCategory, FollowUser}
+
+// FollowTarget names a question, category or user.
+type FollowTarget struct {
+	Type string `json:"target_type"`
+	ID   int64  `json:"target_id"`
+}
+
+type Follow struct {
+	UserID int64 `json:"-"`
+	FollowTarget
+	CreatedAt time.Time `json:"created_at"`
+}
+
+const (
+	ActivityQuestion = "question"
+	ActivityAnswer   = "answer"
+	ActivityComment  = "comment"
+)
+
+// Activity is a post created by ActorID, shown in the feeds of users who
+// follow any of Targets. PostID is the new question, answer or comment,
+// QuestionID the question it belongs to.
+type Activity struct {
+	ID         int64          `json:"id"`
+	ActorID    int64          `json:"actor_id,omitempty"`
+	Type       string         `json:"type"`
+	PostID     int64          `json:"post_id"`
+	QuestionID int64          `json:"question_id"`
+	Excerpt    string         `json:"excerpt,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+	Targets    []FollowTarget `json:"-"`
+}
+
+type FollowRepository interface {
+	// Create reports whether the follow is new.
+	Create(ctx context.Context, follow *Follow) (bool, error)
+	Delete(ctx context.Context, userID int64, target FollowTarget) (bool, error)
+	// List returns what userID follows, newest first, optionally only
+	// targets of one type.
+	List(ctx context.Context, userID int64, targetType string) ([]*Follow, error)
+	Count(ctx context.Context, userID int64) (int, error)
+	CountFollowers(ctx context.Context, target FollowTarget) (int, error)
+}
+
+type ActivityRepository interface {
+	Create(ctx context.Context, activity *Activity) error
+	// Feed returns activities of others in anything userID follows, newest
+	// first, starting below beforeID when it is not 0.
+	Feed(ctx context.Context, userID, beforeID int64, limit int) ([]*Activity, error)
+}
@@ -2,10 +2,24 @@ package domain
 
 import (
 	"context"
-	"mime/multipart"
+	"io"
 )
 
+// StoredImage describes an upload after it was validated, re-encoded and
+// written to object storage.
+type StoredImage struct {
+	Key         string
+	URL         string
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+}
+
 type ImageService interface {
-	UploadAvatar(ctx context.Context, file multipart.File, userID string) (string, error)
-	DeleteAvatar(ctx context.Context, userID string) error
+	UploadAvatar(ctx context.Context, file io.Reader, userID string) (string, error)
+	DeleteAvatar(ctx context.Context, userID, avatarURL string) error
+	// Upload stores a generic image under prefix with a random name.
+	Upload(ctx context.Context, file io.Reader, prefix string) (*StoredImage, error)
+	Delete(ctx context.Context, key string) error
 }
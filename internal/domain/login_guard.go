@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type LoginAttemptRepository interface {
+	IncrementAccountFailures(ctx context.Context, email string, window time.Duration) (int64, error)
+	IncrementIPFailures(ctx context.Context, ip string, window time.Duration) (int64, error)
+	ResetAccountFailures(ctx context.Context, email string) error
+
+	SetAccountBackoff(ctx context.Context, email string, ttl time.Duration) error
+	GetAccountBackoff(ctx context.Context, email string) (time.Duration, error)
+
+	// LockAccount locks the account for ttl and starts its failure count
+	// and backoff over.
+	LockAccount(ctx context.Context, email string, ttl time.Duration) error
+	GetAccountLock(ctx context.Context, email string) (time.Duration, error)
+	// UnlockAccount lifts the lock and clears the failure count and backoff.
+	UnlockAccount(ctx context.Context, email string) error
+
+	// LockIP locks the IP for ttl and starts its failure count over.
+	LockIP(ctx context.Context, ip string, ttl time.Duration) error
+	GetIPLock(ctx context.Context, ip string) (time.Duration, error)
+
+	StoreUnlockToken(ctx context.Context, token, email string, ttl time.Duration) error
+	GetUnlockToken(ctx context.Context, token string) (string, error)
+	DeleteUnlockToken(ctx context.Context, token string) error
+}
@@ -1,14 +1,60 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
+// Email is a ready-to-send message; HTML and Text are sent as alternatives.
 type Email struct {
 	To      string
 	Subject string
-	Body    string
+	HTML    string
+	Text    string
+	// UnsubscribeURL, when set, is announced in the List-Unsubscribe headers
+	// and must accept a one-click POST (RFC 8058).
+	UnsubscribeURL string
+}
+
+// MailTransport delivers rendered messages, e.g. over SMTP.
+type MailTransport interface {
+	Send(ctx context.Context, email *Email) error
 }
 
 type EmailSender interface {
 	Send(ctx context.Context, email *Email) error
+	// SendTemplate renders a template from internal/mail/templates; a string
+	// "UnsubscribeURL" in data also sets Email.UnsubscribeURL.
+	SendTemplate(ctx context.Context, to, name string, data map[string]any) error
 	SendVerificationEmail(ctx context.Context, email, token string) error
+	SendUnlockEmail(ctx context.Context, email, token string) error
+}
+
+const (
+	OutboxPending = "pending"
+	OutboxSent    = "sent"
+	OutboxDead    = "dead"
+)
+
+// OutboxEmail is an Email queued for asynchronous delivery.
+type OutboxEmail struct {
+	ID        int64
+	Email     Email
+	Status    string
+	Attempts  int
+	LastError string
+	CreatedAt time.Time
+}
+
+type EmailOutboxRepository interface {
+	Enqueue(ctx context.Context, email *OutboxEmail) error
+	// ClaimDue leases up to limit pending emails that are due until
+	// leaseUntil and counts the attempt, so concurrent workers never pick
+	// the same email.
+	ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]*OutboxEmail, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, lastError string, retryAt time.Time) error
+	MarkDead(ctx context.Context, id int64, lastError string) error
+	// PurgeSent deletes sent emails older than before.
+	PurgeSent(ctx context.Context, before time.Time) (int64, error)
 }
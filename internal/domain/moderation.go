@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	FlagTargetQuestion = "question"
+	FlagTargetAnswer   = "answer"
+	FlagTargetComment  = "comment"
+)
+
+// FlagTargetTypes lists what can be flagged.
+var FlagTargetTypes = []string{FlagTargetQuestion, FlagTargetAnswer, FlagTargetComment}
+
+const (
+	FlagSpam       = "spam"
+	FlagOffensive  = "offensive"
+	FlagDuplicate  = "duplicate"
+	FlagLowQuality = "low_quality"
+)
+
+// FlagReasons lists every reason a post can be flagged for.
+var FlagReasons = []string{FlagSpam, FlagOffensive, FlagDuplicate, FlagLowQuality}
+
+const (
+	ModerationDismiss = "dismiss"
+	ModerationDelete  = "delete"
+	ModerationLock    = "lock"
+	ModerationWarn    = "warn"
+)
+
+// ModerationActions lists every action a moderator can take on a post.
+var ModerationActions = []string{ModerationDismiss, ModerationDelete, ModerationLock, ModerationWarn}
+
+// Flag reports a post to the moderators. It is pending until a moderation
+// action on the post resolves it; ActionID is that action.
+type Flag struct {
+	ID         int64      `json:"id"`
+	TargetType string     `json:"target_type"`
+	TargetID   int64      `json:"target_id"`
+	ReporterID int64      `json:"reporter_id"`
+	Reason     string     `json:"reason"`
+	Details    string     `json:"details,omitempty"`
+	ActionID   int64      `json:"action_id,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ResolvedAt *time.Time `json:"resolved_at"`
+}
+
+// ModerationAction records what ModeratorID did about a post and why.
+// UserID is the user a warning went to.
+type ModerationAction struct {
+	ID          int64     `json:"id"`
+	TargetType  string    `json:"target_type"`
+	TargetID    int64     `json:"target_id"`
+	ModeratorID int64     `json:"moderator_id"`
+	Action      string    `json:"action"`
+	Reason      string    `json:"reason"`
+	UserID      int64     `json:"user_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// FlaggedPost is an entry of the moderation queue: a post with pending
+// flags, counted per reason.
+type FlaggedPost struct {
+	TargetType     string         `json:"target_type"`
+	TargetID       int64          `json:"target_id"`
+	Flags          int            `json:"flags"`
+	Reasons        map[string]int `json:"reasons"`
+	FirstFlaggedAt time.Time      `json:"first_flagged_at"`
+	LastFlaggedAt  time.Time      `json:"last_flagged_at"`
+}
+
+type FlagRepository interface {
+	// Create reports whether the flag was stored; it is not when the
+	// reporter already flagged the post.
+	Create(ctx context.Context, flag *Flag) (bool, error)
+	// ListForTarget returns every flag of the post, newest first.
+	ListForTarget(ctx context.Context, targetType string, targetID int64) ([]*Flag, error)
+	// Queue returns posts with pending flags, most flagged first, limited to
+	// flags for reason unless it is empty.
+	Queue(ctx context.Context, reason string, limit, offset int) ([]*FlaggedPost, error)
+	CountQueue(ctx context.Context, reason string) (int, error)
+}
+
+type ModerationActionRepository interface {
+	// Create stores the action and resolves the pending flags of its post
+	// with it, returning how many it resolved. A second delete or lock of
+	// the same post fails with a *UniqueViolationError.
+	Create(ctx context.Context, action *ModerationAction) (int64, error)
+	// ListForTarget returns every action taken on the post, newest first.
+	ListForTarget(ctx context.Context, targetType string, targetID int64) ([]*ModerationAction, error)
+	// Exists reports whether action was ever taken on the post.
+	Exists(ctx context.Context, targetType string, targetID int64, action string) (bool, error)
+}
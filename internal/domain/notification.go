@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	NotificationAnswer              = "answer"
+	NotificationComment             = "comment"
+	NotificationMention             = "mention"
+	NotificationAcceptedAnswer      = "accepted_answer"
+	NotificationReputationMilestone = "reputation_milestone"
+	NotificationModeratorWarning    = "moderator_warning"
+)
+
+// NotificationTypes lists every notification type.
+var NotificationTypes = []string{
+	NotificationAnswer,
+	NotificationComment,
+	NotificationMention,
+	NotificationAcceptedAnswer,
+	NotificationReputationMilestone,
+	NotificationModeratorWarning,
+}
+
+const (
+	NotificationTargetQuestion = "question"
+	NotificationTargetAnswer   = "answer"
+	NotificationTargetComment  = "comment"
+)
+
+// Notification tells UserID about something that happened to their content.
+// ActorID is 0 for system events; TargetType and TargetID name the post the
+// notification links to.
+type Notification struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"-"`
+	Type       string     `json:"type"`
+	ActorID    int64      `json:"actor_id,omitempty"`
+	TargetType string     `json:"target_type,omitempty"`
+	TargetID   int64      `json:"target_id,omitempty"`
+	Excerpt    string     `json:"excerpt,omitempty"`
+	Milestone  int        `json:"milestone,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReadAt     *time.Time `json:"read_at"`
+}
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notifications []*Notification) error
+	// List returns userID's notifications newest first, starting below
+	// beforeID when it is not 0.
+	List(ctx context.Context, userID, beforeID int64, limit int, unreadOnly bool) ([]*Notification, error)
+	CountUnread(ctx context.Context, userID int64) (int, error)
+	// MarkRead marks the given notifications of userID as read and returns
+	// how many were unread.
+	MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error)
+	MarkAllRead(ctx context.Context, userID int64) (int64, error)
+	// ListAfter returns userID's notifications with an ID above afterID,
+	// oldest first.
+	ListAfter(ctx context.Context, userID, afterID int64, limit int) ([]*Notification, error)
+	// ListUnemailed returns userID's notifications of the given types created
+	// since the cutoff that were not emailed yet, oldest first.
+	ListUnemailed(ctx context.Context, userID int64, types []string, since time.Time, limit int) ([]*Notification, error)
+	MarkEmailed(ctx context.Context, ids []int64) error
+}
+
+// NotificationBroker carries new notifications to every app instance so
+// streams connected to any of them receive them.
+type NotificationBroker interface {
+	Publish(ctx context.Context, n *Notification) error
+	// Listen passes every published notification to handle until ctx is done
+	// or the subscription fails.
+	Listen(ctx context.Context, handle func(*Notification)) error
+}
+
+// Delivery options for a notification type.
+const (
+	DeliveryInApp  = "in_app" // in-app only
+	DeliveryEmail  = "email"  // in-app and an email right away
+	DeliveryDaily  = "daily"  // in-app and a daily digest email
+	DeliveryWeekly = "weekly" // in-app and a weekly digest email
+	DeliveryOff    = "off"    // not recorded at all
+)
+
+// NotificationPreferences maps notification types to delivery options.
+type NotificationPreferences map[string]string
+
+type NotificationPreferenceRepository interface {
+	// Get returns the preferences userID has set; types left at the default
+	// are missing.
+	Get(ctx context.Context, userID int64) (NotificationPreferences, error)
+	Set(ctx context.Context, userID int64, prefs NotificationPreferences) error
+	// UsersWithDelivery returns the users that chose delivery for any type.
+	UsersWithDelivery(ctx context.Context, delivery string) ([]int64, error)
+	// ClaimDigest records a digest of cadence for userID unless one was
+	// already recorded at or after since. It reports whether the caller
+	// should send the digest.
+	ClaimDigest(ctx context.Context, userID int64, cadence string, since time.Time) (bool, error)
+	// ReleaseDigest drops a claim made at or after since, so the digest is
+	// tried again on the next check.
+	ReleaseDigest(ctx context.Context, userID int64, cadence string, since time.Time) error
+	// UnsubscribeVersion returns the version unsubscribe links of userID
+	// must carry; 0 until the links were first revoked.
+	UnsubscribeVersion(ctx context.Context, userID int64) (int, error)
+	// BumpUnsubscribeVersion raises the version and returns the new one.
+	BumpUnsubscribeVersion(ctx context.Context, userID int64) (int, error)
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	PermissionCategoryWrite = "category:write"
+	PermissionFlagReview    = "flag:review"
+	PermissionPostDelete    = "post:delete"
+	PermissionPostLock      = "post:lock"
+	PermissionPostRollback  = "post:rollback"
+	PermissionUserWarn      = "user:warn"
+	PermissionUserBan       = "user:ban"
+	PermissionRoleAssign    = "role:assign"
+)
+
+// Roles lists every role a user can have.
+var Roles = []string{RoleUser, RoleModerator, RoleAdmin}
+
+// Permission is something a role can be allowed to do. Which roles have
+// which permissions is stored in the database and can be changed by admins.
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type PermissionRepository interface {
+	List(ctx context.Context) ([]*Permission, error)
+	// ForRole returns the names of role's permissions, sorted.
+	ForRole(ctx context.Context, role string) ([]string, error)
+	// SetForRole replaces role's permissions.
+	SetForRole(ctx context.Context, role string, permissions []string) error
+}
+
+// PermissionCache keeps each role's permissions, so checking a permission
+// does not need the database.
+type PermissionCache interface {
+	// Get reports false when role's permissions are not cached.
+	Get(ctx context.Context, role string) ([]string, bool, error)
+	Set(ctx context.Context, role string, permissions []string, ttl time.Duration) error
+	Delete(ctx context.Context, role string) error
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	PostQuestion = "question"
+	PostAnswer   = "answer"
+)
+
+// Question is a question with the content of its latest revision. AuthorID
+// is 0 once the author's account has been deleted. Score is the sum of its
+// votes.
+type Question struct {
+	ID               int64
+	AuthorID         int64
+	CategoryIDs      []int64
+	Title            string
+	Body             string
+	BodyHTML         string
+	Revision         int
+	AcceptedAnswerID int64
+	Score            int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Answer is an answer with the content of its latest revision.
+type Answer struct {
+	ID         int64
+	QuestionID int64
+	AuthorID   int64
+	Body       string
+	BodyHTML   string
+	Revision   int
+	Score      int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// PostRepository stores questions and answers without their content, which
+// lives in their revisions.
+type PostRepository interface {
+	// CreateQuestion inserts the question with its categories.
+	CreateQuestion(ctx context.Context, question *Question) error
+	GetQuestion(ctx context.Context, id int64) (*Question, error)
+	// ListQuestions returns a page of questions newest first, only those in
+	// categoryID unless it is 0. Questions a moderator deleted are left out
+	// here and in CountQuestions.
+	ListQuestions(ctx context.Context, categoryID int64, limit, offset int) ([]*Question, error)
+	CountQuestions(ctx context.Context, categoryID int64) (int, error)
+	// SetAccepted marks answerID as the accepted answer of the question, or
+	// clears it when answerID is 0.
+	SetAccepted(ctx context.Context, questionID, answerID int64) error
+	// DeleteQuestion deletes the question and its answers together with
+	// their revisions, comments, votes, bookmarks, follows, flags, feed
+	// activities and notifications.
+	DeleteQuestion(ctx context.Context, id int64) error
+
+	CreateAnswer(ctx context.Context, answer *Answer) error
+	GetAnswer(ctx context.Context, id int64) (*Answer, error)
+	// ListAnswers returns the answers of a question oldest first, leaving
+	// out those a moderator deleted.
+	ListAnswers(ctx context.Context, questionID int64) ([]*Answer, error)
+	// DeleteAnswer deletes the answer with its revisions, comments, votes,
+	// bookmarks, flags, feed activities and notifications.
+	DeleteAnswer(ctx context.Context, id int64) error
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	RevisionTargetQuestion = "question"
+	RevisionTargetAnswer   = "answer"
+)
+
+// Revision is one saved version of a question or answer. Number counts from
+// 1 per post, and the highest number is the post's current content. Title
+// is empty for answers; RollbackOf is the revision a rollback restored.
+// BodyHTML caches Body rendered by the Markdown renderer of RenderVersion.
+type Revision struct {
+	ID            int64
+	TargetType    string
+	TargetID      int64
+	Number        int
+	AuthorID      int64
+	Title         string
+	Body          string
+	Summary       string
+	RollbackOf    int
+	CreatedAt     time.Time
+	BodyHTML      string
+	RenderVersion int
+}
+
+type RevisionRepository interface {
+	// Create saves revision as the next revision of its post, numbering it
+	// under a lock on the post so concurrent edits get consecutive numbers.
+	// When the latest revision already has the same title and body nothing
+	// is saved: revision is set to the latest one and Create returns false.
+	Create(ctx context.Context, revision *Revision) (bool, error)
+	Get(ctx context.Context, targetType string, targetID int64, number int) (*Revision, error)
+	Latest(ctx context.Context, targetType string, targetID int64) (*Revision, error)
+	// LatestOf returns the latest revision of each of the posts, leaving out
+	// posts without revisions.
+	LatestOf(ctx context.Context, targetType string, targetIDs []int64) ([]*Revision, error)
+	// List returns every revision of the post, newest first.
+	List(ctx context.Context, targetType string, targetID int64) ([]*Revision, error)
+	UpdateRendered(ctx context.Context, id int64, html string, version int) error
+}
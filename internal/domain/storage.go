@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+	"io"
+)
+
+// ObjectStorage keeps uploaded files under a key and knows the public URL
+// each key is served from.
+type ObjectStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
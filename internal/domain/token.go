@@ -13,11 +13,14 @@ type TokenPair struct {
 }
 
 type TokenClaims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
-	JTI    string `json:"jti"`
-	Type   string `json:"type"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	JTI       string    `json:"jti"`
+	Type      string    `json:"type"`
+	SessionID string    `json:"sid"`       // JTI of the refresh token the access token was issued for
+	AuthTime  time.Time `json:"auth_time"` // when the user last presented credentials
+	ExpiresAt time.Time `json:"exp"`
 }
 
 type RefreshTokenMetadata struct {
@@ -35,15 +38,55 @@ type VerificationTokenMetadata struct {
 	ExpiresAt time.Time `json:"expires_at"`
 }
 
+// PasswordResetTokenMetadata is stored for a password reset link. Email is
+// checked again on use, so a link sent before an email change stops working.
+type PasswordResetTokenMetadata struct {
+	UserID    int64     `json:"user_id"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// EmailChangeTokenMetadata is stored for the confirmation link sent to a new
+// email address. The change is only applied if the account still has
+// OldEmail when the link is used.
+type EmailChangeTokenMetadata struct {
+	UserID    int64     `json:"user_id"`
+	OldEmail  string    `json:"old_email"`
+	NewEmail  string    `json:"new_email"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
 type TokenRepository interface {
 	StoreRefreshToken(ctx context.Context, metadata *RefreshTokenMetadata, ttl time.Duration) error
 	GetRefreshToken(ctx context.Context, jti string) (*RefreshTokenMetadata, error)
 	ExtendRefreshTokenTTL(ctx context.Context, jti string, ttl time.Duration) error
 	DeleteRefreshToken(ctx context.Context, jti string) error
+	DeleteUserRefreshTokens(ctx context.Context, userID, exceptJTI string) error
 
 	StoreVerificationToken(ctx context.Context, metadata *VerificationTokenMetadata, ttl time.Duration) error
 	GetVerificationToken(ctx context.Context, token string) (*VerificationTokenMetadata, error)
 	DeleteVerificationToken(ctx context.Context, token string) error
+
+	// StorePasswordResetToken replaces any reset token the user already has.
+	StorePasswordResetToken(ctx context.Context, metadata *PasswordResetTokenMetadata, ttl time.Duration) error
+	GetPasswordResetToken(ctx context.Context, token string) (*PasswordResetTokenMetadata, error)
+	// ConsumePasswordResetToken returns and deletes the token in one step, so
+	// a link can only be used once.
+	ConsumePasswordResetToken(ctx context.Context, token string) (*PasswordResetTokenMetadata, error)
+
+	// StoreEmailChangeToken replaces any pending email change of the user.
+	StoreEmailChangeToken(ctx context.Context, metadata *EmailChangeTokenMetadata, ttl time.Duration) error
+	ConsumeEmailChangeToken(ctx context.Context, token string) (*EmailChangeTokenMetadata, error)
+
+	// StoreStreamTicket keeps the claims a stream ticket stands for.
+	StoreStreamTicket(ctx context.Context, ticket string, claims *TokenClaims, ttl time.Duration) error
+	// ConsumeStreamTicket returns and deletes the claims in one step, so a
+	// ticket opens a single stream.
+	ConsumeStreamTicket(ctx context.Context, ticket string) (*TokenClaims, error)
 }
 
 type TokenService interface {
@@ -55,8 +98,19 @@ type TokenService interface {
 	RefreshAccessToken(ctx context.Context, refreshToken string) (*TokenPair, error)
 
 	RevokeToken(ctx context.Context, refreshToken string) error
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) error
 
 	GenerateVerificationToken(ctx context.Context, email string) (string, error)
 	ValidateVerificationToken(ctx context.Context, token string) (string, error)
 	DeleteVerificationToken(ctx context.Context, token string) error
+
+	GeneratePasswordResetToken(ctx context.Context, user *User) (string, error)
+	ValidatePasswordResetToken(ctx context.Context, token string) (*PasswordResetTokenMetadata, error)
+	ConsumePasswordResetToken(ctx context.Context, token string) (*PasswordResetTokenMetadata, error)
+
+	GenerateEmailChangeToken(ctx context.Context, user *User, newEmail string) (string, error)
+	ConsumeEmailChangeToken(ctx context.Context, token string) (*EmailChangeTokenMetadata, error)
+
+	IssueStreamTicket(ctx context.Context, claims *TokenClaims) (string, error)
+	RedeemStreamTicket(ctx context.Context, ticket string) (*TokenClaims, error)
 }
@@ -1,26 +1,76 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
 
 type User struct {
-	ID            int64  `json:"id"`
-	Login         string `json:"login"`
-	Email         string `json:"email"`
-	Role          string `json:"role"`
-	FullName      string `json:"full_name"`
-	Password      string `json:"-"`
-	Rating        int    `json:"rating"`
-	Avatar        string `json:"avatar"`
-	EmailVerified bool   `json:"email_verified"`
-	GoogleID      string `json:"google_id,omitempty"`
+	ID             int64      `json:"id"`
+	Login          string     `json:"login"`
+	Email          string     `json:"email"`
+	Role           string     `json:"role"`
+	FullName       string     `json:"full_name"`
+	Password       string     `json:"-"`
+	Rating         int        `json:"rating"`
+	Avatar         string     `json:"avatar"`
+	EmailVerified  bool       `json:"email_verified"`
+	GoogleID       string     `json:"google_id,omitempty"`
+	HasPassword    bool       `json:"has_password"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LoginChangedAt time.Time  `json:"-"`
+	BannedAt       *time.Time `json:"banned_at,omitempty"`
+}
+
+// UserActivity counts the posts a user has made, leaving out those a
+// moderator deleted.
+type UserActivity struct {
+	Questions int
+	Answers   int
+	Comments  int
+}
+
+// ProfileUpdate holds the fields a user may change on their own profile; nil
+// fields are left untouched. The email is changed separately, once the new
+// address is confirmed.
+type ProfileUpdate struct {
+	FullName *string
+	Avatar   *string
 }
 
 type UserRepository interface {
 	Create(ctx context.Context, user *User) error
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByGoogleID(ctx context.Context, googleID string) (*User, error)
+	GetByLogin(ctx context.Context, login string) (*User, error) // case-insensitive
+	// GetByPreviousLogin returns the current owner of a login given up after since.
+	GetByPreviousLogin(ctx context.Context, login string, since time.Time) (*User, error)
+	// ChangeLogin records the current login in the history and replaces it.
+	ChangeLogin(ctx context.Context, userID int64, login string) error
 	GetByID(ctx context.Context, id int64) (*User, error)
+	// GetByIDs returns the users with ids in no particular order, leaving
+	// out IDs without a user.
+	GetByIDs(ctx context.Context, ids []int64) ([]*User, error)
 	GetAll(ctx context.Context) ([]*User, error)
+	// Activity counts the user's questions, answers and comments.
+	Activity(ctx context.Context, id int64) (*UserActivity, error)
 	Update(ctx context.Context, user *User) error
+	// UpdatePassword sets the password hash and has_password, and marks the
+	// email verified too when verifyEmail is set. No other column is written.
+	UpdatePassword(ctx context.Context, id int64, hash string, verifyEmail bool) error
+	// UpdateProfile writes the non-nil fields of update and nothing else.
+	UpdateProfile(ctx context.Context, id int64, update ProfileUpdate) error
+	// ReplacePasswordHash sets the password hash to newHash only while it is
+	// still oldHash, and reports whether it did.
+	ReplacePasswordHash(ctx context.Context, id int64, oldHash, newHash string) (bool, error)
+	UpdateRole(ctx context.Context, id int64, role string) error
+	// SetBanned bans the user from bannedAt, or lifts the ban when it is nil.
+	SetBanned(ctx context.Context, id int64, bannedAt *time.Time) error
 	Delete(ctx context.Context, id int64) error
 }
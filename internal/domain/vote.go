@@ -0,0 +1,21 @@
+package domain
+
+import "context"
+
+const (
+	VoteUp   = 1
+	VoteDown = -1
+)
+
+type VoteRepository interface {
+	// Get returns userID's vote on the post, 0 when there is none.
+	Get(ctx context.Context, userID int64, targetType string, targetID int64) (int, error)
+	// Set replaces userID's vote on the post with value, removing it when
+	// value is 0, and moves the rating of authorID by the difference in the
+	// same transaction. It returns the author's rating before and after;
+	// both are 0 when authorID is 0.
+	Set(ctx context.Context, userID int64, targetType string, targetID int64, value int, authorID int64) (int, int, error)
+	// Scores returns the sum of the votes on each of the posts, leaving out
+	// posts without votes.
+	Scores(ctx context.Context, targetType string, targetIDs []int64) (map[int64]int, error)
+}
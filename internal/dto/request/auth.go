@@ -3,7 +3,7 @@ package request
 type Register struct {
 	Login    string `json:"login" binding:"required,min=3,max=20,alphanum"`
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=6,max=72"`
+	Password string `json:"password" binding:"required"`
 	FullName string `json:"full_name,omitempty"`
 }
 
@@ -12,8 +12,34 @@ type Login struct {
 	Password string `json:"password" binding:"required,min=6,max=72"`
 }
 
+type ResendVerification struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ForgotPassword struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPassword struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// UpdateUser changes profile fields. A new email only takes effect once it
+// is confirmed and needs the current password (empty for OAuth-only
+// accounts, which need a recent sign-in instead). Avatars have their own
+// endpoints.
 type UpdateUser struct {
-	FullName *string `json:"full_name,omitempty"`
-	Email    *string `json:"email,omitempty" binding:"omitempty,email"`
-	Avatar   *string `json:"avatar,omitempty"`
+	FullName        *string `json:"full_name,omitempty" binding:"omitempty,max=255"`
+	Email           *string `json:"email,omitempty" binding:"omitempty,email"`
+	CurrentPassword string  `json:"current_password,omitempty"`
+}
+
+type ChangeLogin struct {
+	Login string `json:"login" binding:"required,min=3,max=20,alphanum"`
+}
+
+type ChangePassword struct {
+	CurrentPassword string `json:"current_password"` // empty for OAuth-only accounts
+	NewPassword     string `json:"new_password" binding:"required"`
 }
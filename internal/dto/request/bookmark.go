@@ -0,0 +1,22 @@
+package request
+
+type AddBookmark struct {
+	TargetType   string `json:"target_type" binding:"required,oneof=question answer"`
+	TargetID     int64  `json:"target_id" binding:"required,min=1"`
+	CollectionID *int64 `json:"collection_id"`
+}
+
+type ReorderBookmarks struct {
+	CollectionID *int64  `json:"collection_id"`
+	IDs          []int64 `json:"ids" binding:"required,min=1,max=5000"`
+}
+
+type CreateBookmarkCollection struct {
+	Name   string `json:"name" binding:"required,max=100"`
+	Public bool   `json:"public"`
+}
+
+type UpdateBookmarkCollection struct {
+	Name   *string `json:"name" binding:"omitempty,max=100"`
+	Public *bool   `json:"public"`
+}
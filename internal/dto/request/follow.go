@@ -0,0 +1,6 @@
+package request
+
+type Follow struct {
+	TargetType string `json:"target_type" binding:"required,oneof=question category user"`
+	TargetID   int64  `json:"target_id" binding:"required,min=1"`
+}
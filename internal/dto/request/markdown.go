@@ -0,0 +1,5 @@
+package request
+
+type MarkdownPreview struct {
+	Text string `json:"text" binding:"required"`
+}
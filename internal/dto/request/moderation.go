@@ -0,0 +1,17 @@
+package request
+
+type Flag struct {
+	TargetType string `json:"target_type" binding:"required,oneof=question answer comment"`
+	TargetID   int64  `json:"target_id" binding:"required,min=1"`
+	Reason     string `json:"reason" binding:"required,oneof=spam offensive duplicate low_quality"`
+	Details    string `json:"details" binding:"max=500"`
+}
+
+// ModerationAction acts on a flagged post. A warning goes to the post's
+// author.
+type ModerationAction struct {
+	TargetType string `json:"target_type" binding:"required,oneof=question answer comment"`
+	TargetID   int64  `json:"target_id" binding:"required,min=1"`
+	Action     string `json:"action" binding:"required,oneof=dismiss delete lock warn"`
+	Reason     string `json:"reason" binding:"required,max=500"`
+}
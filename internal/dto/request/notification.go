@@ -0,0 +1,9 @@
+package request
+
+type MarkNotificationsRead struct {
+	IDs []int64 `json:"ids" binding:"required,min=1,max=100"`
+}
+
+type UpdateNotificationPreferences struct {
+	Preferences map[string]string `json:"preferences" binding:"required,min=1"`
+}
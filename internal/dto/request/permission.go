@@ -0,0 +1,9 @@
+package request
+
+type SetRolePermissions struct {
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+type AssignRole struct {
+	Role string `json:"role" binding:"required,oneof=user moderator admin"`
+}
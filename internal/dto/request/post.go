@@ -0,0 +1,44 @@
+package request
+
+// Question asks a question. AttachmentIDs are uploads of the author to link
+// to it.
+type Question struct {
+	Title         string  `json:"title" binding:"required,max=255"`
+	Body          string  `json:"body" binding:"required"`
+	CategoryIDs   []int64 `json:"category_ids" binding:"required,min=1,max=5,dive,min=1"`
+	AttachmentIDs []int64 `json:"attachment_ids" binding:"omitempty,dive,min=1"`
+}
+
+// EditQuestion records a new revision of a question; Summary describes the
+// change in the revision history. AttachmentIDs replaces the linked uploads
+// when present, so [] removes them all and leaving it out keeps them.
+type EditQuestion struct {
+	Title         string  `json:"title" binding:"required,max=255"`
+	Body          string  `json:"body" binding:"required"`
+	Summary       string  `json:"summary" binding:"max=300"`
+	AttachmentIDs []int64 `json:"attachment_ids" binding:"omitempty,dive,min=1"`
+}
+
+type Answer struct {
+	Body          string  `json:"body" binding:"required"`
+	AttachmentIDs []int64 `json:"attachment_ids" binding:"omitempty,dive,min=1"`
+}
+
+type EditAnswer struct {
+	Body          string  `json:"body" binding:"required"`
+	Summary       string  `json:"summary" binding:"max=300"`
+	AttachmentIDs []int64 `json:"attachment_ids" binding:"omitempty,dive,min=1"`
+}
+
+// Vote sets the caller's vote on a post: 1 up, -1 down, 0 to take it back.
+type Vote struct {
+	Value *int `json:"value" binding:"required,oneof=-1 0 1"`
+}
+
+type Accept struct {
+	AnswerID int64 `json:"answer_id" binding:"required,min=1"`
+}
+
+type Comment struct {
+	Body string `json:"body" binding:"required,max=600"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type Rollback struct {
+	Revision int    `json:"revision" binding:"required,min=1"`
+	Summary  string `json:"summary" binding:"max=300"`
+}
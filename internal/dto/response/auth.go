@@ -3,4 +3,5 @@ package response
 type Auth struct {
 	AccessToken string `json:"access_token"`
 	ExpiresIn   int64  `json:"expires_in"`
+	CSRFToken   string `json:"csrf_token,omitempty"`
 }
@@ -0,0 +1,21 @@
+package response
+
+import "github.com/RofaBR/Go-Usof/internal/domain"
+
+// Activity adds the public profile of the post's author.
+type Activity struct {
+	*domain.Activity
+	Actor *Profile `json:"actor,omitempty"`
+}
+
+func NewActivities(activities []*domain.Activity, actors map[int64]*domain.User) []Activity {
+	result := make([]Activity, len(activities))
+	for i, a := range activities {
+		result[i] = Activity{Activity: a}
+		if actor, ok := actors[a.ActorID]; ok {
+			profile := NewProfile(actor)
+			result[i].Actor = &profile
+		}
+	}
+	return result
+}
@@ -0,0 +1,21 @@
+package response
+
+import "github.com/RofaBR/Go-Usof/internal/domain"
+
+// Notification adds the public profile of the user who caused it.
+type Notification struct {
+	*domain.Notification
+	Actor *Profile `json:"actor,omitempty"`
+}
+
+func NewNotifications(notifications []*domain.Notification, actors map[int64]*domain.User) []Notification {
+	result := make([]Notification, len(notifications))
+	for i, n := range notifications {
+		result[i] = Notification{Notification: n}
+		if actor := actors[n.ActorID]; actor != nil {
+			profile := NewProfile(actor)
+			result[i].Actor = &profile
+		}
+	}
+	return result
+}
@@ -0,0 +1,126 @@
+package response
+
+import (
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+// Question is a question's current content with its author's public
+// profile. Body is left out of listings.
+type Question struct {
+	ID               int64     `json:"id"`
+	Title            string    `json:"title"`
+	Body             string    `json:"body,omitempty"`
+	BodyHTML         string    `json:"body_html,omitempty"`
+	CategoryIDs      []int64   `json:"category_ids"`
+	Revision         int       `json:"revision"`
+	Score            int       `json:"score"`
+	AcceptedAnswerID int64     `json:"accepted_answer_id,omitempty"`
+	Author           *Profile  `json:"author,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type Answer struct {
+	ID         int64     `json:"id"`
+	QuestionID int64     `json:"question_id"`
+	Body       string    `json:"body"`
+	BodyHTML   string    `json:"body_html"`
+	Revision   int       `json:"revision"`
+	Score      int       `json:"score"`
+	Author     *Profile  `json:"author,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type Comment struct {
+	ID         int64     `json:"id"`
+	TargetType string    `json:"target_type"`
+	TargetID   int64     `json:"target_id"`
+	Body       string    `json:"body"`
+	Author     *Profile  `json:"author,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func NewQuestion(q *domain.Question, authors map[int64]*domain.User, withBody bool) Question {
+	result := Question{
+		ID:               q.ID,
+		Title:            q.Title,
+		CategoryIDs:      q.CategoryIDs,
+		Revision:         q.Revision,
+		Score:            q.Score,
+		AcceptedAnswerID: q.AcceptedAnswerID,
+		CreatedAt:        q.CreatedAt,
+		UpdatedAt:        q.UpdatedAt,
+	}
+	if result.CategoryIDs == nil {
+		result.CategoryIDs = []int64{}
+	}
+	if withBody {
+		result.Body = q.Body
+		result.BodyHTML = q.BodyHTML
+	}
+	if author, ok := authors[q.AuthorID]; ok {
+		profile := NewProfile(author)
+		result.Author = &profile
+	}
+	return result
+}
+
+func NewQuestions(questions []*domain.Question, authors map[int64]*domain.User) []Question {
+	result := make([]Question, len(questions))
+	for i, q := range questions {
+		result[i] = NewQuestion(q, authors, false)
+	}
+	return result
+}
+
+func NewAnswer(a *domain.Answer, authors map[int64]*domain.User) Answer {
+	result := Answer{
+		ID:         a.ID,
+		QuestionID: a.QuestionID,
+		Body:       a.Body,
+		BodyHTML:   a.BodyHTML,
+		Revision:   a.Revision,
+		Score:      a.Score,
+		CreatedAt:  a.CreatedAt,
+		UpdatedAt:  a.UpdatedAt,
+	}
+	if author, ok := authors[a.AuthorID]; ok {
+		profile := NewProfile(author)
+		result.Author = &profile
+	}
+	return result
+}
+
+func NewAnswers(answers []*domain.Answer, authors map[int64]*domain.User) []Answer {
+	result := make([]Answer, len(answers))
+	for i, a := range answers {
+		result[i] = NewAnswer(a, authors)
+	}
+	return result
+}
+
+func NewComment(c *domain.Comment, authors map[int64]*domain.User) Comment {
+	result := Comment{
+		ID:         c.ID,
+		TargetType: c.TargetType,
+		TargetID:   c.TargetID,
+		Body:       c.Body,
+		CreatedAt:  c.CreatedAt,
+	}
+	if author, ok := authors[c.AuthorID]; ok {
+		profile := NewProfile(author)
+		result.Author = &profile
+	}
+	return result
+}
+
+func NewComments(comments []*domain.Comment, authors map[int64]*domain.User) []Comment {
+	result := make([]Comment, len(comments))
+	for i, c := range comments {
+		result[i] = NewComment(c, authors)
+	}
+	return result
+}
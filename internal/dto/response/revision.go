@@ -0,0 +1,47 @@
+package response
+
+import (
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+// Revision is a post revision with its author's public profile. Body and
+// its sanitized HTML rendering are left out of listings.
+type Revision struct {
+	Revision   int       `json:"revision"`
+	Title      string    `json:"title,omitempty"`
+	Body       string    `json:"body,omitempty"`
+	BodyHTML   string    `json:"body_html,omitempty"`
+	Summary    string    `json:"summary"`
+	RollbackOf int       `json:"rollback_of,omitempty"`
+	Author     *Profile  `json:"author,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func NewRevision(r *domain.Revision, authors map[int64]*domain.User, withBody bool) Revision {
+	result := Revision{
+		Revision:   r.Number,
+		Title:      r.Title,
+		Summary:    r.Summary,
+		RollbackOf: r.RollbackOf,
+		CreatedAt:  r.CreatedAt,
+	}
+	if withBody {
+		result.Body = r.Body
+		result.BodyHTML = r.BodyHTML
+	}
+	if author, ok := authors[r.AuthorID]; ok {
+		profile := NewProfile(author)
+		result.Author = &profile
+	}
+	return result
+}
+
+func NewRevisions(revisions []*domain.Revision, authors map[int64]*domain.User) []Revision {
+	result := make([]Revision, len(revisions))
+	for i, r := range revisions {
+		result[i] = NewRevision(r, authors, false)
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+package response
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+// User is the authenticated user's own view of their account.
+type User struct {
+	ID            int64     `json:"id"`
+	Login         string    `json:"login"`
+	Email         string    `json:"email"`
+	FullName      string    `json:"full_name"`
+	Role          string    `json:"role"`
+	Rating        int       `json:"rating"`
+	Avatar        string    `json:"avatar"`
+	EmailVerified bool      `json:"email_verified"`
+	HasPassword   bool      `json:"has_password"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Profile is what anyone can see about a user; it must not expose email,
+// verification state or linked accounts.
+type Profile struct {
+	ID       int64     `json:"id"`
+	Login    string    `json:"login"`
+	FullName string    `json:"full_name"`
+	Role     string    `json:"role"`
+	Rating   int       `json:"rating"`
+	Avatar   string    `json:"avatar"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// PublicProfile is the response of GET /users/:user.
+type PublicProfile struct {
+	Profile
+	Activity UserActivity `json:"activity"`
+}
+
+type UserActivity struct {
+	Questions int `json:"questions"`
+	Answers   int `json:"answers"`
+	Comments  int `json:"comments"`
+}
+
+func NewPublicProfile(u *domain.User, activity *domain.UserActivity) PublicProfile {
+	return PublicProfile{
+		Profile: NewProfile(u),
+		Activity: UserActivity{
+			Questions: activity.Questions,
+			Answers:   activity.Answers,
+			Comments:  activity.Comments,
+		},
+	}
+}
+
+func NewUser(u *domain.User) User {
+	return User{
+		ID:            u.ID,
+		Login:         u.Login,
+		Email:         u.Email,
+		FullName:      u.FullName,
+		Role:          u.Role,
+		Rating:        u.Rating,
+		Avatar:        AvatarURL(u),
+		EmailVerified: u.EmailVerified,
+		HasPassword:   u.HasPassword,
+		CreatedAt:     u.CreatedAt,
+	}
+}
+
+func NewProfile(u *domain.User) Profile {
+	return Profile{
+		ID:       u.ID,
+		Login:    u.Login,
+		FullName: u.FullName,
+		Role:     u.Role,
+		Rating:   u.Rating,
+		Avatar:   AvatarURL(u),
+		JoinedAt: u.CreatedAt,
+	}
+}
+
+// AvatarURL is the user's uploaded avatar or, without one, the path of the
+// generated identicon served by the API.
+func AvatarURL(u *domain.User) string {
+	if u.Avatar != "" {
+		return u.Avatar
+	}
+	return "/api/users/" + strconv.FormatInt(u.ID, 10) + "/avatar"
+}
+
+// ProfileUpdated is the response of PATCH /users/me. PendingEmail is set
+// when a confirmation link was sent to a new address.
+type ProfileUpdated struct {
+	User
+	PendingEmail string `json:"pending_email,omitempty"`
+}
+
+// Me is the response of GET /users/me.
+type Me struct {
+	User
+	UnreadNotifications int `json:"unread_notifications"`
+}
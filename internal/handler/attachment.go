@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type AttachmentHandler struct {
+	attachmentService *services.AttachmentService
+	imageService      *services.ImageService
+	log               *logger.Logger
+}
+
+func NewAttachmentHandler(attachmentService *services.AttachmentService, imageService *services.ImageService, log *logger.Logger) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+		imageService:      imageService,
+		log:               log,
+	}
+}
+
+// Upload stores an image to embed in a question or answer. The returned URL
+// can be used in the post body right away; the upload is deleted unless a
+// saved post references it within ATTACHMENT_ORPHAN_TTL hours.
+func (h *AttachmentHandler) Upload(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling attachment upload request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	file, ok := formImage(c, "image", h.imageService.MaxUploadSize())
+	if !ok {
+		h.log.Warn("failed to read attachment file", "userId", userID)
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentService.Upload(ctx, userID, file)
+	if status, ok := imageErrorStatus(err); ok {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, services.ErrAttachmentQuotaExceeded) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		h.log.Error("failed to upload attachment", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload image"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachment)
+}
+
+func (h *AttachmentHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list attachments request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	attachments, usage, err := h.attachmentService.List(ctx, userID)
+	if err != nil {
+		h.log.Error("failed to list attachments", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve attachments"})
+		return
+	}
+
+	quota := h.attachmentService.Quota()
+	c.JSON(http.StatusOK, gin.H{
+		"attachments": attachments,
+		"usage":       usage,
+		"quota": gin.H{
+			"files": quota.MaxFiles,
+			"bytes": quota.MaxBytes,
+		},
+	})
+}
+
+func (h *AttachmentHandler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling delete attachment request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	err = h.attachmentService.Delete(ctx, userID, id)
+	if errors.Is(err, services.ErrAttachmentNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, services.ErrAttachmentInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		h.log.Error("failed to delete attachment", "userId", userID, "attachmentId", id, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete attachment"})
+		return
+	}
+
+	h.log.Info("attachment deleted", "userId", userID, "attachmentId", id)
+	c.JSON(http.StatusOK, gin.H{"message": "Attachment deleted successfully"})
+}
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/RofaBR/Go-Usof/internal/domain"
 	"github.com/RofaBR/Go-Usof/internal/dto/request"
@@ -14,15 +16,19 @@ import (
 type AuthHandler struct {
 	userService  *services.UserService
 	tokenService *services.TokenService
-	emailService *services.SMTPSender
+	emailService *services.MailService
+	loginGuard   *services.LoginGuardService
+	cookies      *Cookies
 	log          *logger.Logger
 }
 
-func NewAuthHandler(userService *services.UserService, tokenService *services.TokenService, emailService *services.SMTPSender, log *logger.Logger) *AuthHandler {
+func NewAuthHandler(userService *services.UserService, tokenService *services.TokenService, emailService *services.MailService, loginGuard *services.LoginGuardService, cookies *Cookies, log *logger.Logger) *AuthHandler {
 	return &AuthHandler{
 		userService:  userService,
 		tokenService: tokenService,
 		emailService: emailService,
+		loginGuard:   loginGuard,
+		cookies:      cookies,
 		log:          log,
 	}
 }
@@ -47,9 +53,27 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	err := h.userService.Create(ctx, user)
-	if err != nil {
+	var policyErr *services.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		h.log.Warn("registration rejected by password policy", "email", req.Email)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "Password does not meet the password policy",
+			"violations": policyErr.Violations,
+		})
+		return
+	}
+	switch {
+	case errors.Is(err, services.ErrEmailTaken), errors.Is(err, services.ErrLoginTaken):
+		h.log.Warn("registration rejected", "email", req.Email, "login", req.Login, "error", err)
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, services.ErrLoginInvalid), errors.Is(err, services.ErrLoginReserved):
+		h.log.Warn("registration rejected", "login", req.Login, "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err != nil:
 		h.log.Error("failed to create user", "email", req.Email, "error", err)
-		c.JSON(400, gin.H{"error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
 		return
 	}
 
@@ -60,11 +84,11 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
-	err = h.emailService.SendVerificationEmail(ctx, user.Email, token)
-	if err != nil {
-		h.log.Error("failed to send verification email", "email", user.Email, "error", err)
-		c.JSON(500, gin.H{"error": "User created but failed to send verification email"})
-		return
+	// The email is delivered by the outbox worker. The account already
+	// exists, so a failure to queue it must not fail the registration; the
+	// user can ask for a new one through POST /auth/verify/resend.
+	if err := h.emailService.SendVerificationEmail(ctx, user.Email, token); err != nil {
+		h.log.Error("failed to queue verification email", "email", user.Email, "error", err)
 	}
 
 	h.log.Info("user registration completed successfully", "email", user.Email)
@@ -84,10 +108,33 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userService.ValidateCredentials(ctx, req.Email, req.Password)
-	if err != nil {
+	user, err := h.userService.ValidateCredentials(ctx, req.Email, req.Password, c.ClientIP())
+	var lockoutErr *services.LockoutError
+	if errors.As(err, &lockoutErr) {
+		retryAfter := int(lockoutErr.RetryAfter.Seconds()) + 1
+		h.log.Warn("login rejected by guard", "email", req.Email, "retry_after", retryAfter)
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": lockoutErr.Error()})
+		return
+	}
+	switch {
+	case errors.Is(err, services.ErrInvalidCredentials):
+		h.log.Warn("credential validation failed", "email", req.Email, "error", err)
+		c.JSON(401, gin.H{"error": "Invalid email or password"})
+		return
+	case errors.Is(err, services.ErrEmailNotVerified):
+		h.log.Warn("credential validation failed", "email", req.Email, "error", err)
+		c.JSON(401, gin.H{"error": "Email not verified"})
+		return
+	case errors.Is(err, services.ErrUserBanned):
 		h.log.Warn("credential validation failed", "email", req.Email, "error", err)
-		c.JSON(401, gin.H{"error": err.Error()})
+		c.JSON(403, gin.H{"error": "Account is banned"})
+		return
+	case err != nil:
+		// Guard and database failures carry Redis and SQL details that must
+		// stay in the logs.
+		h.log.Error("credential validation failed", "email", req.Email, "error", err)
+		c.JSON(500, gin.H{"error": "Login failed"})
 		return
 	}
 
@@ -98,20 +145,18 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(
-		"refresh_token",
-		tokenPair.RefreshToken,
-		int(tokenPair.RefreshExpiresIn),
-		"/",
-		"",
-		false,
-		true,
-	)
+	csrfToken, err := h.cookies.SetSession(c, tokenPair.RefreshToken, int(tokenPair.RefreshExpiresIn))
+	if err != nil {
+		h.log.Error("failed to issue session cookies", "email", req.Email, "user_id", user.ID, "error", err)
+		c.JSON(500, gin.H{"error": "Failed to generate authentication tokens"})
+		return
+	}
 
 	h.log.Info("user login successful", "email", req.Email, "user_id", user.ID)
 	c.JSON(http.StatusOK, response.Auth{
 		AccessToken: tokenPair.AccessToken,
 		ExpiresIn:   tokenPair.ExpiresIn,
+		CSRFToken:   csrfToken,
 	})
 }
 
@@ -119,7 +164,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	ctx := c.Request.Context()
 	h.log.Info("handling user logout request")
 
-	refreshToken, err := c.Cookie("refresh_token")
+	refreshToken, err := c.Cookie(refreshTokenCookie)
 	if err != nil {
 		h.log.Warn("no refresh token found in cookie")
 		c.JSON(http.StatusBadRequest, gin.H{"error": "No refresh token found"})
@@ -132,7 +177,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie("refresh_token", "", -1, "/", "", false, true)
+	h.cookies.ClearSession(c)
 	h.log.Info("user logged out successfully")
 	c.Status(http.StatusNoContent)
 }
@@ -141,7 +186,7 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 	ctx := c.Request.Context()
 	h.log.Info("handling token refresh request")
 
-	refreshToken, err := c.Cookie("refresh_token")
+	refreshToken, err := c.Cookie(refreshTokenCookie)
 	if err != nil {
 		h.log.Warn("no refresh token found in cookie")
 		c.JSON(http.StatusBadRequest, gin.H{"error": "No refresh token found"})
@@ -155,10 +200,18 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
+	csrfToken, err := h.cookies.SetSession(c, tokenPair.RefreshToken, int(tokenPair.RefreshExpiresIn))
+	if err != nil {
+		h.log.Error("failed to issue session cookies", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh session"})
+		return
+	}
+
 	h.log.Info("token refreshed successfully")
 	c.JSON(http.StatusOK, response.Auth{
 		AccessToken: tokenPair.AccessToken,
 		ExpiresIn:   tokenPair.ExpiresIn,
+		CSRFToken:   csrfToken,
 	})
 }
 
@@ -195,3 +248,242 @@ func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 		"message": "Email verified successfully",
 	})
 }
+
+// ResendVerification sends a fresh verification link. Like ForgotPassword it
+// answers 202 whether or not the email belongs to an unverified account, so
+// it can't be used to find out which addresses are registered.
+func (h *AuthHandler) ResendVerification(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling resend verification request")
+
+	var req request.ResendVerification
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid resend verification request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accepted := gin.H{"message": "If an unverified account with this email exists, a verification link has been sent."}
+
+	user, err := h.userService.GetByEmail(ctx, req.Email)
+	if err != nil {
+		h.log.Error("failed to look up user for verification resend", "email", req.Email, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to resend verification email"})
+		return
+	}
+	if user == nil || user.EmailVerified {
+		h.log.Info("verification resend skipped", "email", req.Email, "known", user != nil)
+		c.JSON(http.StatusAccepted, accepted)
+		return
+	}
+
+	token, err := h.tokenService.GenerateVerificationToken(ctx, user.Email)
+	if err != nil {
+		h.log.Error("failed to generate verification token", "user_id", user.ID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to resend verification email"})
+		return
+	}
+
+	if err := h.emailService.SendVerificationEmail(ctx, user.Email, token); err != nil {
+		h.log.Error("failed to queue verification email", "user_id", user.ID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to resend verification email"})
+		return
+	}
+
+	h.log.Info("verification email resent", "user_id", user.ID)
+	c.JSON(http.StatusAccepted, accepted)
+}
+
+// ConfirmEmailChange applies an email change requested through PATCH
+// /users/me once the link sent to the new address is opened.
+func (h *AuthHandler) ConfirmEmailChange(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling email change confirmation request")
+
+	token := c.Query("token")
+	if token == "" {
+		h.log.Warn("missing token parameter")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token parameter is required"})
+		return
+	}
+
+	change, err := h.tokenService.ConsumeEmailChangeToken(ctx, token)
+	if err != nil {
+		h.log.Warn("invalid or expired email change token", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired email change token"})
+		return
+	}
+
+	user, err := h.userService.ConfirmEmailChange(ctx, change)
+	switch {
+	case errors.Is(err, services.ErrInvalidEmailChangeToken):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired email change token"})
+		return
+	case errors.Is(err, services.ErrEmailTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		h.log.Error("failed to change email", "user_id", change.UserID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change email"})
+		return
+	}
+
+	h.log.Info("email changed", "user_id", user.ID)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Email changed successfully",
+	})
+}
+
+func (h *AuthHandler) Unlock(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling account unlock request")
+
+	token := c.Query("token")
+	if token == "" {
+		h.log.Warn("missing token parameter")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token parameter is required"})
+		return
+	}
+
+	if err := h.loginGuard.Unlock(ctx, token); err != nil {
+		h.log.Warn("failed to unlock account", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired unlock token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Account unlocked successfully",
+	})
+}
+
+// ForgotPassword emails a password reset link. The response is the same
+// whether or not the address belongs to an account.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling forgot password request")
+
+	var req request.ForgotPassword
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid forgot password request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accepted := gin.H{"message": "If an account with this email exists, a password reset link has been sent."}
+
+	user, err := h.userService.GetByEmail(ctx, req.Email)
+	if err != nil {
+		h.log.Error("failed to look up user for password reset", "email", req.Email, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request password reset"})
+		return
+	}
+	if user == nil {
+		h.log.Info("password reset requested for unknown email", "email", req.Email)
+		c.JSON(http.StatusAccepted, accepted)
+		return
+	}
+
+	token, err := h.tokenService.GeneratePasswordResetToken(ctx, user)
+	if err != nil {
+		h.log.Error("failed to generate password reset token", "user_id", user.ID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request password reset"})
+		return
+	}
+
+	if err := h.emailService.SendPasswordResetEmail(ctx, user.Email, token); err != nil {
+		h.log.Error("failed to queue password reset email", "user_id", user.ID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request password reset"})
+		return
+	}
+
+	h.log.Info("password reset requested", "user_id", user.ID)
+	c.JSON(http.StatusAccepted, accepted)
+}
+
+// CheckPasswordReset tells a client opening the emailed link whether the
+// token is still usable, without using it up.
+func (h *AuthHandler) CheckPasswordReset(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling password reset token check")
+
+	token := c.Query("token")
+	if token == "" {
+		h.log.Warn("missing token parameter")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token parameter is required"})
+		return
+	}
+
+	if _, err := h.tokenService.ValidatePasswordResetToken(ctx, token); err != nil {
+		h.log.Warn("invalid or expired password reset token", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired password reset token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Token is valid. Send it with the new password to POST /api/auth/password/reset.",
+	})
+}
+
+// ResetPassword sets a new password with the token from the emailed link and
+// signs the account out everywhere.
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling password reset request")
+
+	var req request.ResetPassword
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid password reset request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The token is used up only once the new password has been accepted, so
+	// a password rejected by the policy does not cost the user the link. It
+	// is used up before the password changes, so a link resets it once.
+	reset, err := h.tokenService.ValidatePasswordResetToken(ctx, req.Token)
+	if err != nil {
+		h.log.Warn("invalid or expired password reset token", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired password reset token"})
+		return
+	}
+
+	user, err := h.userService.CheckPasswordReset(ctx, reset, req.NewPassword)
+	var policyErr *services.PasswordPolicyError
+	switch {
+	case errors.As(err, &policyErr):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "Password does not meet the password policy",
+			"violations": policyErr.Violations,
+		})
+		return
+	case errors.Is(err, services.ErrInvalidResetToken):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired password reset token"})
+		return
+	case err != nil:
+		h.log.Error("failed to reset password", "user_id", reset.UserID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reset password"})
+		return
+	}
+
+	if _, err := h.tokenService.ConsumePasswordResetToken(ctx, req.Token); err != nil {
+		h.log.Warn("password reset token already used or expired", "user_id", user.ID, "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired password reset token"})
+		return
+	}
+	if err := h.userService.ResetPassword(ctx, user, req.NewPassword); err != nil {
+		h.log.Error("failed to reset password", "user_id", user.ID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reset password"})
+		return
+	}
+	if err := h.tokenService.RevokeOtherSessions(ctx, strconv.FormatInt(user.ID, 10), ""); err != nil {
+		h.log.Error("password reset but failed to revoke sessions", "user_id", user.ID, "error", err)
+	}
+	if err := h.loginGuard.Clear(ctx, user.Email); err != nil {
+		h.log.Warn("failed to clear login lockout after password reset", "user_id", user.ID, "error", err)
+	}
+
+	h.log.Info("password reset completed", "user_id", user.ID)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Password reset successfully. All sessions have been signed out.",
+	})
+}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type BookmarkHandler struct {
+	bookmarkService *services.BookmarkService
+	log             *logger.Logger
+}
+
+func NewBookmarkHandler(bookmarkService *services.BookmarkService, log *logger.Logger) *BookmarkHandler {
+	return &BookmarkHandler{
+		bookmarkService: bookmarkService,
+		log:             log,
+	}
+}
+
+// List returns the bookmarks of ?collection_id=, or those outside any
+// collection without it, in their saved order.
+func (h *BookmarkHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list bookmarks request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var collectionID *int64
+	if raw := c.Query("collection_id"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+			return
+		}
+		collectionID = &id
+	}
+
+	bookmarks, err := h.bookmarkService.List(ctx, userID, collectionID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve bookmarks")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"bookmarks": bookmarks})
+}
+
+// Add bookmarks a question or answer, or moves an existing bookmark of it
+// to the given collection.
+func (h *BookmarkHandler) Add(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling add bookmark request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.AddBookmark
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bookmark, created, err := h.bookmarkService.Add(ctx, userID, req.TargetType, req.TargetID, req.CollectionID)
+	if err != nil {
+		h.respondError(c, err, "Failed to save bookmark")
+		return
+	}
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	c.JSON(status, bookmark)
+}
+
+func (h *BookmarkHandler) Remove(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling remove bookmark request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bookmark ID"})
+		return
+	}
+
+	if err := h.bookmarkService.Remove(ctx, userID, id); err != nil {
+		h.respondError(c, err, "Failed to remove bookmark")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Bookmark removed successfully"})
+}
+
+func (h *BookmarkHandler) Reorder(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling reorder bookmarks request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.ReorderBookmarks
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bookmarks, err := h.bookmarkService.Reorder(ctx, userID, req.CollectionID, req.IDs)
+	if err != nil {
+		h.respondError(c, err, "Failed to reorder bookmarks")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"bookmarks": bookmarks})
+}
+
+func (h *BookmarkHandler) ListCollections(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list bookmark collections request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	collections, err := h.bookmarkService.Collections(ctx, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve collections"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"collections": collections})
+}
+
+func (h *BookmarkHandler) CreateCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling create bookmark collection request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.CreateBookmarkCollection
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collection, err := h.bookmarkService.CreateCollection(ctx, userID, req.Name, req.Public)
+	if err != nil {
+		h.respondError(c, err, "Failed to create collection")
+		return
+	}
+	c.JSON(http.StatusCreated, collection)
+}
+
+// UpdateCollection renames a collection or makes it public or private.
+func (h *BookmarkHandler) UpdateCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling update bookmark collection request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+		return
+	}
+	var req request.UpdateBookmarkCollection
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collection, err := h.bookmarkService.UpdateCollection(ctx, userID, id, req.Name, req.Public)
+	if err != nil {
+		h.respondError(c, err, "Failed to update collection")
+		return
+	}
+	c.JSON(http.StatusOK, collection)
+}
+
+func (h *BookmarkHandler) DeleteCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling delete bookmark collection request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+		return
+	}
+
+	if err := h.bookmarkService.DeleteCollection(ctx, userID, id); err != nil {
+		h.respondError(c, err, "Failed to delete collection")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Collection deleted successfully"})
+}
+
+// GetSharedCollection shows a public collection to anyone with its share
+// token. Owners see their private collections through /bookmarks.
+func (h *BookmarkHandler) GetSharedCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling get shared collection request")
+
+	collection, bookmarks, err := h.bookmarkService.SharedCollection(ctx, c.Param("token"))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve collection")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"collection": collection, "bookmarks": bookmarks})
+}
+
+func (h *BookmarkHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrBookmarkNotFound), errors.Is(err, services.ErrCollectionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidBookmarkTarget), errors.Is(err, services.ErrInvalidBookmarkOrder),
+		errors.Is(err, services.ErrInvalidCollectionName):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrCollectionNameTaken), errors.Is(err, services.ErrTooManyBookmarks),
+		errors.Is(err, services.ErrTooManyBookmarkCollections):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type CommentHandler struct {
+	commentService *services.CommentService
+	log            *logger.Logger
+}
+
+func NewCommentHandler(commentService *services.CommentService, log *logger.Logger) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+		log:            log,
+	}
+}
+
+func (h *CommentHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list comments request")
+
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+
+	comments, err := h.commentService.List(ctx, c.Param("type"), id)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve comments")
+		return
+	}
+	authors, err := h.commentService.Authors(ctx, comments)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve comments"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"comments": response.NewComments(comments, authors)})
+}
+
+func (h *CommentHandler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling create comment request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+	var req request.Comment
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comment, err := h.commentService.Create(ctx, userID, c.Param("type"), id, req.Body)
+	if err != nil {
+		h.respondError(c, err, "Failed to post comment")
+		return
+	}
+	authors, err := h.commentService.Authors(ctx, []*domain.Comment{comment})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to post comment"})
+		return
+	}
+	c.JSON(http.StatusCreated, response.NewComment(comment, authors))
+}
+
+func (h *CommentHandler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling delete comment request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
+	}
+
+	if err := h.commentService.Delete(ctx, userID, id); err != nil {
+		h.respondError(c, err, "Failed to delete comment")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *CommentHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrCommentNotFound),
+		errors.Is(err, services.ErrQuestionNotFound),
+		errors.Is(err, services.ErrAnswerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidComment),
+		errors.Is(err, services.ErrInvalidPostTarget):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrCommentForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrPostLocked),
+		errors.Is(err, services.ErrPostDeleted):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	refreshTokenCookie = "refresh_token"
+	oauthStateCookie   = "oauth_state"
+)
+
+// Cookies writes every cookie the API issues so that Secure, SameSite,
+// Domain and Path come from one place.
+type Cookies struct {
+	config   config.CookieConfig
+	sameSite http.SameSite
+}
+
+func NewCookies(cfg config.CookieConfig) *Cookies {
+	sameSite := http.SameSiteLaxMode
+	switch cfg.SameSite {
+	case "strict":
+		sameSite = http.SameSiteStrictMode
+	case "none":
+		sameSite = http.SameSiteNoneMode
+	}
+	return &Cookies{config: cfg, sameSite: sameSite}
+}
+
+// SetSession stores the refresh token and issues a fresh CSRF token that the
+// client must echo in the X-CSRF-Token header. The CSRF token is returned so
+// it can also be sent in the response body.
+func (k *Cookies) SetSession(c *gin.Context, refreshToken string, maxAge int) (string, error) {
+	csrfToken, err := generateCSRFToken()
+	if err != nil {
+		return "", err
+	}
+	k.set(c, refreshTokenCookie, refreshToken, maxAge, true, k.sameSite)
+	k.set(c, middleware.CSRFCookieName, csrfToken, maxAge, false, k.sameSite)
+	return csrfToken, nil
+}
+
+func (k *Cookies) ClearSession(c *gin.Context) {
+	k.set(c, refreshTokenCookie, "", -1, true, k.sameSite)
+	k.set(c, middleware.CSRFCookieName, "", -1, false, k.sameSite)
+}
+
+// SetOAuthState always uses SameSite=Lax, otherwise the cookie would not be
+// sent back on the top-level redirect from the provider.
+func (k *Cookies) SetOAuthState(c *gin.Context, state string, maxAge int) {
+	k.set(c, oauthStateCookie, state, maxAge, true, http.SameSiteLaxMode)
+}
+
+func (k *Cookies) ClearOAuthState(c *gin.Context) {
+	k.set(c, oauthStateCookie, "", -1, true, http.SameSiteLaxMode)
+}
+
+func (k *Cookies) set(c *gin.Context, name, value string, maxAge int, httpOnly bool, sameSite http.SameSite) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		MaxAge:   maxAge,
+		Path:     k.config.Path,
+		Domain:   k.config.Domain,
+		Secure:   k.config.Secure,
+		HttpOnly: httpOnly,
+		SameSite: sameSite,
+	})
+}
+
+func generateCSRFToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type FollowHandler struct {
+	followService *services.FollowService
+	log           *logger.Logger
+}
+
+func NewFollowHandler(followService *services.FollowService, log *logger.Logger) *FollowHandler {
+	return &FollowHandler{
+		followService: followService,
+		log:           log,
+	}
+}
+
+func (h *FollowHandler) Follow(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling follow request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.Follow
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	target := domain.FollowTarget{Type: req.TargetType, ID: req.TargetID}
+
+	created, err := h.followService.Follow(ctx, userID, target)
+	switch {
+	case errors.Is(err, services.ErrFollowTargetNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, services.ErrInvalidFollowTarget), errors.Is(err, services.ErrCannotFollowSelf):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, services.ErrTooManyFollows):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to follow"})
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	c.JSON(status, gin.H{"target_type": target.Type, "target_id": target.ID, "following": true})
+}
+
+func (h *FollowHandler) Unfollow(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling unfollow request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid target ID"})
+		return
+	}
+	target := domain.FollowTarget{Type: c.Param("type"), ID: id}
+
+	deleted, err := h.followService.Unfollow(ctx, userID, target)
+	if errors.Is(err, services.ErrInvalidFollowTarget) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unfollow"})
+		return
+	}
+	if !deleted {
+		c.JSON(http.StatusNotFound, gin.H{"error": "You are not following this"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"target_type": target.Type, "target_id": target.ID, "following": false})
+}
+
+// Followers returns how many users follow the target in the path.
+func (h *FollowHandler) Followers(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling followers request")
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid target ID"})
+		return
+	}
+	target := domain.FollowTarget{Type: c.Param("type"), ID: id}
+
+	count, err := h.followService.Followers(ctx, target)
+	if errors.Is(err, services.ErrInvalidFollowTarget) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count followers"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"target_type": target.Type, "target_id": target.ID, "followers": count})
+}
+
+// List returns what the current user follows, newest first; ?type= limits
+// it to one kind of target.
+func (h *FollowHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list follows request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	follows, err := h.followService.List(ctx, userID, c.Query("type"))
+	if errors.Is(err, services.ErrInvalidFollowTarget) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve follows"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"follows": follows})
+}
+
+// Feed returns new questions, answers and comments in everything the
+// current user follows, newest first. Pages are requested with
+// ?before=<next_cursor of the previous page>.
+func (h *FollowHandler) Feed(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling feed request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var before int64
+	if raw := c.Query("before"); raw != "" {
+		var err error
+		if before, err = strconv.ParseInt(raw, 10, 64); err != nil || before <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid cursor"})
+			return
+		}
+	}
+	limit := services.DefaultFeedPageSize
+	if raw := c.Query("limit"); raw != "" {
+		var err error
+		if limit, err = strconv.Atoi(raw); err != nil || limit <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+	}
+
+	activities, actors, err := h.followService.Feed(ctx, userID, before, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve feed"})
+		return
+	}
+
+	var nextCursor *int64
+	if len(activities) == min(limit, services.MaxFeedPageSize) {
+		nextCursor = &activities[len(activities)-1].ID
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"activities":  response.NewActivities(activities, actors),
+		"next_cursor": nextCursor,
+	})
+}
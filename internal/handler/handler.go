@@ -1,24 +1,47 @@
 package handler
 
 import (
+	"github.com/RofaBR/Go-Usof/internal/config"
 	"github.com/RofaBR/Go-Usof/internal/services"
 	"github.com/RofaBR/Go-Usof/pkg/logger"
 )
 
 type Handler struct {
-	Health   *HealthHandler
-	Auth     *AuthHandler
-	OAuth2   *OAuth2Handler
-	User     *UserHandler
-	Category *CategoryHandler
+	Health       *HealthHandler
+	Auth         *AuthHandler
+	OAuth2       *OAuth2Handler
+	User         *UserHandler
+	Category     *CategoryHandler
+	Attachment   *AttachmentHandler
+	Notification *NotificationHandler
+	Follow       *FollowHandler
+	Bookmark     *BookmarkHandler
+	Revision     *RevisionHandler
+	Markdown     *MarkdownHandler
+	Moderation   *ModerationHandler
+	Permission   *PermissionHandler
+	Post         *PostHandler
+	Comment      *CommentHandler
 }
 
-func NewHandler(log *logger.Logger, svc *services.Service) *Handler {
+func NewHandler(log *logger.Logger, svc *services.Service, cfg *config.Config) *Handler {
+	cookies := NewCookies(cfg.Cookie)
+
 	return &Handler{
-		Health:   NewHealthHandler(log),
-		Auth:     NewAuthHandler(svc.User, svc.Token, svc.Email, log),
-		OAuth2:   NewOAuth2Handler(svc.OAuth2, svc.Token, log),
-		User:     NewUserHandler(svc.User, svc.Image, svc.Token, log),
-		Category: NewCategoryHandler(svc.Category, log),
+		Health:       NewHealthHandler(log),
+		Auth:         NewAuthHandler(svc.User, svc.Token, svc.Email, svc.LoginGuard, cookies, log),
+		OAuth2:       NewOAuth2Handler(svc.OAuth2, svc.Token, cookies, log),
+		User:         NewUserHandler(svc.User, svc.Username, svc.Image, svc.Token, svc.Email, svc.Notification, log),
+		Category:     NewCategoryHandler(svc.Category, log),
+		Attachment:   NewAttachmentHandler(svc.Attachment, svc.Image, log),
+		Notification: NewNotificationHandler(svc.Notification, svc.NotificationEmail, svc.Hub, svc.Token, cfg.Notification, log),
+		Follow:       NewFollowHandler(svc.Follow, log),
+		Bookmark:     NewBookmarkHandler(svc.Bookmark, log),
+		Revision:     NewRevisionHandler(svc.Revision, svc.Post, log),
+		Markdown:     NewMarkdownHandler(svc.Markdown, log),
+		Moderation:   NewModerationHandler(svc.Moderation, log),
+		Permission:   NewPermissionHandler(svc.Permission, log),
+		Post:         NewPostHandler(svc.Post, log),
+		Comment:      NewCommentHandler(svc.Comment, log),
 	}
 }
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type MarkdownHandler struct {
+	markdownService *services.MarkdownService
+	log             *logger.Logger
+}
+
+func NewMarkdownHandler(markdownService *services.MarkdownService, log *logger.Logger) *MarkdownHandler {
+	return &MarkdownHandler{
+		markdownService: markdownService,
+		log:             log,
+	}
+}
+
+// previewBodyOverhead leaves room for the JSON around the text in preview
+// requests.
+const previewBodyOverhead = 1 << 10
+
+// Preview renders Markdown the same way post bodies are rendered, so editors
+// can show what a post will look like before it is saved.
+func (h *MarkdownHandler) Preview(c *gin.Context) {
+	h.log.Info("handling markdown preview request")
+
+	// The length check in the service runs on the decoded text; cap the body
+	// first so an oversized request is never read into memory. A character
+	// takes at most 6 bytes in JSON (\uXXXX).
+	maxBody := int64(h.markdownService.MaxLength())*6 + previewBodyOverhead
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBody)
+
+	var req request.MarkdownPreview
+	if err := c.ShouldBindJSON(&req); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.respondTooLong(c)
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	html, err := h.markdownService.Preview(req.Text)
+	if err != nil {
+		if errors.Is(err, services.ErrMarkdownTooLong) {
+			h.respondTooLong(c)
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render preview"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"html": html})
+}
+
+func (h *MarkdownHandler) respondTooLong(c *gin.Context) {
+	c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+		"error": fmt.Sprintf("Text must be at most %d characters", h.markdownService.MaxLength()),
+	})
+}
+
+// Stylesheet serves the CSS for the syntax highlighting classes in rendered
+// code blocks.
+func (h *MarkdownHandler) Stylesheet(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Data(http.StatusOK, "text/css; charset=utf-8", []byte(h.markdownService.Stylesheet()))
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type ModerationHandler struct {
+	moderationService *services.ModerationService
+	log               *logger.Logger
+}
+
+func NewModerationHandler(moderationService *services.ModerationService, log *logger.Logger) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+		log:               log,
+	}
+}
+
+func (h *ModerationHandler) Flag(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling flag request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.Flag
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	flag := &domain.Flag{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		ReporterID: userID,
+		Reason:     req.Reason,
+		Details:    strings.TrimSpace(req.Details),
+	}
+	if err := h.moderationService.Flag(ctx, flag); err != nil {
+		h.respondError(c, err, "Failed to flag post")
+		return
+	}
+	c.JSON(http.StatusCreated, flag)
+}
+
+// Queue lists posts with pending flags, most flagged first. ?reason= limits
+// it to one reason; pages are requested with ?limit= and ?offset=.
+func (h *ModerationHandler) Queue(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling moderation queue request")
+
+	limit, ok := queryInt(c, "limit", services.DefaultQueuePageSize)
+	if !ok {
+		return
+	}
+	offset, ok := queryInt(c, "offset", 0)
+	if !ok {
+		return
+	}
+
+	posts, total, err := h.moderationService.Queue(ctx, c.Query("reason"), limit, offset)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve moderation queue")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "total": total})
+}
+
+// Review returns the flags of a post together with the actions already taken
+// on it.
+func (h *ModerationHandler) Review(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling moderation review request")
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	flags, actions, err := h.moderationService.Review(ctx, c.Param("type"), id)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve flags")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"flags": flags, "actions": actions})
+}
+
+func (h *ModerationHandler) Act(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling moderation action request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.ModerationAction
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	action := &domain.ModerationAction{
+		TargetType:  req.TargetType,
+		TargetID:    req.TargetID,
+		ModeratorID: userID,
+		Action:      req.Action,
+		Reason:      strings.TrimSpace(req.Reason),
+	}
+	resolved, err := h.moderationService.Act(ctx, action)
+	if err != nil {
+		h.respondError(c, err, "Failed to record moderation action")
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"action": action, "resolved_flags": resolved})
+}
+
+// Ban bans the user in the path and signs them out.
+func (h *ModerationHandler) Ban(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling ban request")
+
+	_, actorID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	user, err := h.moderationService.Ban(ctx, actorID, userID)
+	if err != nil {
+		h.respondError(c, err, "Failed to ban user")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"user": response.NewProfile(user), "banned_at": user.BannedAt})
+}
+
+func (h *ModerationHandler) Unban(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling unban request")
+
+	_, actorID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if _, err := h.moderationService.Unban(ctx, actorID, userID); err != nil {
+		h.respondError(c, err, "Failed to unban user")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ModerationHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrInvalidFlagTarget),
+		errors.Is(err, services.ErrInvalidFlagReason),
+		errors.Is(err, services.ErrInvalidModerationAction):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrPermissionDenied),
+		errors.Is(err, services.ErrCannotBanSelf):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrFlagTargetNotFound),
+		errors.Is(err, services.ErrWarnedUserNotFound),
+		errors.Is(err, services.ErrBannedUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrAlreadyFlagged),
+		errors.Is(err, services.ErrPostDeleted),
+		errors.Is(err, services.ErrNoPendingFlags),
+		errors.Is(err, services.ErrAlreadyModerated):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
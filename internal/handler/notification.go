@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationService *services.NotificationService
+	emailService        *services.NotificationEmailService
+	hub                 *services.NotificationHub
+	tokenService        *services.TokenService
+	config              config.NotificationConfig
+	log                 *logger.Logger
+}
+
+func NewNotificationHandler(notificationService *services.NotificationService, emailService *services.NotificationEmailService, hub *services.NotificationHub, tokenService *services.TokenService, cfg config.NotificationConfig, log *logger.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		emailService:        emailService,
+		hub:                 hub,
+		tokenService:        tokenService,
+		config:              cfg,
+		log:                 log,
+	}
+}
+
+// List returns the current user's notifications newest first. Pages are
+// requested with ?before=<next_cursor of the previous page>; ?unread=true
+// leaves out read notifications.
+func (h *NotificationHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list notifications request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var before int64
+	if raw := c.Query("before"); raw != "" {
+		var err error
+		if before, err = strconv.ParseInt(raw, 10, 64); err != nil || before <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid cursor"})
+			return
+		}
+	}
+	limit := services.DefaultNotificationPageSize
+	if raw := c.Query("limit"); raw != "" {
+		var err error
+		if limit, err = strconv.Atoi(raw); err != nil || limit <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+	}
+	unreadOnly := c.Query("unread") == "true"
+
+	notifications, actors, err := h.notificationService.List(ctx, userID, before, limit, unreadOnly)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notifications"})
+		return
+	}
+	unread, err := h.notificationService.UnreadCount(ctx, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notifications"})
+		return
+	}
+
+	var nextCursor *int64
+	if len(notifications) == min(limit, services.MaxNotificationPageSize) {
+		nextCursor = &notifications[len(notifications)-1].ID
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"notifications": response.NewNotifications(notifications, actors),
+		"unread_count":  unread,
+		"next_cursor":   nextCursor,
+	})
+}
+
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling mark notifications read request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.MarkNotificationsRead
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	marked, err := h.notificationService.MarkRead(ctx, userID, req.IDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notifications"})
+		return
+	}
+	h.respondMarked(c, userID, marked)
+}
+
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling mark all notifications read request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	marked, err := h.notificationService.MarkAllRead(ctx, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notifications"})
+		return
+	}
+	h.respondMarked(c, userID, marked)
+}
+
+func (h *NotificationHandler) respondMarked(c *gin.Context, userID, marked int64) {
+	unread, err := h.notificationService.UnreadCount(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notifications"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"marked": marked, "unread_count": unread})
+}
+
+// GetPreferences returns how the current user receives each notification
+// type.
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling get notification preferences request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	prefs, err := h.emailService.Preferences(ctx, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notification preferences"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"preferences": prefs})
+}
+
+// UpdatePreferences changes the delivery of the given notification types and
+// leaves the others as they are.
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling update notification preferences request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	var req request.UpdateNotificationPreferences
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	prefs, err := h.emailService.UpdatePreferences(ctx, userID, req.Preferences)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidNotificationPreference) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown notification type or delivery"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notification preferences"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"preferences": prefs})
+}
+
+// unsubscribePage is shown to people following an unsubscribe link. Token is
+// set when the page asks for confirmation.
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Unsubscribe</title></head>
+<body>
+<p>{{.Message}}</p>
+{{if .Token}}<form method="post" action="?token={{.Token}}"><button type="submit">Unsubscribe</button></form>{{end}}
+</body>
+</html>
+`))
+
+// ConfirmUnsubscribe answers GET on the link in notification emails. It only
+// asks for confirmation: link scanners and prefetching mail clients follow
+// links, and must not unsubscribe anyone (RFC 8058).
+func (h *NotificationHandler) ConfirmUnsubscribe(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling unsubscribe confirmation request")
+
+	token := c.Query("token")
+	if token == "" {
+		h.unsubscribeResponse(c, http.StatusBadRequest, "Token is required", "")
+		return
+	}
+
+	notificationType, err := h.emailService.CheckUnsubscribe(ctx, token)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidUnsubscribeToken) {
+			h.unsubscribeResponse(c, http.StatusBadRequest, "Invalid unsubscribe link", "")
+			return
+		}
+		h.unsubscribeResponse(c, http.StatusInternalServerError, "Failed to check unsubscribe link", "")
+		return
+	}
+
+	message := "Stop all notification emails? You will still see notifications in the app."
+	if notificationType != "" {
+		message = fmt.Sprintf("Stop emails about %s notifications? You will still see them in the app.", strings.ReplaceAll(notificationType, "_", " "))
+	}
+	h.unsubscribeResponse(c, http.StatusOK, message, token)
+}
+
+// Unsubscribe answers POST on the link in notification emails, sent by mail
+// clients for one-click unsubscribe and by the confirmation page. It needs
+// no login; the signed token names the user and the notification type.
+func (h *NotificationHandler) Unsubscribe(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling unsubscribe request")
+
+	token := c.Query("token")
+	if token == "" {
+		h.unsubscribeResponse(c, http.StatusBadRequest, "Token is required", "")
+		return
+	}
+
+	if err := h.emailService.Unsubscribe(ctx, token); err != nil {
+		if errors.Is(err, services.ErrInvalidUnsubscribeToken) {
+			h.unsubscribeResponse(c, http.StatusBadRequest, "Invalid unsubscribe link", "")
+			return
+		}
+		h.unsubscribeResponse(c, http.StatusInternalServerError, "Failed to unsubscribe", "")
+		return
+	}
+	h.unsubscribeResponse(c, http.StatusOK, "You will no longer receive these emails", "")
+}
+
+// RevokeUnsubscribeLinks makes the unsubscribe links in all emails sent so
+// far stop working, e.g. after a mailbox was forwarded to someone else.
+func (h *NotificationHandler) RevokeUnsubscribeLinks(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling revoke unsubscribe links request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	if err := h.emailService.RevokeUnsubscribeLinks(ctx, userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke unsubscribe links"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Unsubscribe links in earlier emails no longer work"})
+}
+
+// unsubscribeResponse renders the unsubscribe page for browsers and JSON for
+// everyone else. A non-empty token adds the confirmation form.
+func (h *NotificationHandler) unsubscribeResponse(c *gin.Context, status int, message, token string) {
+	if c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) != gin.MIMEHTML {
+		if status >= http.StatusBadRequest {
+			c.JSON(status, gin.H{"error": message})
+		} else {
+			c.JSON(status, gin.H{"message": message})
+		}
+		return
+	}
+
+	var page bytes.Buffer
+	if err := unsubscribePage.Execute(&page, map[string]string{"Message": message, "Token": token}); err != nil {
+		h.log.Error("failed to render unsubscribe page", "error", err)
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+	c.Data(status, "text/html; charset=utf-8", page.Bytes())
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/gin-gonic/gin"
+)
+
+// streamRetry is the reconnect delay suggested to EventSource clients.
+const streamRetry = 5 * time.Second
+
+// StreamTicket issues a single-use ticket for opening the stream with
+// EventSource, which can't send the Authorization header.
+func (h *NotificationHandler) StreamTicket(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling stream ticket request")
+
+	claims, _, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.tokenService.IssueStreamTicket(ctx, claims)
+	if err != nil {
+		h.log.Error("failed to issue stream ticket", "user_id", claims.UserID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to issue stream ticket"})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"ticket": ticket})
+}
+
+// Stream pushes the current user's new notifications as Server-Sent Events.
+// Every event carries the notification ID, so a reconnecting EventSource
+// sends Last-Event-ID and receives what it missed in between. The stream
+// ends with an "expired" event when the access token expires; the client
+// reconnects with a fresh token. Actors are looked up once per stream and
+// then reused, so a busy stream doesn't query the database for every event.
+func (h *NotificationHandler) Stream(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling notification stream request")
+
+	claims, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+
+	// Clients reconnecting by hand may pass the ID as a query parameter.
+	raw := c.GetHeader("Last-Event-ID")
+	if raw == "" {
+		raw = c.Query("last_event_id")
+	}
+	var lastID int64
+	if raw != "" {
+		var err error
+		if lastID, err = strconv.ParseInt(raw, 10, 64); err != nil || lastID < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid last event ID"})
+			return
+		}
+	}
+
+	// Subscribe before replaying so nothing published in between is lost;
+	// duplicates are skipped by ID.
+	sub := h.hub.Subscribe(userID)
+	defer h.hub.Unsubscribe(sub)
+
+	var missed []*domain.Notification
+	if lastID > 0 {
+		var err error
+		missed, err = h.notificationService.Since(ctx, userID, lastID, h.config.StreamReplayLimit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notifications"})
+			return
+		}
+	}
+	actors, err := h.notificationService.Actors(ctx, missed)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notifications"})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", streamRetry.Milliseconds())
+	for _, n := range missed {
+		if !h.sendNotification(c, n, actors) {
+			return
+		}
+		lastID = n.ID
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(time.Duration(h.config.StreamHeartbeat) * time.Second)
+	defer heartbeat.Stop()
+
+	var expired <-chan time.Time
+	if !claims.ExpiresAt.IsZero() {
+		timer := time.NewTimer(time.Until(claims.ExpiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-expired:
+			fmt.Fprint(c.Writer, "event: expired\ndata: {}\n\n")
+			c.Writer.Flush()
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case n, ok := <-sub.C:
+			if !ok {
+				// Dropped for falling behind; the client resumes from lastID.
+				return
+			}
+			if n.ID <= lastID {
+				continue
+			}
+			if !h.sendNotification(c, n, actors) {
+				return
+			}
+			lastID = n.ID
+			c.Writer.Flush()
+		}
+	}
+}
+
+// sendNotification writes n as an event. actors caches the users already
+// looked up on this stream and is filled in when n has a new actor.
+func (h *NotificationHandler) sendNotification(c *gin.Context, n *domain.Notification, actors map[int64]*domain.User) bool {
+	if _, ok := actors[n.ActorID]; n.ActorID != 0 && !ok {
+		found, err := h.notificationService.Actors(c.Request.Context(), []*domain.Notification{n})
+		if err != nil {
+			return false
+		}
+		// Remember missing actors too, so they are not looked up again.
+		actors[n.ActorID] = found[n.ActorID]
+	}
+	data, err := json.Marshal(response.NewNotifications([]*domain.Notification{n}, actors)[0])
+	if err != nil {
+		h.log.Error("failed to encode notification", "notification_id", n.ID, "error", err)
+		return false
+	}
+	_, err = fmt.Fprintf(c.Writer, "id: %d\nevent: notification\ndata: %s\n\n", n.ID, data)
+	return err == nil
+}
@@ -12,13 +12,15 @@ import (
 type OAuth2Handler struct {
 	oauth2Service *services.OAuth2Service
 	tokenService  *services.TokenService
+	cookies       *Cookies
 	log           *logger.Logger
 }
 
-func NewOAuth2Handler(oauth2Service *services.OAuth2Service, tokenService *services.TokenService, log *logger.Logger) *OAuth2Handler {
+func NewOAuth2Handler(oauth2Service *services.OAuth2Service, tokenService *services.TokenService, cookies *Cookies, log *logger.Logger) *OAuth2Handler {
 	return &OAuth2Handler{
 		oauth2Service: oauth2Service,
 		tokenService:  tokenService,
+		cookies:       cookies,
 		log:           log,
 	}
 }
@@ -31,22 +33,14 @@ func (h *OAuth2Handler) GoogleLogin(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(
-		"oauth_state",
-		state,
-		300,
-		"/",
-		"",
-		false,
-		true,
-	)
+	h.cookies.SetOAuthState(c, state, 300)
 	c.Redirect(http.StatusTemporaryRedirect, url)
 }
 
 func (h *OAuth2Handler) GoogleCallback(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	storedState, err := c.Cookie("oauth_state")
+	storedState, err := c.Cookie(oauthStateCookie)
 	if err != nil {
 		h.log.Error("failed to get oauth state cookie", "error", err)
 		c.JSON(500, gin.H{"error": "Failed to get oauth state cookie"})
@@ -60,7 +54,7 @@ func (h *OAuth2Handler) GoogleCallback(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie("oauth_state", "", -1, "/", "", false, true)
+	h.cookies.ClearOAuthState(c)
 
 	code := c.Query("code")
 	if code == "" {
@@ -75,23 +69,26 @@ func (h *OAuth2Handler) GoogleCallback(c *gin.Context) {
 		c.JSON(500, gin.H{"error": "Failed to handle oauth callback"})
 		return
 	}
+	if user.BannedAt != nil {
+		h.log.Warn("oauth sign-in of banned user", "user_id", user.ID)
+		c.JSON(403, gin.H{"error": "Account is banned"})
+		return
+	}
 	tokenPair, err := h.tokenService.GenerateTokenPair(ctx, user)
 	if err != nil {
 		h.log.Error("failed to generate token pair", "error", err)
 		c.JSON(500, gin.H{"error": "Failed to generate authentication tokens"})
 		return
 	}
-	c.SetCookie(
-		"refresh_token",
-		tokenPair.RefreshToken,
-		int(tokenPair.RefreshExpiresIn),
-		"/",
-		"",
-		false,
-		true,
-	)
+	csrfToken, err := h.cookies.SetSession(c, tokenPair.RefreshToken, int(tokenPair.RefreshExpiresIn))
+	if err != nil {
+		h.log.Error("failed to issue session cookies", "error", err)
+		c.JSON(500, gin.H{"error": "Failed to generate authentication tokens"})
+		return
+	}
 	c.JSON(http.StatusOK, response.Auth{
 		AccessToken: tokenPair.AccessToken,
 		ExpiresIn:   tokenPair.ExpiresIn,
+		CSRFToken:   csrfToken,
 	})
 }
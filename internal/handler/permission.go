@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type PermissionHandler struct {
+	permissionService *services.PermissionService
+	log               *logger.Logger
+}
+
+func NewPermissionHandler(permissionService *services.PermissionService, log *logger.Logger) *PermissionHandler {
+	return &PermissionHandler{
+		permissionService: permissionService,
+		log:               log,
+	}
+}
+
+// List returns every permission and which of them each role has.
+func (h *PermissionHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list permissions request")
+
+	permissions, roles, err := h.permissionService.List(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve permissions"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"permissions": permissions, "roles": roles})
+}
+
+func (h *PermissionHandler) SetRolePermissions(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling set role permissions request")
+
+	var req request.SetRolePermissions
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	role := c.Param("role")
+	permissions, err := h.permissionService.SetRolePermissions(ctx, role, req.Permissions)
+	if err != nil {
+		h.respondError(c, err, "Failed to update role permissions")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"role": role, "permissions": permissions})
+}
+
+func (h *PermissionHandler) AssignRole(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling assign role request")
+
+	_, actorID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req request.AssignRole
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.permissionService.AssignRole(ctx, actorID, userID, req.Role)
+	if err != nil {
+		h.respondError(c, err, "Failed to assign role")
+		return
+	}
+	c.JSON(http.StatusOK, response.NewProfile(user))
+}
+
+func (h *PermissionHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrInvalidRole),
+		errors.Is(err, services.ErrUnknownPermission),
+		errors.Is(err, services.ErrAdminLockout):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrCannotChangeOwnRole):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrRoleUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,373 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type PostHandler struct {
+	postService *services.PostService
+	log         *logger.Logger
+}
+
+func NewPostHandler(postService *services.PostService, log *logger.Logger) *PostHandler {
+	return &PostHandler{
+		postService: postService,
+		log:         log,
+	}
+}
+
+func (h *PostHandler) Ask(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling ask question request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	var req request.Question
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := h.postService.Ask(ctx, userID, req.CategoryIDs, req.Title, req.Body, req.AttachmentIDs)
+	if err != nil {
+		h.respondError(c, err, "Failed to post question")
+		return
+	}
+	h.respondQuestion(c, http.StatusCreated, question)
+}
+
+// ListQuestions returns a page of questions newest first. ?category= limits
+// it to one category; pages are requested with ?limit= and ?offset=.
+func (h *PostHandler) ListQuestions(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list questions request")
+
+	category, ok := queryInt(c, "category", 0)
+	if !ok {
+		return
+	}
+	limit, ok := queryInt(c, "limit", services.DefaultQuestionPageSize)
+	if !ok {
+		return
+	}
+	offset, ok := queryInt(c, "offset", 0)
+	if !ok {
+		return
+	}
+
+	questions, total, err := h.postService.Questions(ctx, int64(category), limit, offset)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve questions")
+		return
+	}
+	authors, err := h.postService.Authors(ctx, questions, nil)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve questions"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"questions": response.NewQuestions(questions, authors), "total": total})
+}
+
+// GetQuestion returns a question together with its answers.
+func (h *PostHandler) GetQuestion(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling get question request")
+
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+
+	question, err := h.postService.Question(ctx, id)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve question")
+		return
+	}
+	answers, err := h.postService.Answers(ctx, id)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve question")
+		return
+	}
+	authors, err := h.postService.Authors(ctx, []*domain.Question{question}, answers)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve question"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"question": response.NewQuestion(question, authors, true),
+		"answers":  response.NewAnswers(answers, authors),
+	})
+}
+
+func (h *PostHandler) EditQuestion(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling edit question request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+	var req request.EditQuestion
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := h.postService.EditQuestion(ctx, userID, id, req.Title, req.Body, req.Summary, req.AttachmentIDs)
+	if err != nil {
+		h.respondError(c, err, "Failed to edit question")
+		return
+	}
+	h.respondQuestion(c, http.StatusOK, question)
+}
+
+func (h *PostHandler) DeleteQuestion(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling delete question request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.postService.DeleteQuestion(ctx, userID, id); err != nil {
+		h.respondError(c, err, "Failed to delete question")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *PostHandler) Answer(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling answer request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	questionID, ok := postID(c)
+	if !ok {
+		return
+	}
+	var req request.Answer
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	answer, err := h.postService.Answer(ctx, userID, questionID, req.Body, req.AttachmentIDs)
+	if err != nil {
+		h.respondError(c, err, "Failed to post answer")
+		return
+	}
+	h.respondAnswer(c, http.StatusCreated, answer)
+}
+
+func (h *PostHandler) EditAnswer(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling edit answer request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+	var req request.EditAnswer
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	answer, err := h.postService.EditAnswer(ctx, userID, id, req.Body, req.Summary, req.AttachmentIDs)
+	if err != nil {
+		h.respondError(c, err, "Failed to edit answer")
+		return
+	}
+	h.respondAnswer(c, http.StatusOK, answer)
+}
+
+func (h *PostHandler) DeleteAnswer(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling delete answer request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.postService.DeleteAnswer(ctx, userID, id); err != nil {
+		h.respondError(c, err, "Failed to delete answer")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Vote sets the caller's vote on the question or answer and returns the
+// post's new score.
+func (h *PostHandler) Vote(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling vote request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+	var req request.Vote
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	score, err := h.postService.Vote(ctx, userID, c.Param("type"), id, *req.Value)
+	if err != nil {
+		h.respondError(c, err, "Failed to vote")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"vote": *req.Value, "score": score})
+}
+
+func (h *PostHandler) Accept(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling accept answer request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+	var req request.Accept
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.postService.Accept(ctx, userID, id, req.AnswerID); err != nil {
+		h.respondError(c, err, "Failed to accept answer")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"accepted_answer_id": req.AnswerID})
+}
+
+func (h *PostHandler) Unaccept(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling unaccept answer request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	id, ok := postID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.postService.Unaccept(ctx, userID, id); err != nil {
+		h.respondError(c, err, "Failed to clear accepted answer")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *PostHandler) respondQuestion(c *gin.Context, status int, question *domain.Question) {
+	authors, err := h.postService.Authors(c.Request.Context(), []*domain.Question{question}, nil)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve question"})
+		return
+	}
+	c.JSON(status, response.NewQuestion(question, authors, true))
+}
+
+func (h *PostHandler) respondAnswer(c *gin.Context, status int, answer *domain.Answer) {
+	authors, err := h.postService.Authors(c.Request.Context(), nil, []*domain.Answer{answer})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve answer"})
+		return
+	}
+	c.JSON(status, response.NewAnswer(answer, authors))
+}
+
+func (h *PostHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrQuestionNotFound),
+		errors.Is(err, services.ErrAnswerNotFound),
+		errors.Is(err, services.ErrPostCategoryNotFound),
+		errors.Is(err, services.ErrAttachmentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidTitle),
+		errors.Is(err, services.ErrInvalidBody),
+		errors.Is(err, services.ErrInvalidCategories),
+		errors.Is(err, services.ErrInvalidPostTarget),
+		errors.Is(err, services.ErrInvalidVote),
+		errors.Is(err, services.ErrAnswerNotOfQuestion):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrMarkdownTooLong):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrPostForbidden),
+		errors.Is(err, services.ErrAcceptForbidden),
+		errors.Is(err, services.ErrCannotVoteOwnPost):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrPostLocked),
+		errors.Is(err, services.ErrPostDeleted),
+		errors.Is(err, services.ErrRevisionConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
+
+func postID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return 0, false
+	}
+	return id, true
+}
+
+// queryInt parses an optional non-negative integer query parameter. When it
+// returns false the response has already been written.
+func queryInt(c *gin.Context, name string, fallback int) (int, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return fallback, true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + name})
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/diff"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// diffContext is the number of unchanged lines shown around each change in
+// unified diffs.
+const diffContext = 3
+
+type RevisionHandler struct {
+	revisionService *services.RevisionService
+	postService     *services.PostService
+	log             *logger.Logger
+}
+
+func NewRevisionHandler(revisionService *services.RevisionService, postService *services.PostService, log *logger.Logger) *RevisionHandler {
+	return &RevisionHandler{
+		revisionService: revisionService,
+		postService:     postService,
+		log:             log,
+	}
+}
+
+func (h *RevisionHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling list revisions request")
+
+	targetType, targetID, ok := revisionTarget(c)
+	if !ok {
+		return
+	}
+
+	revisions, authors, err := h.revisionService.List(ctx, targetType, targetID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve revisions")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"revisions": response.NewRevisions(revisions, authors)})
+}
+
+func (h *RevisionHandler) Get(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling get revision request")
+
+	targetType, targetID, ok := revisionTarget(c)
+	if !ok {
+		return
+	}
+	number, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || number <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision number"})
+		return
+	}
+
+	revision, err := h.revisionService.Get(ctx, targetType, targetID, number)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve revision")
+		return
+	}
+	authors, err := h.revisionService.Authors(ctx, []*domain.Revision{revision})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve revision"})
+		return
+	}
+	c.JSON(http.StatusOK, response.NewRevision(revision, authors, true))
+}
+
+// Diff compares ?from= with ?to= (by default the current revision and the
+// one before it). ?format=side-by-side returns aligned rows instead of a
+// unified diff.
+func (h *RevisionHandler) Diff(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling revision diff request")
+
+	targetType, targetID, ok := revisionTarget(c)
+	if !ok {
+		return
+	}
+	from, ok := revisionQuery(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := revisionQuery(c, "to")
+	if !ok {
+		return
+	}
+	format := c.DefaultQuery("format", "unified")
+	if format != "unified" && format != "side-by-side" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Format must be unified or side-by-side"})
+		return
+	}
+
+	result, err := h.revisionService.Diff(ctx, targetType, targetID, from, to)
+	if err != nil {
+		h.respondError(c, err, "Failed to compare revisions")
+		return
+	}
+	authors, err := h.revisionService.Authors(ctx, []*domain.Revision{result.From, result.To})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to compare revisions"})
+		return
+	}
+
+	body := gin.H{
+		"from":   response.NewRevision(result.From, authors, false),
+		"to":     response.NewRevision(result.To, authors, false),
+		"format": format,
+	}
+	if result.TitleChanged {
+		body["title"] = gin.H{"from": result.From.Title, "to": result.To.Title}
+	}
+	if format == "unified" {
+		body["diff"] = diff.Unified(
+			"revision "+strconv.Itoa(result.From.Number),
+			"revision "+strconv.Itoa(result.To.Number),
+			result.Lines, diffContext,
+		)
+	} else {
+		body["rows"] = diff.SideBySide(result.Lines)
+	}
+	c.JSON(http.StatusOK, body)
+}
+
+// Rollback restores an earlier revision of the post as a new revision. Locked
+// and deleted posts cannot be rolled back.
+func (h *RevisionHandler) Rollback(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling rollback request")
+
+	_, userID, ok := currentUser(c, h.log)
+	if !ok {
+		return
+	}
+	targetType, targetID, ok := revisionTarget(c)
+	if !ok {
+		return
+	}
+	var req request.Rollback
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	revision, err := h.postService.Rollback(ctx, userID, targetType, targetID, req.Revision, req.Summary)
+	if err != nil {
+		h.respondError(c, err, "Failed to roll back")
+		return
+	}
+	authors, err := h.revisionService.Authors(ctx, []*domain.Revision{revision})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to roll back"})
+		return
+	}
+	c.JSON(http.StatusCreated, response.NewRevision(revision, authors, true))
+}
+
+func (h *RevisionHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrRevisionNotFound),
+		errors.Is(err, services.ErrQuestionNotFound),
+		errors.Is(err, services.ErrAnswerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidRevisionTarget),
+		errors.Is(err, services.ErrInvalidPostTarget):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrRollbackForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrRollbackToCurrent),
+		errors.Is(err, services.ErrRevisionConflict),
+		errors.Is(err, services.ErrPostLocked),
+		errors.Is(err, services.ErrPostDeleted):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
+
+func revisionTarget(c *gin.Context) (string, int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return "", 0, false
+	}
+	return c.Param("type"), id, true
+}
+
+// revisionQuery parses an optional revision number query parameter; 0 means
+// it is absent.
+func revisionQuery(c *gin.Context, name string) (int, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return 0, true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision number"})
+		return 0, false
+	}
+	return n, true
+}
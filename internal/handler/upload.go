@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead leaves room for boundaries and part headers on top of
+// the file itself when capping upload request bodies.
+const multipartOverhead = 64 << 10
+
+// formImage caps the request body at maxSize and opens the named multipart
+// file. It writes the error response itself and returns ok=false on failure.
+func formImage(c *gin.Context, field string, maxSize int64) (multipart.File, bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+multipartOverhead)
+
+	fileHeader, err := c.FormFile(field)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": services.ErrImageTooLarge.Error()})
+			return nil, false
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Image file '" + field + "' is required"})
+		return nil, false
+	}
+	if fileHeader.Size > maxSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": services.ErrImageTooLarge.Error()})
+		return nil, false
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to open file"})
+		return nil, false
+	}
+	return file, true
+}
+
+// imageErrorStatus maps image validation errors to their response status.
+// Anything else is an internal error.
+func imageErrorStatus(err error) (int, bool) {
+	switch {
+	case errors.Is(err, services.ErrImageTooLarge):
+		return http.StatusRequestEntityTooLarge, true
+	case errors.Is(err, services.ErrUnsupportedImage):
+		return http.StatusUnsupportedMediaType, true
+	case errors.Is(err, services.ErrImageCorrupt),
+		errors.Is(err, services.ErrImageTooSmall),
+		errors.Is(err, services.ErrImageDimensions),
+		errors.Is(err, services.ErrImageTooManyPixels):
+		return http.StatusUnprocessableEntity, true
+	}
+	return 0, false
+}
@@ -1,100 +1,189 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/dto/request"
+	"github.com/RofaBR/Go-Usof/internal/dto/response"
+	"github.com/RofaBR/Go-Usof/internal/middleware"
 	"github.com/RofaBR/Go-Usof/internal/services"
 	"github.com/RofaBR/Go-Usof/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
+const defaultIdenticonSize = 128
+
 type UserHandler struct {
-	userService  *services.UserService
-	imageService *services.CloudinaryService
-	tokenService *services.TokenService
-	log          *logger.Logger
+	userService         *services.UserService
+	usernameService     *services.UsernameService
+	imageService        *services.ImageService
+	tokenService        *services.TokenService
+	emailService        *services.MailService
+	notificationService *services.NotificationService
+	log                 *logger.Logger
 }
 
-func NewUserHandler(userService *services.UserService, imageService *services.CloudinaryService, tokenService *services.TokenService, log *logger.Logger) *UserHandler {
+func NewUserHandler(userService *services.UserService, usernameService *services.UsernameService, imageService *services.ImageService, tokenService *services.TokenService, emailService *services.MailService, notificationService *services.NotificationService, log *logger.Logger) *UserHandler {
 	return &UserHandler{
-		userService:  userService,
-		imageService: imageService,
-		tokenService: tokenService,
-		log:          log,
+		userService:         userService,
+		usernameService:     usernameService,
+		imageService:        imageService,
+		tokenService:        tokenService,
+		emailService:        emailService,
+		notificationService: notificationService,
+		log:                 log,
 	}
 }
 
-func (h *UserHandler) UpdateAvatar(c *gin.Context) {
+func (h *UserHandler) GetMe(c *gin.Context) {
 	ctx := c.Request.Context()
-	h.log.Info("handling update avatar request")
+	h.log.Info("handling get current user request")
 
-	token, err := extractToken(c)
-	if err != nil {
-		h.log.Warn("failed to extract token from request", "error", err)
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+	claims, userID, ok := h.currentUser(c)
+	if !ok {
 		return
 	}
 
-	params, err := h.tokenService.ValidateAccessToken(c.Request.Context(), token)
+	user, err := h.userService.GetByID(ctx, userID)
 	if err != nil {
-		h.log.Warn("failed to validate access token", "error", err)
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		h.log.Error("failed to get user", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+	if user == nil {
+		h.log.Warn("user not found", "userId", claims.UserID)
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
 		return
 	}
-	userIDStr := params.UserID
 
-	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	unread, err := h.notificationService.UnreadCount(ctx, userID)
 	if err != nil {
-		h.log.Error("invalid user ID format", "userId", userIDStr, "error", err)
-		c.JSON(400, gin.H{"error": "Invalid user ID"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
 		return
 	}
 
-	fileHeader, err := c.FormFile("avatar")
-	if err != nil {
-		h.log.Warn("failed to parse avatar file", "error", err)
-		c.JSON(400, gin.H{"error": "Avatar file is required"})
+	c.JSON(http.StatusOK, response.Me{User: response.NewUser(user), UnreadNotifications: unread})
+}
+
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling update current user request")
+
+	claims, userID, ok := h.currentUser(c)
+	if !ok {
 		return
 	}
 
-	file, err := fileHeader.Open()
-	if err != nil {
-		h.log.Error("failed to open avatar file", "error", err)
-		c.JSON(400, gin.H{"error": "Failed to open file"})
+	var req request.UpdateUser
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid update user request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	defer file.Close()
 
-	avatarURL, err := h.imageService.UploadAvatar(ctx, file, userIDStr)
+	// The email change is checked first so a rejected one leaves the rest
+	// of the profile untouched as well.
+	var pending *domain.User
+	if req.Email != nil {
+		user, err := h.userService.RequestEmailChange(ctx, userID, *req.Email, req.CurrentPassword, h.tokenService.IsRecentlyAuthenticated(claims))
+		switch {
+		case errors.Is(err, services.ErrEmailUnchanged):
+			// Nothing to confirm; the rest of the update still applies.
+		case errors.Is(err, services.ErrEmailTaken):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		case errors.Is(err, services.ErrInvalidCurrentPassword):
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		case errors.Is(err, services.ErrReauthRequired):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Please sign in again before changing your email", "code": "reauth_required"})
+			return
+		case err != nil:
+			h.log.Error("failed to request email change", "userId", userID, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+			return
+		default:
+			pending = user
+		}
+	}
+
+	user, err := h.userService.UpdateProfile(ctx, userID, domain.ProfileUpdate{FullName: req.FullName})
 	if err != nil {
-		h.log.Error("failed to upload avatar", "userId", userID, "error", err)
-		c.JSON(500, gin.H{"error": "Failed to upload image"})
+		h.log.Error("failed to update user", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
 		return
 	}
 
-	user, err := h.userService.GetByID(ctx, userID)
-	if err != nil {
+	resp := response.ProfileUpdated{User: response.NewUser(user)}
+	if pending != nil {
+		token, err := h.tokenService.GenerateEmailChangeToken(ctx, pending, *req.Email)
+		if err != nil {
+			h.log.Error("failed to generate email change token", "userId", userID, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request email change"})
+			return
+		}
+		if err := h.emailService.SendEmailChangeEmail(ctx, *req.Email, token); err != nil {
+			h.log.Error("failed to queue email change confirmation", "userId", userID, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request email change"})
+			return
+		}
+		resp.PendingEmail = *req.Email
+	}
+
+	h.log.Info("user updated successfully", "userId", userID, "email_change_requested", resp.PendingEmail != "")
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) UpdateAvatar(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling update avatar request")
+
+	claims, userID, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+
+	current, err := h.userService.GetByID(ctx, userID)
+	if err != nil || current == nil {
 		h.log.Error("failed to get user", "userId", userID, "error", err)
-		c.JSON(500, gin.H{"error": "Failed to retrieve user"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update avatar"})
 		return
 	}
-	if user == nil {
-		h.log.Warn("user not found", "userId", userID)
-		c.JSON(404, gin.H{"error": "User not found"})
+
+	file, ok := formImage(c, "avatar", h.imageService.MaxUploadSize())
+	if !ok {
+		h.log.Warn("failed to read avatar file", "userId", userID)
 		return
 	}
+	defer file.Close()
 
-	user.Avatar = avatarURL
+	avatarURL, err := h.imageService.UploadAvatar(ctx, file, claims.UserID)
+	if status, ok := imageErrorStatus(err); ok {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		h.log.Error("failed to upload avatar", "userId", userID, "error", err)
+		c.JSON(500, gin.H{"error": "Failed to upload image"})
+		return
+	}
 
-	err = h.userService.Update(ctx, user)
+	user, err := h.userService.UpdateProfile(ctx, userID, domain.ProfileUpdate{Avatar: &avatarURL})
 	if err != nil {
 		h.log.Error("failed to update user avatar", "userId", userID, "error", err)
+		if delErr := h.imageService.DeleteAvatar(ctx, claims.UserID, avatarURL); delErr != nil {
+			h.log.Error("failed to delete unused avatar", "userId", userID, "error", delErr)
+		}
 		c.JSON(500, gin.H{"error": "Failed to update avatar"})
 		return
 	}
+	h.deleteReplacedAvatar(ctx, user, current.Avatar)
 
 	h.log.Info("avatar updated successfully", "userId", userID, "avatarURL", avatarURL)
 	c.JSON(200, gin.H{
@@ -103,20 +192,261 @@ func (h *UserHandler) UpdateAvatar(c *gin.Context) {
 	})
 }
 
-func extractToken(c *gin.Context) (string, error) {
-	bearerToken := c.GetHeader("Authorization")
-	if bearerToken == "" {
-		return "", errors.New("authorization header is missing")
+// DeleteAvatar removes the avatar; the profile falls back to the generated
+// identicon.
+func (h *UserHandler) DeleteAvatar(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling delete avatar request")
+
+	_, userID, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+
+	current, err := h.userService.GetByID(ctx, userID)
+	if err != nil || current == nil {
+		h.log.Error("failed to get user", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete avatar"})
+		return
+	}
+	if current.Avatar == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No avatar to delete"})
+		return
+	}
+
+	empty := ""
+	user, err := h.userService.UpdateProfile(ctx, userID, domain.ProfileUpdate{Avatar: &empty})
+	if err != nil {
+		h.log.Error("failed to clear avatar", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete avatar"})
+		return
+	}
+	h.deleteReplacedAvatar(ctx, user, current.Avatar)
+
+	h.log.Info("avatar deleted", "userId", userID)
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Avatar deleted successfully",
+		"avatar_url": response.AvatarURL(user),
+	})
+}
+
+// GetAvatar redirects to the user's avatar, or serves a generated identicon
+// when they have none. Only avatars held in our own storage are redirected
+// to, so the endpoint can't be used to send visitors elsewhere. Identicons
+// are seeded by user ID so they survive login changes; ?size= sets the edge
+// length in pixels.
+func (h *UserHandler) GetAvatar(c *gin.Context) {
+	ctx := c.Request.Context()
+	ref := c.Param("user")
+
+	user, _, err := h.lookupUser(ctx, ref)
+	if err != nil {
+		h.log.Error("failed to get user for avatar", "user", ref, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+	if user == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	userID := strconv.FormatInt(user.ID, 10)
+	if user.Avatar != "" && h.imageService.IsStoredAvatar(userID, user.Avatar) {
+		c.Redirect(http.StatusFound, user.Avatar)
+		return
+	}
+
+	size := defaultIdenticonSize
+	if raw := c.Query("size"); raw != "" {
+		size, err = strconv.Atoi(raw)
+		if err != nil || size < services.MinIdenticonSize || size > services.MaxIdenticonSize {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("size must be between %d and %d", services.MinIdenticonSize, services.MaxIdenticonSize),
+			})
+			return
+		}
+	}
+
+	etag := fmt.Sprintf(`"identicon-%d-%d"`, user.ID, size)
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Header("ETag", etag)
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	data, err := h.imageService.RenderIdenticon(userID, size)
+	if err != nil {
+		h.log.Error("failed to render identicon", "userId", user.ID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render avatar"})
+		return
+	}
+	c.Data(http.StatusOK, "image/png", data)
+}
+
+// deleteReplacedAvatar removes the stored object of a previous avatar once
+// the profile no longer points at it. Failures only leave an unused file
+// behind, so they are logged and not reported to the client.
+func (h *UserHandler) deleteReplacedAvatar(ctx context.Context, user *domain.User, previous string) {
+	if previous == "" || previous == user.Avatar {
+		return
+	}
+	if err := h.imageService.DeleteAvatar(ctx, strconv.FormatInt(user.ID, 10), previous); err != nil {
+		h.log.Error("failed to delete previous avatar", "userId", user.ID, "error", err)
+	}
+}
+
+// GetProfile serves public profiles by numeric ID or by login.
+func (h *UserHandler) GetProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+	ref := c.Param("user")
+	h.log.Info("handling get profile request", "user", ref)
+
+	user, redirected, err := h.lookupUser(ctx, ref)
+	if err != nil {
+		h.log.Error("failed to get profile", "user", ref, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+	if user == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	if redirected {
+		location := strings.TrimSuffix(c.Request.URL.Path, ref) + user.Login
+		h.log.Info("redirecting old login", "from", ref, "to", user.Login)
+		c.Redirect(http.StatusMovedPermanently, location)
+		return
+	}
+
+	activity, err := h.userService.Activity(ctx, user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+	c.JSON(http.StatusOK, response.NewPublicProfile(user, activity))
+}
+
+// lookupUser finds a user by numeric ID or by current or recent login.
+func (h *UserHandler) lookupUser(ctx context.Context, ref string) (*domain.User, bool, error) {
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		user, err := h.userService.GetByID(ctx, id)
+		return user, false, err
+	}
+	return h.usernameService.Resolve(ctx, ref)
+}
+
+func (h *UserHandler) ChangeLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling change login request")
+
+	_, userID, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req request.ChangeLogin
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid change login request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.usernameService.Change(ctx, userID, req.Login)
+	var cooldownErr *services.LoginCooldownError
+	switch {
+	case errors.As(err, &cooldownErr):
+		c.Header("Retry-After", strconv.Itoa(int(cooldownErr.RetryAfter.Seconds())+1))
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": cooldownErr.Error()})
+		return
+	case errors.Is(err, services.ErrLoginTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, services.ErrLoginInvalid), errors.Is(err, services.ErrLoginReserved), errors.Is(err, services.ErrLoginUnchanged):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		h.log.Error("failed to change login", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change login"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewUser(user))
+}
+
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.log.Info("handling change password request")
+
+	claims, userID, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req request.ChangePassword
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("invalid change password request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err := h.userService.ChangePassword(ctx, userID, req.CurrentPassword, req.NewPassword, h.tokenService.IsRecentlyAuthenticated(claims))
+	var policyErr *services.PasswordPolicyError
+	switch {
+	case errors.As(err, &policyErr):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "Password does not meet the password policy",
+			"violations": policyErr.Violations,
+		})
+		return
+	case errors.Is(err, services.ErrInvalidCurrentPassword):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, services.ErrReauthRequired):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Please sign in again before changing your password", "code": "reauth_required"})
+		return
+	case errors.Is(err, services.ErrPasswordUnchanged):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		h.log.Error("failed to change password", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change password"})
+		return
+	}
+
+	if err := h.tokenService.RevokeOtherSessions(ctx, claims.UserID, claims.SessionID); err != nil {
+		h.log.Error("password changed but failed to revoke other sessions", "userId", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Password changed but failed to sign out other sessions"})
+		return
 	}
 
-	parts := strings.Split(bearerToken, " ")
-	if len(parts) != 2 {
-		return "", errors.New("invalid token format: expected 'Bearer <token>'")
+	h.log.Info("password changed successfully", "userId", userID)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Password changed successfully. Other sessions have been signed out.",
+	})
+}
+
+// currentUser reads the claims set by AuthMiddleware. When it returns false
+// the response has already been written.
+func (h *UserHandler) currentUser(c *gin.Context) (*domain.TokenClaims, int64, bool) {
+	return currentUser(c, h.log)
+}
+
+// currentUser reads the claims set by the auth middleware. It writes the
+// error response itself and returns ok=false on failure.
+func currentUser(c *gin.Context, log *logger.Logger) (*domain.TokenClaims, int64, bool) {
+	claims, ok := c.MustGet(middleware.ClaimsKey).(*domain.TokenClaims)
+	if !ok {
+		log.Error("invalid claims type in context")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid claims type"})
+		return nil, 0, false
 	}
 
-	if parts[0] != "Bearer" {
-		return "", errors.New("invalid token format: expected Bearer scheme")
+	userID, err := strconv.ParseInt(claims.UserID, 10, 64)
+	if err != nil {
+		log.Error("invalid user ID format", "userId", claims.UserID, "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return nil, 0, false
 	}
 
-	return parts[1], nil
+	return claims, userID, true
 }
@@ -0,0 +1,211 @@
+// Package mail renders transactional emails from embedded templates.
+//
+// Templates live in templates/<locale>/. Every message <name> has a
+// <name>.txt.tmpl defining "subject" and "content" (plain text) and a
+// <name>.html.tmpl defining "content" (HTML). Both are wrapped in the
+// layouts from templates/layouts. Every other *.tmpl file of a locale holds
+// shared definitions available to all its messages, such as the "footer".
+package mail
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+const layoutsDir = "layouts"
+
+// htmlFuncs lets HTML templates pass several values to shared snippets such
+// as the "button" defined in the layout.
+var htmlFuncs = htmltemplate.FuncMap{
+	"dict": func(pairs ...any) (map[string]any, error) {
+		if len(pairs)%2 != 0 {
+			return nil, fmt.Errorf("dict needs key/value pairs")
+		}
+		m := make(map[string]any, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			key, ok := pairs[i].(string)
+			if !ok {
+				return nil, fmt.Errorf("dict keys must be strings")
+			}
+			m[key] = pairs[i+1]
+		}
+		return m, nil
+	},
+}
+
+// Message is a rendered email with both alternatives.
+type Message struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type messageTemplates struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// Renderer holds all templates, parsed once at startup so a broken template
+// fails the boot rather than a send.
+type Renderer struct {
+	appName       string
+	baseURL       string
+	defaultLocale string
+	locales       []string
+	// messages is keyed by locale, then message name.
+	messages map[string]map[string]messageTemplates
+}
+
+func NewRenderer(appName, baseURL, defaultLocale string) (*Renderer, error) {
+	r := &Renderer{
+		appName:       appName,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		defaultLocale: defaultLocale,
+		messages:      make(map[string]map[string]messageTemplates),
+	}
+
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read email templates: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == layoutsDir {
+			continue
+		}
+		if err := r.parseLocale(entry.Name()); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := r.messages[defaultLocale]; !ok {
+		return nil, fmt.Errorf("no email templates for default locale %q", defaultLocale)
+	}
+
+	// The default goes first; locale matchers fall back to the first entry.
+	r.locales = append(r.locales, defaultLocale)
+	for locale := range r.messages {
+		if locale != defaultLocale {
+			r.locales = append(r.locales, locale)
+		}
+	}
+	slices.Sort(r.locales[1:])
+	return r, nil
+}
+
+func (r *Renderer) parseLocale(locale string) error {
+	dir := path.Join("templates", locale)
+	names, err := fs.Glob(templateFS, path.Join(dir, "*.txt.tmpl"))
+	if err != nil {
+		return fmt.Errorf("failed to list %s templates: %w", locale, err)
+	}
+
+	shared, err := fs.Glob(templateFS, path.Join(dir, "*.tmpl"))
+	if err != nil {
+		return fmt.Errorf("failed to list %s templates: %w", locale, err)
+	}
+	shared = slices.DeleteFunc(shared, func(name string) bool {
+		return strings.HasSuffix(name, ".txt.tmpl") || strings.HasSuffix(name, ".html.tmpl")
+	})
+
+	r.messages[locale] = make(map[string]messageTemplates, len(names))
+	for _, name := range names {
+		message := strings.TrimSuffix(path.Base(name), ".txt.tmpl")
+
+		textFiles := append([]string{path.Join("templates", layoutsDir, "base.txt.tmpl")}, shared...)
+		text, err := texttemplate.ParseFS(templateFS, append(textFiles, name)...)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s/%s text template: %w", locale, message, err)
+		}
+		if text.Lookup("subject") == nil {
+			return fmt.Errorf("email template %s/%s does not define a subject", locale, message)
+		}
+
+		htmlFiles := append([]string{path.Join("templates", layoutsDir, "base.html.tmpl")}, shared...)
+		html, err := htmltemplate.New(message).Funcs(htmlFuncs).ParseFS(templateFS,
+			append(htmlFiles, path.Join(dir, message+".html.tmpl"))...)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s/%s html template: %w", locale, message, err)
+		}
+
+		r.messages[locale][message] = messageTemplates{html: html, text: text}
+	}
+	return nil
+}
+
+// Locales lists the locales templates exist for, the default first.
+func (r *Renderer) Locales() []string {
+	return r.locales
+}
+
+// Render executes message name for locale. Region subtags fall back to the
+// language ("uk-UA" to "uk"), unknown locales to the default locale. data may
+// add any keys; AppName, BaseURL and Locale are always set.
+func (r *Renderer) Render(name, locale string, data map[string]any) (*Message, error) {
+	locale, tmpl, ok := r.lookup(name, locale)
+	if !ok {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+
+	// Optional keys are present so templates can test them with "if".
+	vars := map[string]any{"UnsubscribeURL": ""}
+	for k, v := range data {
+		vars[k] = v
+	}
+	vars["AppName"] = r.appName
+	vars["BaseURL"] = r.baseURL
+	vars["Locale"] = locale
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", vars); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "layout", vars); err != nil {
+		return nil, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+	if err := tmpl.html.ExecuteTemplate(&html, "layout", vars); err != nil {
+		return nil, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+
+	return &Message{
+		// Collapse whitespace so user content cannot break the header.
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		HTML:    html.String(),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+	}, nil
+}
+
+func (r *Renderer) lookup(name, locale string) (string, messageTemplates, bool) {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	language, _, _ := strings.Cut(locale, "-")
+	for _, candidate := range []string{locale, language, r.defaultLocale} {
+		if tmpl, ok := r.messages[candidate][name]; ok {
+			return candidate, tmpl, true
+		}
+	}
+	return "", messageTemplates{}, false
+}
+
+type localeKey struct{}
+
+// WithLocale stores the recipient's preferred locale for templates rendered
+// while handling the request.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// Locale returns the locale stored by WithLocale, or "" for the default.
+func Locale(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
@@ -0,0 +1,96 @@
+package transport
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+var linkPattern = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// Capture keeps sent emails in memory instead of delivering them. It lets
+// tests assert on emails, e.g. follow the link of a verification email,
+// without a mail server.
+type Capture struct {
+	mu     sync.Mutex
+	emails []domain.Email
+	// sent is closed and replaced on every Send to wake up WaitFor.
+	sent chan struct{}
+}
+
+func NewCapture() *Capture {
+	return &Capture{sent: make(chan struct{})}
+}
+
+func (c *Capture) Send(ctx context.Context, email *domain.Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.emails = append(c.emails, *email)
+	close(c.sent)
+	c.sent = make(chan struct{})
+	return nil
+}
+
+// Emails returns all captured emails, oldest first.
+func (c *Capture) Emails() []domain.Email {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.emails)
+}
+
+// Last returns the most recent email sent to the given address.
+func (c *Capture) Last(to string) (domain.Email, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.last(to)
+}
+
+func (c *Capture) last(to string) (domain.Email, bool) {
+	for i := len(c.emails) - 1; i >= 0; i-- {
+		if strings.EqualFold(c.emails[i].To, to) {
+			return c.emails[i], true
+		}
+	}
+	return domain.Email{}, false
+}
+
+// WaitFor returns the most recent email sent to the given address, waiting
+// until one arrives or ctx is done. Emails go through the outbox, so they
+// are captured asynchronously.
+func (c *Capture) WaitFor(ctx context.Context, to string) (domain.Email, error) {
+	for {
+		c.mu.Lock()
+		email, ok := c.last(to)
+		sent := c.sent
+		c.mu.Unlock()
+		if ok {
+			return email, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return domain.Email{}, ctx.Err()
+		case <-sent:
+		}
+	}
+}
+
+// Reset forgets all captured emails.
+func (c *Capture) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.emails = nil
+}
+
+// Links returns the URLs in the plain-text part of email, in order.
+func Links(email domain.Email) []string {
+	return linkPattern.FindAllString(email.Text, -1)
+}
@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+// MaildirTransport writes every email as a file into a Maildir, so messages
+// sent during local development can be opened with any mail client or
+// simply read from disk.
+type MaildirTransport struct {
+	dir       string
+	fromEmail string
+	fromName  string
+	hostname  string
+}
+
+func NewMaildirTransport(dir, fromEmail, fromName string) (*MaildirTransport, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create maildir %s: %w", dir, err)
+		}
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	return &MaildirTransport{dir: dir, fromEmail: fromEmail, fromName: fromName, hostname: hostname}, nil
+}
+
+// Send writes the message to tmp/ and moves it into new/ once complete, as
+// the Maildir format requires, so readers never see partial files.
+func (t *MaildirTransport) Send(ctx context.Context, email *domain.Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	msg, err := encode(email, t.fromEmail, t.fromName)
+	if err != nil {
+		return err
+	}
+
+	name, err := t.uniqueName()
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(t.dir, "tmp", name)
+	if err := os.WriteFile(tmp, msg, 0o644); err != nil {
+		return fmt.Errorf("failed to write email to %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, filepath.Join(t.dir, "new", name)); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to deliver email to maildir: %w", err)
+	}
+	return nil
+}
+
+// uniqueName follows the Maildir convention time.unique.hostname.
+func (t *MaildirTransport) uniqueName() (string, error) {
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate file name: %w", err)
+	}
+	now := time.Now()
+	return strconv.FormatInt(now.Unix(), 10) + ".M" + strconv.Itoa(now.Nanosecond()/1000) +
+		"R" + hex.EncodeToString(random) + "." + t.hostname, nil
+}
@@ -0,0 +1,219 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+// implicitTLSPort is the SMTPS port; other ports use STARTTLS when the server
+// offers it.
+const implicitTLSPort = 465
+
+// SMTPTransport sends emails over SMTP, keeping up to SMTPPoolSize
+// authenticated connections open between messages. Send honours ctx: dialing
+// and every command on the connection are aborted when ctx is done.
+type SMTPTransport struct {
+	config config.SenderConfig
+	dialer net.Dialer
+
+	mu     sync.Mutex
+	idle   []*smtpConn
+	closed bool
+}
+
+type smtpConn struct {
+	conn     net.Conn
+	client   *smtp.Client
+	lastUsed time.Time
+}
+
+func NewSMTPTransport(cfg config.SenderConfig) *SMTPTransport {
+	return &SMTPTransport{config: cfg}
+}
+
+func (t *SMTPTransport) Send(ctx context.Context, email *domain.Email) error {
+	msg, err := encode(email, t.config.FromEmail, t.config.FromName)
+	if err != nil {
+		return err
+	}
+
+	// A pooled connection may have been closed by the server in the
+	// meantime, so a failure on one is retried once on a fresh connection.
+	if c := t.get(); c != nil {
+		err := t.send(ctx, c, email.To, msg)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+	}
+
+	c, err := t.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", t.config.SMTPHost, err)
+	}
+	return t.send(ctx, c, email.To, msg)
+}
+
+// send delivers msg over c and returns c to the pool if it is still usable.
+func (t *SMTPTransport) send(ctx context.Context, c *smtpConn, to string, msg []byte) error {
+	stop := watch(ctx, c.conn)
+	err := deliver(c.client, t.config.FromEmail, to, msg)
+	if !stop() {
+		err = errors.Join(ctx.Err(), err)
+	}
+	if err != nil {
+		c.conn.Close()
+		return fmt.Errorf("failed to send email to %s: %w", to, err)
+	}
+	t.put(c)
+	return nil
+}
+
+func deliver(client *smtp.Client, from, to string, msg []byte) error {
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (t *SMTPTransport) dial(ctx context.Context) (*smtpConn, error) {
+	addr := net.JoinHostPort(t.config.SMTPHost, strconv.Itoa(t.config.SMTPPort))
+	tlsConfig := &tls.Config{ServerName: t.config.SMTPHost}
+
+	conn, err := t.dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if t.config.SMTPPort == implicitTLSPort {
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	stop := watch(ctx, conn)
+	client, err := t.handshake(conn, tlsConfig)
+	if !stop() {
+		err = errors.Join(ctx.Err(), err)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &smtpConn{conn: conn, client: client}, nil
+}
+
+func (t *SMTPTransport) handshake(conn net.Conn, tlsConfig *tls.Config) (*smtp.Client, error) {
+	client, err := smtp.NewClient(conn, t.config.SMTPHost)
+	if err != nil {
+		return nil, err
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return nil, err
+		}
+	}
+	if ok, _ := client.Extension("AUTH"); ok && t.config.Password != "" {
+		auth := smtp.PlainAuth("", t.config.FromEmail, t.config.Password, t.config.SMTPHost)
+		if err := client.Auth(auth); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// watch makes I/O on conn fail once ctx is done, including when ctx has a
+// deadline that passes mid-command. The returned stop reports false if ctx
+// ended while watched; the connection is then unusable.
+func watch(ctx context.Context, conn net.Conn) (stop func() bool) {
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+	stopAfter := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Unix(1, 0))
+	})
+	return func() bool {
+		if !stopAfter() {
+			return false
+		}
+		conn.SetDeadline(time.Time{})
+		return true
+	}
+}
+
+// quitTimeout bounds how long closing a pooled connection may take.
+const quitTimeout = 5 * time.Second
+
+func (c *smtpConn) quit() error {
+	c.conn.SetDeadline(time.Now().Add(quitTimeout))
+	defer c.conn.Close()
+	return c.client.Quit()
+}
+
+// get returns a pooled connection that has not been idle for too long.
+func (t *SMTPTransport) get() *smtpConn {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	maxIdle := time.Duration(t.config.SMTPIdleTimeout) * time.Second
+	for len(t.idle) > 0 {
+		c := t.idle[len(t.idle)-1]
+		t.idle = t.idle[:len(t.idle)-1]
+		if time.Since(c.lastUsed) < maxIdle {
+			return c
+		}
+		go c.quit()
+	}
+	return nil
+}
+
+func (t *SMTPTransport) put(c *smtpConn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed || len(t.idle) >= t.config.SMTPPoolSize {
+		go c.quit()
+		return
+	}
+	c.lastUsed = time.Now()
+	t.idle = append(t.idle, c)
+}
+
+// Close ends all pooled connections. Sends after Close still work but no
+// longer reuse connections.
+func (t *SMTPTransport) Close() error {
+	t.mu.Lock()
+	idle := t.idle
+	t.idle = nil
+	t.closed = true
+	t.mu.Unlock()
+
+	var errs []error
+	for _, c := range idle {
+		if err := c.quit(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
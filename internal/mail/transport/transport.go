@@ -0,0 +1,52 @@
+// Package transport contains the mail transports emails are delivered with:
+// pooled SMTP for production, a Maildir writer for local development and an
+// in-memory capture for tests.
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"gopkg.in/gomail.v2"
+)
+
+// New returns the transport selected by cfg.Transport.
+func New(cfg config.SenderConfig) (domain.MailTransport, error) {
+	switch cfg.Transport {
+	case "smtp":
+		return NewSMTPTransport(cfg), nil
+	case "maildir":
+		return NewMaildirTransport(cfg.MaildirPath, cfg.FromEmail, cfg.FromName)
+	case "memory":
+		return NewCapture(), nil
+	default:
+		return nil, fmt.Errorf("unknown mail transport %q", cfg.Transport)
+	}
+}
+
+// encode renders email as a MIME message: text/plain with an optional
+// text/html alternative.
+func encode(email *domain.Email, fromEmail, fromName string) ([]byte, error) {
+	m := gomail.NewMessage()
+	m.SetAddressHeader("From", fromEmail, fromName)
+	m.SetHeader("To", email.To)
+	m.SetHeader("Subject", email.Subject)
+	m.SetDateHeader("Date", time.Now())
+	if email.UnsubscribeURL != "" {
+		m.SetHeader("List-Unsubscribe", "<"+email.UnsubscribeURL+">")
+		m.SetHeader("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	m.SetBody("text/plain", email.Text)
+	if email.HTML != "" {
+		m.AddAlternative("text/html", email.HTML)
+	}
+
+	var buf bytes.Buffer
+	if _, err := m.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("failed to encode email: %w", err)
+	}
+	return buf.Bytes(), nil
+}
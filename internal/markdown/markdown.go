@@ -0,0 +1,117 @@
+// Package markdown renders question and answer bodies written in CommonMark
+// with the GitHub extensions (tables, fenced code, strikethrough, autolinks,
+// task lists) to HTML that is safe to embed in pages.
+//
+// Raw HTML in the source is passed through goldmark and then filtered by an
+// allow-list policy, so harmless markup such as <kbd> survives while scripts,
+// event handlers and dangerous URLs are removed. Fenced code blocks with a
+// known language are highlighted with CSS classes; Stylesheet returns the
+// matching CSS.
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+// Version identifies the rendering rules. Bump it whenever the output for
+// the same source changes, so cached HTML is rendered again.
+const Version = 2
+
+var (
+	// highlightClass matches exactly the classes Chroma puts on highlighted
+	// code. Authors can't use them: raw HTML loses its classes before this
+	// policy runs (see rawHTMLRenderer).
+	highlightClass = highlightClasses()
+	languageClass  = regexp.MustCompile(`^language-[\w+#-]+$`)
+	checkboxType   = regexp.MustCompile(`^checkbox$`)
+)
+
+func highlightClasses() *regexp.Regexp {
+	names := []string{"chroma"}
+	for _, class := range chroma.StandardTypes {
+		if class != "" {
+			names = append(names, regexp.QuoteMeta(class))
+		}
+	}
+	sort.Strings(names)
+	return regexp.MustCompile(`^(` + strings.Join(names, "|") + `)$`)
+}
+
+type Renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+	css    string
+}
+
+// New returns a renderer whose stylesheet uses the named Chroma style.
+func New(style string) (*Renderer, error) {
+	chromaStyle, ok := styles.Registry[style]
+	if !ok {
+		return nil, fmt.Errorf("unknown highlight style %q", style)
+	}
+
+	var css bytes.Buffer
+	if err := chromahtml.New(chromahtml.WithClasses(true)).WriteCSS(&css, chromaStyle); err != nil {
+		return nil, fmt.Errorf("failed to build highlight stylesheet: %w", err)
+	}
+
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			highlighting.NewHighlighting(
+				highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+			),
+		),
+		// Raw HTML is kept here, without classes, and filtered by the policy
+		// below.
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+			renderer.WithNodeRenderers(util.Prioritized(rawHTMLRenderer{}, 100)),
+		),
+	)
+
+	return &Renderer{md: md, policy: newPolicy(), css: css.String()}, nil
+}
+
+// Render converts source to sanitized HTML.
+func (r *Renderer) Render(source string) (string, error) {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(source), &buf); err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+	return r.policy.Sanitize(buf.String()), nil
+}
+
+// Stylesheet returns the CSS for highlighted code blocks.
+func (r *Renderer) Stylesheet() string {
+	return r.css
+}
+
+// newPolicy extends the user-generated content policy with what the
+// renderer itself produces (highlighting classes, task list checkboxes) and
+// a few inline elements common in technical posts.
+func newPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+	policy.AllowElements("kbd", "mark")
+	policy.AllowAttrs("class").Matching(highlightClass).OnElements("pre", "span")
+	policy.AllowAttrs("class").Matching(languageClass).OnElements("code")
+	policy.AllowAttrs("type").Matching(checkboxType).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	return policy
+}
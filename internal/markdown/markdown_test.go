@@ -0,0 +1,135 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func newRenderer(t *testing.T) *Renderer {
+	t.Helper()
+	r, err := New("github")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return r
+}
+
+func TestNewUnknownStyle(t *testing.T) {
+	if _, err := New("no-such-style"); err == nil {
+		t.Error("New accepted an unknown highlight style")
+	}
+}
+
+func TestRenderStripsDangerousMarkup(t *testing.T) {
+	r := newRenderer(t)
+
+	for _, tc := range []struct {
+		name, source, forbidden string
+	}{
+		{"script", "hi <script>alert(1)</script>", "<script"},
+		{"event handler", `<img src="x.png" onerror="alert(1)">`, "onerror"},
+		{"javascript link", "[click](javascript:alert(1))", "javascript:"},
+		{"javascript autolink", `<a href="javascript:alert(1)">x</a>`, "javascript:"},
+		{"iframe", `<iframe src="https://example.com"></iframe>`, "<iframe"},
+		{"style attribute", `<p style="position:fixed">x</p>`, "style="},
+		{"form", `<form action="/x"><input name="q"></form>`, "<form"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			html, err := r.Render(tc.source)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			if strings.Contains(html, tc.forbidden) {
+				t.Errorf("output contains %q: %s", tc.forbidden, html)
+			}
+		})
+	}
+}
+
+func TestRenderKeepsAllowedMarkup(t *testing.T) {
+	r := newRenderer(t)
+
+	for _, tc := range []struct {
+		name, source, want string
+	}{
+		{"kbd", "Press <kbd>Ctrl</kbd>", "<kbd>Ctrl</kbd>"},
+		{"table", "| a |\n|---|\n| b |", "<table>"},
+		{"strikethrough", "~~old~~", "<del>old</del>"},
+		{"task list", "- [x] done", `type="checkbox"`},
+		{"external link", "[go](https://go.dev)", `target="_blank"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			html, err := r.Render(tc.source)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			if !strings.Contains(html, tc.want) {
+				t.Errorf("output lacks %q: %s", tc.want, html)
+			}
+		})
+	}
+}
+
+func TestRenderHighlightsCode(t *testing.T) {
+	r := newRenderer(t)
+
+	html, err := r.Render("```go\nfunc main() {}\n```")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if !strings.Contains(html, `class="chroma"`) {
+		t.Errorf("highlighted block lost its chroma class: %s", html)
+	}
+	if !strings.Contains(html, `<span class="kd">func</span>`) {
+		t.Errorf("keyword not highlighted: %s", html)
+	}
+}
+
+func TestRenderDropsUnknownClasses(t *testing.T) {
+	r := newRenderer(t)
+
+	html, err := r.Render(`<span class="admin-badge">x</span> <code class="evil">y</code>`)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if strings.Contains(html, "admin-badge") || strings.Contains(html, "evil") {
+		t.Errorf("arbitrary classes kept: %s", html)
+	}
+}
+
+func TestStylesheet(t *testing.T) {
+	r := newRenderer(t)
+	if !strings.Contains(r.Stylesheet(), ".chroma") {
+		t.Error("stylesheet has no rules for highlighted code")
+	}
+}
+
+func TestRenderDropsHighlightClassesFromRawHTML(t *testing.T) {
+	r := newRenderer(t)
+
+	for _, source := range []string{
+		`<span class="kd">fake keyword</span>`,
+		"<div>\n<pre class=\"chroma\"><span class=\"hl\">x</span></pre>\n</div>",
+		`<span class="nt">tag</span> and <span
+class="err">split tag</span>`,
+	} {
+		html, err := r.Render(source)
+		if err != nil {
+			t.Fatalf("Render: %v", err)
+		}
+		if strings.Contains(html, "class=") {
+			t.Errorf("raw HTML kept a class: %s", html)
+		}
+	}
+}
+
+func TestRenderKeepsOnlyChromaClasses(t *testing.T) {
+	if !highlightClass.MatchString("kd") || !highlightClass.MatchString("chroma") {
+		t.Error("Chroma classes not allowed")
+	}
+	for _, class := range []string{"x1", "abc", "btn", "admin"} {
+		if highlightClass.MatchString(class) {
+			t.Errorf("class %q allowed", class)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+	"golang.org/x/net/html"
+)
+
+// rawHTMLRenderer writes raw HTML from the source like goldmark's own
+// renderer, but drops class attributes from it. Classes in the output then
+// only come from the highlighter, so the policy can allow its classes
+// without letting authors dress up their text as highlighted code.
+type rawHTMLRenderer struct{}
+
+func (rawHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindRawHTML, renderRawHTML)
+	reg.Register(ast.KindHTMLBlock, renderHTMLBlock)
+}
+
+func renderRawHTML(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkSkipChildren, nil
+	}
+	n := node.(*ast.RawHTML)
+	var raw bytes.Buffer
+	for i := range n.Segments.Len() {
+		segment := n.Segments.At(i)
+		raw.Write(segment.Value(source))
+	}
+	_, err := w.Write(stripClasses(raw.Bytes()))
+	return ast.WalkSkipChildren, err
+}
+
+func renderHTMLBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	// The whole block, closure included, is written at once so tags spanning
+	// several lines are parsed as one.
+	n := node.(*ast.HTMLBlock)
+	var raw bytes.Buffer
+	for i := range n.Lines().Len() {
+		line := n.Lines().At(i)
+		raw.Write(line.Value(source))
+	}
+	if n.HasClosure() {
+		raw.Write(n.ClosureLine.Value(source))
+	}
+	_, err := w.Write(stripClasses(raw.Bytes()))
+	return ast.WalkContinue, err
+}
+
+// stripClasses rewrites the tags in raw without their class attributes and
+// leaves everything else byte for byte; the policy sanitizes the rest later.
+func stripClasses(raw []byte) []byte {
+	var out bytes.Buffer
+	z := html.NewTokenizer(bytes.NewReader(raw))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			// Whatever the tokenizer could not finish, e.g. a truncated tag,
+			// is dropped rather than passed on unparsed.
+			return out.Bytes()
+		}
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			out.Write(z.Raw())
+			continue
+		}
+		token := z.Token()
+		attrs := token.Attr[:0]
+		for _, attr := range token.Attr {
+			if attr.Key != "class" {
+				attrs = append(attrs, attr)
+			}
+		}
+		token.Attr = attrs
+		out.WriteString(token.String())
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/RofaBR/Go-Usof/internal/domain"
@@ -39,7 +40,50 @@ func AuthMiddleware(tokenService *services.TokenService) gin.HandlerFunc {
 	}
 }
 
-func RoleMiddleware(roles ...string) gin.HandlerFunc {
+// StreamAuthMiddleware authenticates like AuthMiddleware but also accepts a
+// single-use ticket (see TokenService.IssueStreamTicket) in the ticket query
+// parameter, because browsers cannot set headers on EventSource requests.
+// Access tokens are never taken from the URL, where they would be logged.
+func StreamAuthMiddleware(tokenService *services.TokenService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var claims *domain.TokenClaims
+		var err error
+		if parts := strings.Split(c.GetHeader("Authorization"), " "); len(parts) == 2 && parts[0] == "Bearer" {
+			claims, err = tokenService.ValidateAccessToken(c.Request.Context(), parts[1])
+		} else if ticket := c.Query("ticket"); ticket != "" {
+			claims, err = tokenService.RedeemStreamTicket(c.Request.Context(), ticket)
+		} else {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		c.Set(ClaimsKey, claims)
+		c.Next()
+	}
+}
+
+// OptionalAuthMiddleware attaches claims when a valid bearer token is present
+// but never rejects the request. Downstream middleware such as the rate limiter
+// can then key on the user instead of the client IP.
+func OptionalAuthMiddleware(tokenService *services.TokenService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			if claims, err := tokenService.ValidateAccessToken(c.Request.Context(), parts[1]); err == nil {
+				c.Set(ClaimsKey, claims)
+			}
+		}
+		c.Next()
+	}
+}
+
+// RequirePermission lets the request through only when the user in the
+// claims set by AuthMiddleware has permission. The user's current role is
+// looked up, so a role in a token issued before a change does not count.
+func RequirePermission(permissionService *services.PermissionService, permission string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		raw, exists := c.Get(ClaimsKey)
 		if !exists {
@@ -55,14 +99,25 @@ func RoleMiddleware(roles ...string) gin.HandlerFunc {
 			return
 		}
 
-		for _, role := range roles {
-			if claims.Role == role {
-				c.Next()
-				return
-			}
+		userID, err := strconv.ParseInt(claims.UserID, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+			c.Abort()
+			return
 		}
 
-		c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
-		c.Abort()
+		allowed, err := permissionService.UserHas(c.Request.Context(), userID, permission)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check permissions"})
+			c.Abort()
+			return
+		}
+		if !allowed {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
 	}
 }
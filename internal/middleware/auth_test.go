@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/services"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type fakePermissionRepo struct {
+	domain.PermissionRepository
+}
+
+func (fakePermissionRepo) ForRole(_ context.Context, role string) ([]string, error) {
+	if role == domain.RoleAdmin {
+		return []string{domain.PermissionCategoryWrite}, nil
+	}
+	return nil, nil
+}
+
+type noPermissionCache struct{}
+
+func (noPermissionCache) Get(context.Context, string) ([]string, bool, error) {
+	return nil, false, nil
+}
+
+func (noPermissionCache) Set(context.Context, string, []string, time.Duration) error {
+	return nil
+}
+
+func (noPermissionCache) Delete(context.Context, string) error {
+	return nil
+}
+
+type fakeUserRepo struct {
+	domain.UserRepository
+	roles map[int64]string
+}
+
+func (r fakeUserRepo) GetByID(_ context.Context, id int64) (*domain.User, error) {
+	role, ok := r.roles[id]
+	if !ok {
+		return nil, nil
+	}
+	return &domain.User{ID: id, Role: role}, nil
+}
+
+func TestRequirePermissionUsesCurrentRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	users := fakeUserRepo{roles: map[int64]string{1: domain.RoleAdmin, 2: domain.RoleUser}}
+	permissions := services.NewPermissionService(fakePermissionRepo{}, noPermissionCache{}, users, nil, config.PermissionConfig{}, logger.New("error"))
+
+	for _, tc := range []struct {
+		name   string
+		claims *domain.TokenClaims
+		want   int
+	}{
+		{"admin", &domain.TokenClaims{UserID: "1", Role: domain.RoleAdmin}, http.StatusOK},
+		{"demoted admin", &domain.TokenClaims{UserID: "2", Role: domain.RoleAdmin}, http.StatusForbidden},
+		{"deleted user", &domain.TokenClaims{UserID: "3", Role: domain.RoleAdmin}, http.StatusForbidden},
+	} {
+		r := gin.New()
+		r.Use(func(c *gin.Context) { c.Set(ClaimsKey, tc.claims) })
+		r.GET("/", RequirePermission(permissions, domain.PermissionCategoryWrite), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		if w.Code != tc.want {
+			t.Errorf("%s: status %d, want %d", tc.name, w.Code, tc.want)
+		}
+	}
+}
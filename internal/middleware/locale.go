@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/RofaBR/Go-Usof/internal/mail"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// LocaleMiddleware picks the best supported locale from Accept-Language and
+// stores it in the request context for email templates. supported[0] is the
+// fallback.
+func LocaleMiddleware(supported []string) gin.HandlerFunc {
+	tags := make([]language.Tag, len(supported))
+	for i, locale := range supported {
+		tags[i] = language.Make(locale)
+	}
+	matcher := language.NewMatcher(tags)
+
+	return func(c *gin.Context) {
+		header := c.GetHeader("Accept-Language")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		preferred, _, err := language.ParseAcceptLanguage(header)
+		if err == nil && len(preferred) > 0 {
+			_, index, _ := matcher.Match(preferred...)
+			ctx := mail.WithLocale(c.Request.Context(), supported[index])
+			c.Request = c.Request.WithContext(ctx)
+		}
+		c.Next()
+	}
+}
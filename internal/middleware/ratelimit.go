@@ -0,0 +1,237 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type RateLimitPolicy struct {
+	Name   string
+	Limit  int
+	Window time.Duration
+}
+
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	Reset     time.Duration // time until the oldest request in the window expires
+}
+
+// RateLimiter counts requests per key over a sliding window.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, policy RateLimitPolicy) (*RateLimitResult, error)
+}
+
+// slidingWindowScript keeps one sorted-set member per request scored by its
+// timestamp in milliseconds. It returns {allowed, count, oldest}.
+var slidingWindowScript = goredis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	count = count + 1
+	allowed = 1
+end
+
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+local oldestScore = now
+if oldest[2] then
+	oldestScore = tonumber(oldest[2])
+end
+return {allowed, count, oldestScore}
+`)
+
+type RedisRateLimiter struct {
+	client *goredis.Client
+}
+
+func NewRedisRateLimiter(client *goredis.Client) *RedisRateLimiter {
+	return &RedisRateLimiter{client: client}
+}
+
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string, policy RateLimitPolicy) (*RateLimitResult, error) {
+	now := time.Now().UnixMilli()
+	window := policy.Window.Milliseconds()
+
+	res, err := slidingWindowScript.Run(ctx, l.client, []string{key},
+		now, window, policy.Limit, uuid.New().String(),
+	).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate rate limit: %w", err)
+	}
+
+	return buildResult(policy, res[0] == 1, int(res[1]), time.Duration(res[2]+window-now)*time.Millisecond), nil
+}
+
+// MemoryRateLimiter is an in-process sliding window limiter for tests and
+// single-instance development setups. Keys are kept until their window has
+// passed; RunJanitor drops them afterwards.
+type MemoryRateLimiter struct {
+	mu      sync.Mutex
+	entries map[string]*memoryWindow
+}
+
+type memoryWindow struct {
+	hits    []time.Time
+	expires time.Time // when the newest hit leaves the window
+}
+
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{entries: make(map[string]*memoryWindow)}
+}
+
+func (l *MemoryRateLimiter) Allow(_ context.Context, key string, policy RateLimitPolicy) (*RateLimitResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-policy.Window)
+
+	entry, ok := l.entries[key]
+	if !ok {
+		entry = &memoryWindow{}
+		l.entries[key] = entry
+	}
+
+	kept := entry.hits[:0]
+	for _, t := range entry.hits {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+
+	allowed := len(kept) < policy.Limit
+	if allowed {
+		kept = append(kept, now)
+	}
+	entry.hits = kept
+	if len(kept) > 0 {
+		entry.expires = kept[len(kept)-1].Add(policy.Window)
+	}
+
+	reset := policy.Window
+	if len(kept) > 0 {
+		reset = kept[0].Add(policy.Window).Sub(now)
+	}
+
+	return buildResult(policy, allowed, len(kept), reset), nil
+}
+
+// Sweep removes keys whose hits have all left their window and returns how
+// many were removed.
+func (l *MemoryRateLimiter) Sweep(now time.Time) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	removed := 0
+	for key, entry := range l.entries {
+		if !entry.expires.After(now) {
+			delete(l.entries, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// RunJanitor sweeps expired keys every interval until ctx is cancelled.
+func (l *MemoryRateLimiter) RunJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			l.Sweep(now)
+		}
+	}
+}
+
+func buildResult(policy RateLimitPolicy, allowed bool, count int, reset time.Duration) *RateLimitResult {
+	return &RateLimitResult{
+		Allowed:   allowed,
+		Limit:     policy.Limit,
+		Remaining: max(policy.Limit-count, 0),
+		Reset:     max(reset, 0),
+	}
+}
+
+// RateLimitMiddleware applies a single policy to every request in the group.
+func RateLimitMiddleware(limiter RateLimiter, policy RateLimitPolicy, log *logger.Logger) gin.HandlerFunc {
+	return rateLimit(limiter, log, func(*gin.Context) RateLimitPolicy {
+		return policy
+	})
+}
+
+// MethodRateLimitMiddleware applies the read policy to safe methods and the
+// write policy to everything else.
+func MethodRateLimitMiddleware(limiter RateLimiter, read, write RateLimitPolicy, log *logger.Logger) gin.HandlerFunc {
+	return rateLimit(limiter, log, func(c *gin.Context) RateLimitPolicy {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return read
+		default:
+			return write
+		}
+	})
+}
+
+func rateLimit(limiter RateLimiter, log *logger.Logger, selectPolicy func(*gin.Context) RateLimitPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy := selectPolicy(c)
+		key := fmt.Sprintf("ratelimit:%s:%s", policy.Name, rateLimitSubject(c))
+
+		result, err := limiter.Allow(c.Request.Context(), key, policy)
+		if err != nil {
+			log.Error("rate limiter unavailable, allowing request", "policy", policy.Name, "error", err)
+			c.Next()
+			return
+		}
+
+		reset := strconv.Itoa(int(math.Ceil(result.Reset.Seconds())))
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", reset)
+		c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Limit, int(policy.Window.Seconds())))
+
+		if !result.Allowed {
+			log.Warn("rate limit exceeded", "policy", policy.Name, "key", key)
+			c.Header("Retry-After", reset)
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// rateLimitSubject keys authenticated requests by user ID and everyone else by client IP.
+func rateLimitSubject(c *gin.Context) string {
+	if raw, exists := c.Get(ClaimsKey); exists {
+		if claims, ok := raw.(*domain.TokenClaims); ok && claims.UserID != "" {
+			return "user:" + claims.UserID
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
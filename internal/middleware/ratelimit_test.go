@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMemoryRateLimiterAllow(t *testing.T) {
+	limiter := NewMemoryRateLimiter()
+	policy := RateLimitPolicy{Name: "test", Limit: 3, Window: time.Minute}
+	ctx := context.Background()
+
+	for i := range 3 {
+		result, err := limiter.Allow(ctx, "key", policy)
+		if err != nil {
+			t.Fatalf("Allow: %v", err)
+		}
+		if !result.Allowed {
+			t.Fatalf("request %d rejected, want allowed", i+1)
+		}
+		if want := 2 - i; result.Remaining != want {
+			t.Errorf("request %d: Remaining = %d, want %d", i+1, result.Remaining, want)
+		}
+	}
+
+	result, err := limiter.Allow(ctx, "key", policy)
+	if err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+	if result.Allowed {
+		t.Fatal("request over the limit allowed")
+	}
+	if result.Remaining != 0 {
+		t.Errorf("Remaining = %d, want 0", result.Remaining)
+	}
+	if result.Reset <= 0 || result.Reset > policy.Window {
+		t.Errorf("Reset = %v, want within (0, %v]", result.Reset, policy.Window)
+	}
+
+	other, err := limiter.Allow(ctx, "other", policy)
+	if err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+	if !other.Allowed {
+		t.Error("a different key shares the limit")
+	}
+}
+
+func TestMemoryRateLimiterWindowSlides(t *testing.T) {
+	limiter := NewMemoryRateLimiter()
+	policy := RateLimitPolicy{Name: "test", Limit: 1, Window: 20 * time.Millisecond}
+	ctx := context.Background()
+
+	if result, _ := limiter.Allow(ctx, "key", policy); !result.Allowed {
+		t.Fatal("first request rejected")
+	}
+	if result, _ := limiter.Allow(ctx, "key", policy); result.Allowed {
+		t.Fatal("second request inside the window allowed")
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if result, _ := limiter.Allow(ctx, "key", policy); !result.Allowed {
+		t.Error("request after the window rejected")
+	}
+}
+
+func TestMemoryRateLimiterSweep(t *testing.T) {
+	limiter := NewMemoryRateLimiter()
+	ctx := context.Background()
+	short := RateLimitPolicy{Name: "short", Limit: 5, Window: time.Second}
+	long := RateLimitPolicy{Name: "long", Limit: 5, Window: time.Hour}
+
+	limiter.Allow(ctx, "short", short)
+	limiter.Allow(ctx, "long", long)
+
+	if removed := limiter.Sweep(time.Now()); removed != 0 {
+		t.Errorf("Sweep removed %d live keys", removed)
+	}
+	if removed := limiter.Sweep(time.Now().Add(2 * time.Second)); removed != 1 {
+		t.Errorf("Sweep removed %d keys, want 1", removed)
+	}
+	if _, ok := limiter.entries["short"]; ok {
+		t.Error("expired key kept")
+	}
+	if _, ok := limiter.entries["long"]; !ok {
+		t.Error("live key removed")
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	policy := RateLimitPolicy{Name: "auth", Limit: 1, Window: time.Minute}
+
+	r := gin.New()
+	r.Use(RateLimitMiddleware(NewMemoryRateLimiter(), policy, logger.New("error")))
+	r.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	first := httptest.NewRecorder()
+	r.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/", nil))
+	if first.Code != http.StatusOK {
+		t.Fatalf("first request: status %d, want 200", first.Code)
+	}
+	if got := first.Header().Get("RateLimit-Limit"); got != "1" {
+		t.Errorf("RateLimit-Limit = %q, want 1", got)
+	}
+	if got := first.Header().Get("RateLimit-Remaining"); got != "0" {
+		t.Errorf("RateLimit-Remaining = %q, want 0", got)
+	}
+
+	second := httptest.NewRecorder()
+	r.ServeHTTP(second, httptest.NewRequest(http.MethodGet, "/", nil))
+	if second.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request: status %d, want 429", second.Code)
+	}
+	if second.Header().Get("Retry-After") == "" {
+		t.Error("Retry-After missing on 429")
+	}
+}
+
+func TestMethodRateLimitMiddlewareSelectsPolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	read := RateLimitPolicy{Name: "read", Limit: 2, Window: time.Minute}
+	write := RateLimitPolicy{Name: "write", Limit: 1, Window: time.Minute}
+
+	r := gin.New()
+	r.Use(MethodRateLimitMiddleware(NewMemoryRateLimiter(), read, write, logger.New("error")))
+	r.Any("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for _, tc := range []struct {
+		method string
+		want   int
+	}{
+		{http.MethodPost, http.StatusOK},
+		{http.MethodPost, http.StatusTooManyRequests},
+		{http.MethodGet, http.StatusOK},
+		{http.MethodGet, http.StatusOK},
+		{http.MethodGet, http.StatusTooManyRequests},
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(tc.method, "/", nil))
+		if w.Code != tc.want {
+			t.Errorf("%s: status %d, want %d", tc.method, w.Code, tc.want)
+		}
+	}
+}
@@ -31,7 +31,31 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 	}
 }
 
-type joins[Q dialect.Joinable] struct{}
+type joins[Q dialect.Joinable] struct {
+	Activities              joinSet[activityJoins[Q]]
+	ActivityTargets         joinSet[activityTargetJoins[Q]]
+	Answers                 joinSet[answerJoins[Q]]
+	Attachments             joinSet[attachmentJoins[Q]]
+	BookmarkCollections     joinSet[bookmarkCollectionJoins[Q]]
+	Bookmarks               joinSet[bookmarkJoins[Q]]
+	Categories              joinSet[categoryJoins[Q]]
+	Comments                joinSet[commentJoins[Q]]
+	Flags                   joinSet[flagJoins[Q]]
+	Follows                 joinSet[followJoins[Q]]
+	LoginHistories          joinSet[loginHistoryJoins[Q]]
+	ModerationActions       joinSet[moderationActionJoins[Q]]
+	NotificationDigests     joinSet[notificationDigestJoins[Q]]
+	NotificationPreferences joinSet[notificationPreferenceJoins[Q]]
+	Notifications           joinSet[notificationJoins[Q]]
+	Permissions             joinSet[permissionJoins[Q]]
+	PostRevisions           joinSet[postRevisionJoins[Q]]
+	QuestionCategories      joinSet[questionCategoryJoins[Q]]
+	Questions               joinSet[questionJoins[Q]]
+	RolePermissions         joinSet[rolePermissionJoins[Q]]
+	UnsubscribeVersions     joinSet[unsubscribeVersionJoins[Q]]
+	Users                   joinSet[userJoins[Q]]
+	Votes                   joinSet[voteJoins[Q]]
+}
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
 	return joinSet[Q]{
@@ -42,7 +66,31 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 }
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
-	return joins[Q]{}
+	return joins[Q]{
+		Activities:              buildJoinSet[activityJoins[Q]](Activities.Columns, buildActivityJoins),
+		ActivityTargets:         buildJoinSet[activityTargetJoins[Q]](ActivityTargets.Columns, buildActivityTargetJoins),
+		Answers:                 buildJoinSet[answerJoins[Q]](Answers.Columns, buildAnswerJoins),
+		Attachments:             buildJoinSet[attachmentJoins[Q]](Attachments.Columns, buildAttachmentJoins),
+		BookmarkCollections:     buildJoinSet[bookmarkCollectionJoins[Q]](BookmarkCollections.Columns, buildBookmarkCollectionJoins),
+		Bookmarks:               buildJoinSet[bookmarkJoins[Q]](Bookmarks.Columns, buildBookmarkJoins),
+		Categories:              buildJoinSet[categoryJoins[Q]](Categories.Columns, buildCategoryJoins),
+		Comments:                buildJoinSet[commentJoins[Q]](Comments.Columns, buildCommentJoins),
+		Flags:                   buildJoinSet[flagJoins[Q]](Flags.Columns, buildFlagJoins),
+		Follows:                 buildJoinSet[followJoins[Q]](Follows.Columns, buildFollowJoins),
+		LoginHistories:          buildJoinSet[loginHistoryJoins[Q]](LoginHistories.Columns, buildLoginHistoryJoins),
+		ModerationActions:       buildJoinSet[moderationActionJoins[Q]](ModerationActions.Columns, buildModerationActionJoins),
+		NotificationDigests:     buildJoinSet[notificationDigestJoins[Q]](NotificationDigests.Columns, buildNotificationDigestJoins),
+		NotificationPreferences: buildJoinSet[notificationPreferenceJoins[Q]](NotificationPreferences.Columns, buildNotificationPreferenceJoins),
+		Notifications:           buildJoinSet[notificationJoins[Q]](Notifications.Columns, buildNotificationJoins),
+		Permissions:             buildJoinSet[permissionJoins[Q]](Permissions.Columns, buildPermissionJoins),
+		PostRevisions:           buildJoinSet[postRevisionJoins[Q]](PostRevisions.Columns, buildPostRevisionJoins),
+		QuestionCategories:      buildJoinSet[questionCategoryJoins[Q]](QuestionCategories.Columns, buildQuestionCategoryJoins),
+		Questions:               buildJoinSet[questionJoins[Q]](Questions.Columns, buildQuestionJoins),
+		RolePermissions:         buildJoinSet[rolePermissionJoins[Q]](RolePermissions.Columns, buildRolePermissionJoins),
+		UnsubscribeVersions:     buildJoinSet[unsubscribeVersionJoins[Q]](UnsubscribeVersions.Columns, buildUnsubscribeVersionJoins),
+		Users:                   buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
+		Votes:                   buildJoinSet[voteJoins[Q]](Votes.Columns, buildVoteJoins),
+	}
 }
 
 type modAs[Q any, C interface{ AliasedAs(string) C }] struct {
@@ -16,10 +16,58 @@ import (
 
 var Preload = getPreloaders()
 
-type preloaders struct{}
+type preloaders struct {
+	Activity               activityPreloader
+	ActivityTarget         activityTargetPreloader
+	Answer                 answerPreloader
+	Attachment             attachmentPreloader
+	BookmarkCollection     bookmarkCollectionPreloader
+	Bookmark               bookmarkPreloader
+	Category               categoryPreloader
+	Comment                commentPreloader
+	Flag                   flagPreloader
+	Follow                 followPreloader
+	LoginHistory           loginHistoryPreloader
+	ModerationAction       moderationActionPreloader
+	NotificationDigest     notificationDigestPreloader
+	NotificationPreference notificationPreferencePreloader
+	Notification           notificationPreloader
+	Permission             permissionPreloader
+	PostRevision           postRevisionPreloader
+	QuestionCategory       questionCategoryPreloader
+	Question               questionPreloader
+	RolePermission         rolePermissionPreloader
+	UnsubscribeVersion     unsubscribeVersionPreloader
+	User                   userPreloader
+	Vote                   votePreloader
+}
 
 func getPreloaders() preloaders {
-	return preloaders{}
+	return preloaders{
+		Activity:               buildActivityPreloader(),
+		ActivityTarget:         buildActivityTargetPreloader(),
+		Answer:                 buildAnswerPreloader(),
+		Attachment:             buildAttachmentPreloader(),
+		BookmarkCollection:     buildBookmarkCollectionPreloader(),
+		Bookmark:               buildBookmarkPreloader(),
+		Category:               buildCategoryPreloader(),
+		Comment:                buildCommentPreloader(),
+		Flag:                   buildFlagPreloader(),
+		Follow:                 buildFollowPreloader(),
+		LoginHistory:           buildLoginHistoryPreloader(),
+		ModerationAction:       buildModerationActionPreloader(),
+		NotificationDigest:     buildNotificationDigestPreloader(),
+		NotificationPreference: buildNotificationPreferencePreloader(),
+		Notification:           buildNotificationPreloader(),
+		Permission:             buildPermissionPreloader(),
+		PostRevision:           buildPostRevisionPreloader(),
+		QuestionCategory:       buildQuestionCategoryPreloader(),
+		Question:               buildQuestionPreloader(),
+		RolePermission:         buildRolePermissionPreloader(),
+		UnsubscribeVersion:     buildUnsubscribeVersionPreloader(),
+		User:                   buildUserPreloader(),
+		Vote:                   buildVotePreloader(),
+	}
 }
 
 var (
@@ -28,10 +76,58 @@ var (
 	UpdateThenLoad = getThenLoaders[*dialect.UpdateQuery]()
 )
 
-type thenLoaders[Q orm.Loadable] struct{}
+type thenLoaders[Q orm.Loadable] struct {
+	Activity               activityThenLoader[Q]
+	ActivityTarget         activityTargetThenLoader[Q]
+	Answer                 answerThenLoader[Q]
+	Attachment             attachmentThenLoader[Q]
+	BookmarkCollection     bookmarkCollectionThenLoader[Q]
+	Bookmark               bookmarkThenLoader[Q]
+	Category               categoryThenLoader[Q]
+	Comment                commentThenLoader[Q]
+	Flag                   flagThenLoader[Q]
+	Follow                 followThenLoader[Q]
+	LoginHistory           loginHistoryThenLoader[Q]
+	ModerationAction       moderationActionThenLoader[Q]
+	NotificationDigest     notificationDigestThenLoader[Q]
+	NotificationPreference notificationPreferenceThenLoader[Q]
+	Notification           notificationThenLoader[Q]
+	Permission             permissionThenLoader[Q]
+	PostRevision           postRevisionThenLoader[Q]
+	QuestionCategory       questionCategoryThenLoader[Q]
+	Question               questionThenLoader[Q]
+	RolePermission         rolePermissionThenLoader[Q]
+	UnsubscribeVersion     unsubscribeVersionThenLoader[Q]
+	User                   userThenLoader[Q]
+	Vote                   voteThenLoader[Q]
+}
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
-	return thenLoaders[Q]{}
+	return thenLoaders[Q]{
+		Activity:               buildActivityThenLoader[Q](),
+		ActivityTarget:         buildActivityTargetThenLoader[Q](),
+		Answer:                 buildAnswerThenLoader[Q](),
+		Attachment:             buildAttachmentThenLoader[Q](),
+		BookmarkCollection:     buildBookmarkCollectionThenLoader[Q](),
+		Bookmark:               buildBookmarkThenLoader[Q](),
+		Category:               buildCategoryThenLoader[Q](),
+		Comment:                buildCommentThenLoader[Q](),
+		Flag:                   buildFlagThenLoader[Q](),
+		Follow:                 buildFollowThenLoader[Q](),
+		LoginHistory:           buildLoginHistoryThenLoader[Q](),
+		ModerationAction:       buildModerationActionThenLoader[Q](),
+		NotificationDigest:     buildNotificationDigestThenLoader[Q](),
+		NotificationPreference: buildNotificationPreferenceThenLoader[Q](),
+		Notification:           buildNotificationThenLoader[Q](),
+		Permission:             buildPermissionThenLoader[Q](),
+		PostRevision:           buildPostRevisionThenLoader[Q](),
+		QuestionCategory:       buildQuestionCategoryThenLoader[Q](),
+		Question:               buildQuestionThenLoader[Q](),
+		RolePermission:         buildRolePermissionThenLoader[Q](),
+		UnsubscribeVersion:     buildUnsubscribeVersionThenLoader[Q](),
+		User:                   buildUserThenLoader[Q](),
+		Vote:                   buildVoteThenLoader[Q](),
+	}
 }
 
 func thenLoadBuilder[Q orm.Loadable, T any](name string, f func(context.Context, bob.Executor, T, ...bob.Mod[*dialect.SelectQuery]) error) func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q] {
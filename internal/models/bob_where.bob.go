@@ -17,17 +17,83 @@ var (
 )
 
 func Where[Q psql.Filterable]() struct {
-	Categories       categoryWhere[Q]
-	SchemaMigrations schemaMigrationWhere[Q]
-	Users            userWhere[Q]
+	Activities              activityWhere[Q]
+	ActivityTargets         activityTargetWhere[Q]
+	Answers                 answerWhere[Q]
+	Attachments             attachmentWhere[Q]
+	BookmarkCollections     bookmarkCollectionWhere[Q]
+	Bookmarks               bookmarkWhere[Q]
+	Categories              categoryWhere[Q]
+	Comments                commentWhere[Q]
+	EmailOutboxes           emailOutboxWhere[Q]
+	Flags                   flagWhere[Q]
+	Follows                 followWhere[Q]
+	LoginHistories          loginHistoryWhere[Q]
+	ModerationActions       moderationActionWhere[Q]
+	NotificationDigests     notificationDigestWhere[Q]
+	NotificationPreferences notificationPreferenceWhere[Q]
+	Notifications           notificationWhere[Q]
+	Permissions             permissionWhere[Q]
+	PostRevisions           postRevisionWhere[Q]
+	QuestionCategories      questionCategoryWhere[Q]
+	Questions               questionWhere[Q]
+	RolePermissions         rolePermissionWhere[Q]
+	SchemaMigrations        schemaMigrationWhere[Q]
+	UnsubscribeVersions     unsubscribeVersionWhere[Q]
+	Users                   userWhere[Q]
+	Votes                   voteWhere[Q]
 } {
 	return struct {
-		Categories       categoryWhere[Q]
-		SchemaMigrations schemaMigrationWhere[Q]
-		Users            userWhere[Q]
+		Activities              activityWhere[Q]
+		ActivityTargets         activityTargetWhere[Q]
+		Answers                 answerWhere[Q]
+		Attachments             attachmentWhere[Q]
+		BookmarkCollections     bookmarkCollectionWhere[Q]
+		Bookmarks               bookmarkWhere[Q]
+		Categories              categoryWhere[Q]
+		Comments                commentWhere[Q]
+		EmailOutboxes           emailOutboxWhere[Q]
+		Flags                   flagWhere[Q]
+		Follows                 followWhere[Q]
+		LoginHistories          loginHistoryWhere[Q]
+		ModerationActions       moderationActionWhere[Q]
+		NotificationDigests     notificationDigestWhere[Q]
+		NotificationPreferences notificationPreferenceWhere[Q]
+		Notifications           notificationWhere[Q]
+		Permissions             permissionWhere[Q]
+		PostRevisions           postRevisionWhere[Q]
+		QuestionCategories      questionCategoryWhere[Q]
+		Questions               questionWhere[Q]
+		RolePermissions         rolePermissionWhere[Q]
+		SchemaMigrations        schemaMigrationWhere[Q]
+		UnsubscribeVersions     unsubscribeVersionWhere[Q]
+		Users                   userWhere[Q]
+		Votes                   voteWhere[Q]
 	}{
-		Categories:       buildCategoryWhere[Q](Categories.Columns),
-		SchemaMigrations: buildSchemaMigrationWhere[Q](SchemaMigrations.Columns),
-		Users:            buildUserWhere[Q](Users.Columns),
+		Activities:              buildActivityWhere[Q](Activities.Columns),
+		ActivityTargets:         buildActivityTargetWhere[Q](ActivityTargets.Columns),
+		Answers:                 buildAnswerWhere[Q](Answers.Columns),
+		Attachments:             buildAttachmentWhere[Q](Attachments.Columns),
+		BookmarkCollections:     buildBookmarkCollectionWhere[Q](BookmarkCollections.Columns),
+		Bookmarks:               buildBookmarkWhere[Q](Bookmarks.Columns),
+		Categories:              buildCategoryWhere[Q](Categories.Columns),
+		Comments:                buildCommentWhere[Q](Comments.Columns),
+		EmailOutboxes:           buildEmailOutboxWhere[Q](EmailOutboxes.Columns),
+		Flags:                   buildFlagWhere[Q](Flags.Columns),
+		Follows:                 buildFollowWhere[Q](Follows.Columns),
+		LoginHistories:          buildLoginHistoryWhere[Q](LoginHistories.Columns),
+		ModerationActions:       buildModerationActionWhere[Q](ModerationActions.Columns),
+		NotificationDigests:     buildNotificationDigestWhere[Q](NotificationDigests.Columns),
+		NotificationPreferences: buildNotificationPreferenceWhere[Q](NotificationPreferences.Columns),
+		Notifications:           buildNotificationWhere[Q](Notifications.Columns),
+		Permissions:             buildPermissionWhere[Q](Permissions.Columns),
+		PostRevisions:           buildPostRevisionWhere[Q](PostRevisions.Columns),
+		QuestionCategories:      buildQuestionCategoryWhere[Q](QuestionCategories.Columns),
+		Questions:               buildQuestionWhere[Q](Questions.Columns),
+		RolePermissions:         buildRolePermissionWhere[Q](RolePermissions.Columns),
+		SchemaMigrations:        buildSchemaMigrationWhere[Q](SchemaMigrations.Columns),
+		UnsubscribeVersions:     buildUnsubscribeVersionWhere[Q](UnsubscribeVersions.Columns),
+		Users:                   buildUserWhere[Q](Users.Columns),
+		Votes:                   buildVoteWhere[Q](Votes.Columns),
 	}
 }
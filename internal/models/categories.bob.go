@@ -5,7 +5,9 @@ package models
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
@@ -17,6 +19,10 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+	"github.com/stephenafamo/scan"
 )
 
 // Category is an object representing the database table.
@@ -25,6 +31,8 @@ type Category struct {
 	Title       string           `db:"title" `
 	Slug        string           `db:"slug" `
 	Description null.Val[string] `db:"description" `
+
+	R categoryR `db:"-" `
 }
 
 // CategorySlice is an alias for a slice of pointers to Category.
@@ -37,6 +45,11 @@ var Categories = psql.NewTablex[*Category, CategorySlice, *CategorySetter]("", "
 // CategoriesQuery is a query on the categories table
 type CategoriesQuery = *psql.ViewQuery[*Category, CategorySlice]
 
+// categoryR is where relationships are stored.
+type categoryR struct {
+	Questions QuestionSlice // question_categories.question_categories_category_id_fkeyquestion_categories.question_categories_question_id_fkey
+}
+
 func buildCategoryColumns(alias string) categoryColumns {
 	return categoryColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
@@ -240,6 +253,7 @@ func (o *Category) Update(ctx context.Context, exec bob.Executor, s *CategorySet
 		return err
 	}
 
+	o.R = v.R
 	*o = *v
 
 	return nil
@@ -259,7 +273,7 @@ func (o *Category) Reload(ctx context.Context, exec bob.Executor) error {
 	if err != nil {
 		return err
 	}
-
+	o2.R = o.R
 	*o = *o2
 
 	return nil
@@ -306,7 +320,7 @@ func (o CategorySlice) copyMatchingRows(from ...*Category) {
 			if new.ID != old.ID {
 				continue
 			}
-
+			new.R = old.R
 			o[i] = new
 			break
 		}
@@ -404,6 +418,100 @@ func (o CategorySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
+// Questions starts a query for related objects on questions
+func (o *Category) Questions(mods ...bob.Mod[*dialect.SelectQuery]) QuestionsQuery {
+	return Questions.Query(append(mods,
+		sm.InnerJoin(QuestionCategories.NameAs()).On(
+			Questions.Columns.ID.EQ(QuestionCategories.Columns.QuestionID)),
+		sm.Where(QuestionCategories.Columns.CategoryID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os CategorySlice) Questions(mods ...bob.Mod[*dialect.SelectQuery]) QuestionsQuery {
+	pkID := make(pgtypes.Array[int32], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "integer[]")),
+	))
+
+	return Questions.Query(append(mods,
+		sm.InnerJoin(QuestionCategories.NameAs()).On(
+			Questions.Columns.ID.EQ(QuestionCategories.Columns.QuestionID),
+		),
+		sm.Where(psql.Group(QuestionCategories.Columns.CategoryID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachCategoryQuestions0(ctx context.Context, exec bob.Executor, count int, category0 *Category, questions2 QuestionSlice) (QuestionCategorySlice, error) {
+	setters := make([]*QuestionCategorySetter, count)
+	for i := range count {
+		setters[i] = &QuestionCategorySetter{
+			CategoryID: omit.From(category0.ID),
+			QuestionID: omit.From(questions2[i].ID),
+		}
+	}
+
+	questionCategories1, err := QuestionCategories.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachCategoryQuestions0: %w", err)
+	}
+
+	return questionCategories1, nil
+}
+
+func (category0 *Category) InsertQuestions(ctx context.Context, exec bob.Executor, related ...*QuestionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Questions.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	questions2 := QuestionSlice(inserted)
+
+	_, err = attachCategoryQuestions0(ctx, exec, len(related), category0, questions2)
+	if err != nil {
+		return err
+	}
+
+	category0.R.Questions = append(category0.R.Questions, questions2...)
+
+	for _, rel := range questions2 {
+		rel.R.Categories = append(rel.R.Categories, category0)
+	}
+	return nil
+}
+
+func (category0 *Category) AttachQuestions(ctx context.Context, exec bob.Executor, related ...*Question) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	questions2 := QuestionSlice(related)
+
+	_, err = attachCategoryQuestions0(ctx, exec, len(related), category0, questions2)
+	if err != nil {
+		return err
+	}
+
+	category0.R.Questions = append(category0.R.Questions, questions2...)
+
+	for _, rel := range related {
+		rel.R.Categories = append(rel.R.Categories, category0)
+	}
+
+	return nil
+}
+
 type categoryWhere[Q psql.Filterable] struct {
 	ID          psql.WhereMod[Q, int32]
 	Title       psql.WhereMod[Q, string]
@@ -423,3 +531,171 @@ func buildCategoryWhere[Q psql.Filterable](cols categoryColumns) categoryWhere[Q
 		Description: psql.WhereNull[Q, string](cols.Description),
 	}
 }
+
+func (o *Category) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Questions":
+		rels, ok := retrieved.(QuestionSlice)
+		if !ok {
+			return fmt.Errorf("category cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Questions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Categories = CategorySlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("category has no relationship %q", name)
+	}
+}
+
+type categoryPreloader struct{}
+
+func buildCategoryPreloader() categoryPreloader {
+	return categoryPreloader{}
+}
+
+type categoryThenLoader[Q orm.Loadable] struct {
+	Questions func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildCategoryThenLoader[Q orm.Loadable]() categoryThenLoader[Q] {
+	type QuestionsLoadInterface interface {
+		LoadQuestions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return categoryThenLoader[Q]{
+		Questions: thenLoadBuilder[Q](
+			"Questions",
+			func(ctx context.Context, exec bob.Executor, retrieved QuestionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadQuestions(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadQuestions loads the category's Questions into the .R struct
+func (o *Category) LoadQuestions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Questions = nil
+
+	related, err := o.Questions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Categories = CategorySlice{o}
+	}
+
+	o.R.Questions = related
+	return nil
+}
+
+// LoadQuestions loads the category's Questions into the .R struct
+func (os CategorySlice) LoadQuestions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Questions.Columns))
+	}
+
+	q := os.Questions(append(
+		mods,
+		sm.Columns(QuestionCategories.Columns.CategoryID.As("related_categories.ID")),
+	)...)
+
+	IDSlice := []int32{}
+
+	mapper := scan.Mod(scan.StructMapper[*Question](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int32))
+				row.ScheduleScanByName("related_categories.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	questions, err := bob.Allx[bob.SliceTransformer[*Question, QuestionSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Questions = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range questions {
+			if !(o.ID == IDSlice[i]) {
+				continue
+			}
+
+			rel.R.Categories = append(rel.R.Categories, o)
+
+			o.R.Questions = append(o.R.Questions, rel)
+		}
+	}
+
+	return nil
+}
+
+type categoryJoins[Q dialect.Joinable] struct {
+	typ       string
+	Questions modAs[Q, questionColumns]
+}
+
+func (j categoryJoins[Q]) aliasedAs(alias string) categoryJoins[Q] {
+	return buildCategoryJoins[Q](buildCategoryColumns(alias), j.typ)
+}
+
+func buildCategoryJoins[Q dialect.Joinable](cols categoryColumns, typ string) categoryJoins[Q] {
+	return categoryJoins[Q]{
+		typ: typ,
+		Questions: modAs[Q, questionColumns]{
+			c: Questions.Columns,
+			f: func(to questionColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := QuestionCategories.Columns.AliasedAs(QuestionCategories.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, QuestionCategories.Name().As(to.Alias())).On(
+						to.CategoryID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := QuestionCategories.Columns.AliasedAs(QuestionCategories.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Questions.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.QuestionID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -105,6 +105,42 @@ var Users = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		HasPassword: column{
+			Name:      "has_password",
+			DBType:    "boolean",
+			Default:   "true",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Avatar: column{
+			Name:      "avatar",
+			DBType:    "text",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LoginChangedAt: column{
+			Name:      "login_changed_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BannedAt: column{
+			Name:      "banned_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: userIndexes{
 		UsersPkey: index{
@@ -158,6 +194,23 @@ var Users = Table[
 			Where:         "",
 			Include:       []string{},
 		},
+		UsersLoginLowerKey: index{
+			Type: "btree",
+			Name: "users_login_lower_key",
+			Columns: []indexColumn{
+				{
+					Name:         "lower((login)::text)",
+					Desc:         null.FromCond(false, true),
+					IsExpression: true,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "users_pkey",
@@ -182,33 +235,38 @@ var Users = Table[
 }
 
 type userColumns struct {
-	ID            column
-	Login         column
-	Email         column
-	Fullname      column
-	Rating        column
-	Role          column
-	Password      column
-	CreatedAt     column
-	EmailVerified column
-	GoogleID      column
+	ID             column
+	Login          column
+	Email          column
+	Fullname       column
+	Rating         column
+	Role           column
+	Password       column
+	CreatedAt      column
+	EmailVerified  column
+	GoogleID       column
+	HasPassword    column
+	Avatar         column
+	LoginChangedAt column
+	BannedAt       column
 }
 
 func (c userColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.Login, c.Email, c.Fullname, c.Rating, c.Role, c.Password, c.CreatedAt, c.EmailVerified, c.GoogleID,
+		c.ID, c.Login, c.Email, c.Fullname, c.Rating, c.Role, c.Password, c.CreatedAt, c.EmailVerified, c.GoogleID, c.HasPassword, c.Avatar, c.LoginChangedAt, c.BannedAt,
 	}
 }
 
 type userIndexes struct {
-	UsersPkey        index
-	UsersEmailKey    index
-	UsersGoogleIDKey index
+	UsersPkey          index
+	UsersEmailKey      index
+	UsersGoogleIDKey   index
+	UsersLoginLowerKey index
 }
 
 func (i userIndexes) AsSlice() []index {
 	return []index{
-		i.UsersPkey, i.UsersEmailKey, i.UsersGoogleIDKey,
+		i.UsersPkey, i.UsersEmailKey, i.UsersGoogleIDKey, i.UsersLoginLowerKey,
 	}
 }
 
@@ -10,14 +10,16 @@ import (
 
 // Enum values for UserRole
 const (
-	UserRoleUser  UserRole = "user"
-	UserRoleAdmin UserRole = "admin"
+	UserRoleUser      UserRole = "user"
+	UserRoleAdmin     UserRole = "admin"
+	UserRoleModerator UserRole = "moderator"
 )
 
 func AllUserRole() []UserRole {
 	return []UserRole{
 		UserRoleUser,
 		UserRoleAdmin,
+		UserRoleModerator,
 	}
 }
 
@@ -29,7 +31,9 @@ func (e UserRole) String() string {
 
 func (e UserRole) Valid() bool {
 	switch e {
-	case UserRoleUser, UserRoleAdmin:
+	case UserRoleUser,
+		UserRoleAdmin,
+		UserRoleModerator:
 		return true
 	default:
 		return false
@@ -8,14 +8,136 @@ import "context"
 type contextKey string
 
 var (
+	// Relationship Contexts for activities
+	activityWithParentsCascadingCtx = newContextual[bool]("activityWithParentsCascading")
+	activityRelActorUserCtx         = newContextual[bool]("activities.users.activities.activities_actor_id_fkey")
+	activityRelQuestionCtx          = newContextual[bool]("activities.questions.activities.activities_question_id_fkey")
+	activityRelActivityTargetsCtx   = newContextual[bool]("activities.activity_targets.activity_targets.activity_targets_activity_id_fkey")
+
+	// Relationship Contexts for activity_targets
+	activityTargetWithParentsCascadingCtx = newContextual[bool]("activityTargetWithParentsCascading")
+	activityTargetRelActivityCtx          = newContextual[bool]("activities.activity_targets.activity_targets.activity_targets_activity_id_fkey")
+
+	// Relationship Contexts for answers
+	answerWithParentsCascadingCtx       = newContextual[bool]("answerWithParentsCascading")
+	answerRelAuthorUserCtx              = newContextual[bool]("answers.users.answers.answers_author_id_fkey")
+	answerRelQuestionCtx                = newContextual[bool]("answers.questions.answers.answers_question_id_fkey")
+	answerRelAcceptedAnswerQuestionsCtx = newContextual[bool]("answers.questions.questions.questions_accepted_answer_id_fkey")
+
+	// Relationship Contexts for attachments
+	attachmentWithParentsCascadingCtx = newContextual[bool]("attachmentWithParentsCascading")
+	attachmentRelUserCtx              = newContextual[bool]("attachments.users.attachments.attachments_user_id_fkey")
+
+	// Relationship Contexts for bookmark_collections
+	bookmarkCollectionWithParentsCascadingCtx   = newContextual[bool]("bookmarkCollectionWithParentsCascading")
+	bookmarkCollectionRelUserCtx                = newContextual[bool]("bookmark_collections.users.bookmark_collections.bookmark_collections_user_id_fkey")
+	bookmarkCollectionRelCollectionBookmarksCtx = newContextual[bool]("bookmark_collections.bookmarks.bookmarks.bookmarks_collection_id_fkey")
+
+	// Relationship Contexts for bookmarks
+	bookmarkWithParentsCascadingCtx            = newContextual[bool]("bookmarkWithParentsCascading")
+	bookmarkRelCollectionBookmarkCollectionCtx = newContextual[bool]("bookmark_collections.bookmarks.bookmarks.bookmarks_collection_id_fkey")
+	bookmarkRelUserCtx                         = newContextual[bool]("bookmarks.users.bookmarks.bookmarks_user_id_fkey")
+
 	// Relationship Contexts for categories
 	categoryWithParentsCascadingCtx = newContextual[bool]("categoryWithParentsCascading")
+	categoryRelQuestionsCtx         = newContextual[bool]("categories.questions.question_categories.question_categories_category_id_fkeyquestion_categories.question_categories_question_id_fkey")
+
+	// Relationship Contexts for comments
+	commentWithParentsCascadingCtx = newContextual[bool]("commentWithParentsCascading")
+	commentRelAuthorUserCtx        = newContextual[bool]("comments.users.comments.comments_author_id_fkey")
+
+	// Relationship Contexts for email_outbox
+	emailOutboxWithParentsCascadingCtx = newContextual[bool]("emailOutboxWithParentsCascading")
+
+	// Relationship Contexts for flags
+	flagWithParentsCascadingCtx      = newContextual[bool]("flagWithParentsCascading")
+	flagRelActionModerationActionCtx = newContextual[bool]("flags.moderation_actions.flags.flags_action_id_fkey")
+	flagRelReporterUserCtx           = newContextual[bool]("flags.users.flags.flags_reporter_id_fkey")
+
+	// Relationship Contexts for follows
+	followWithParentsCascadingCtx = newContextual[bool]("followWithParentsCascading")
+	followRelUserCtx              = newContextual[bool]("follows.users.follows.follows_user_id_fkey")
+
+	// Relationship Contexts for login_history
+	loginHistoryWithParentsCascadingCtx = newContextual[bool]("loginHistoryWithParentsCascading")
+	loginHistoryRelUserCtx              = newContextual[bool]("login_history.users.login_history.login_history_user_id_fkey")
+
+	// Relationship Contexts for moderation_actions
+	moderationActionWithParentsCascadingCtx = newContextual[bool]("moderationActionWithParentsCascading")
+	moderationActionRelActionFlagsCtx       = newContextual[bool]("flags.moderation_actions.flags.flags_action_id_fkey")
+	moderationActionRelModeratorUserCtx     = newContextual[bool]("moderation_actions.users.moderation_actions.moderation_actions_moderator_id_fkey")
+	moderationActionRelUserCtx              = newContextual[bool]("moderation_actions.users.moderation_actions.moderation_actions_user_id_fkey")
+
+	// Relationship Contexts for notification_digests
+	notificationDigestWithParentsCascadingCtx = newContextual[bool]("notificationDigestWithParentsCascading")
+	notificationDigestRelUserCtx              = newContextual[bool]("notification_digests.users.notification_digests.notification_digests_user_id_fkey")
+
+	// Relationship Contexts for notification_preferences
+	notificationPreferenceWithParentsCascadingCtx = newContextual[bool]("notificationPreferenceWithParentsCascading")
+	notificationPreferenceRelUserCtx              = newContextual[bool]("notification_preferences.users.notification_preferences.notification_preferences_user_id_fkey")
+
+	// Relationship Contexts for notifications
+	notificationWithParentsCascadingCtx = newContextual[bool]("notificationWithParentsCascading")
+	notificationRelActorUserCtx         = newContextual[bool]("notifications.users.notifications.notifications_actor_id_fkey")
+	notificationRelUserCtx              = newContextual[bool]("notifications.users.notifications.notifications_user_id_fkey")
+
+	// Relationship Contexts for permissions
+	permissionWithParentsCascadingCtx = newContextual[bool]("permissionWithParentsCascading")
+	permissionRelRolePermissionsCtx   = newContextual[bool]("permissions.role_permissions.role_permissions.role_permissions_permission_fkey")
+
+	// Relationship Contexts for post_revisions
+	postRevisionWithParentsCascadingCtx = newContextual[bool]("postRevisionWithParentsCascading")
+	postRevisionRelAuthorUserCtx        = newContextual[bool]("post_revisions.users.post_revisions.post_revisions_author_id_fkey")
+
+	// Relationship Contexts for question_categories
+	questionCategoryWithParentsCascadingCtx = newContextual[bool]("questionCategoryWithParentsCascading")
+	questionCategoryRelCategoryCtx          = newContextual[bool]("categories.question_categories.question_categories.question_categories_category_id_fkey")
+	questionCategoryRelQuestionCtx          = newContextual[bool]("question_categories.questions.question_categories.question_categories_question_id_fkey")
+
+	// Relationship Contexts for questions
+	questionWithParentsCascadingCtx    = newContextual[bool]("questionWithParentsCascading")
+	questionRelActivitiesCtx           = newContextual[bool]("activities.questions.activities.activities_question_id_fkey")
+	questionRelAnswersCtx              = newContextual[bool]("answers.questions.answers.answers_question_id_fkey")
+	questionRelCategoriesCtx           = newContextual[bool]("categories.questions.question_categories.question_categories_category_id_fkeyquestion_categories.question_categories_question_id_fkey")
+	questionRelAcceptedAnswerAnswerCtx = newContextual[bool]("answers.questions.questions.questions_accepted_answer_id_fkey")
+	questionRelAuthorUserCtx           = newContextual[bool]("questions.users.questions.questions_author_id_fkey")
+
+	// Relationship Contexts for role_permissions
+	rolePermissionWithParentsCascadingCtx = newContextual[bool]("rolePermissionWithParentsCascading")
+	rolePermissionRelPermissionCtx        = newContextual[bool]("permissions.role_permissions.role_permissions.role_permissions_permission_fkey")
 
 	// Relationship Contexts for schema_migrations
 	schemaMigrationWithParentsCascadingCtx = newContextual[bool]("schemaMigrationWithParentsCascading")
 
+	// Relationship Contexts for unsubscribe_versions
+	unsubscribeVersionWithParentsCascadingCtx = newContextual[bool]("unsubscribeVersionWithParentsCascading")
+	unsubscribeVersionRelUserCtx              = newContextual[bool]("unsubscribe_versions.users.unsubscribe_versions.unsubscribe_versions_user_id_fkey")
+
 	// Relationship Contexts for users
-	userWithParentsCascadingCtx = newContextual[bool]("userWithParentsCascading")
+	userWithParentsCascadingCtx          = newContextual[bool]("userWithParentsCascading")
+	userRelActorActivitiesCtx            = newContextual[bool]("activities.users.activities.activities_actor_id_fkey")
+	userRelAuthorAnswersCtx              = newContextual[bool]("answers.users.answers.answers_author_id_fkey")
+	userRelAttachmentsCtx                = newContextual[bool]("attachments.users.attachments.attachments_user_id_fkey")
+	userRelBookmarkCollectionsCtx        = newContextual[bool]("bookmark_collections.users.bookmark_collections.bookmark_collections_user_id_fkey")
+	userRelBookmarksCtx                  = newContextual[bool]("bookmarks.users.bookmarks.bookmarks_user_id_fkey")
+	userRelAuthorCommentsCtx             = newContextual[bool]("comments.users.comments.comments_author_id_fkey")
+	userRelReporterFlagsCtx              = newContextual[bool]("flags.users.flags.flags_reporter_id_fkey")
+	userRelFollowsCtx                    = newContextual[bool]("follows.users.follows.follows_user_id_fkey")
+	userRelLoginHistoriesCtx             = newContextual[bool]("login_history.users.login_history.login_history_user_id_fkey")
+	userRelModeratorModerationActionsCtx = newContextual[bool]("moderation_actions.users.moderation_actions.moderation_actions_moderator_id_fkey")
+	userRelModerationActionsCtx          = newContextual[bool]("moderation_actions.users.moderation_actions.moderation_actions_user_id_fkey")
+	userRelNotificationDigestsCtx        = newContextual[bool]("notification_digests.users.notification_digests.notification_digests_user_id_fkey")
+	userRelNotificationPreferencesCtx    = newContextual[bool]("notification_preferences.users.notification_preferences.notification_preferences_user_id_fkey")
+	userRelActorNotificationsCtx         = newContextual[bool]("notifications.users.notifications.notifications_actor_id_fkey")
+	userRelNotificationsCtx              = newContextual[bool]("notifications.users.notifications.notifications_user_id_fkey")
+	userRelAuthorPostRevisionsCtx        = newContextual[bool]("post_revisions.users.post_revisions.post_revisions_author_id_fkey")
+	userRelAuthorQuestionsCtx            = newContextual[bool]("questions.users.questions.questions_author_id_fkey")
+	userRelUnsubscribeVersionCtx         = newContextual[bool]("unsubscribe_versions.users.unsubscribe_versions.unsubscribe_versions_user_id_fkey")
+	userRelVotesCtx                      = newContextual[bool]("users.votes.votes.votes_user_id_fkey")
+
+	// Relationship Contexts for votes
+	voteWithParentsCascadingCtx = newContextual[bool]("voteWithParentsCascading")
+	voteRelUserCtx              = newContextual[bool]("users.votes.votes.votes_user_id_fkey")
 )
 
 // Contextual is a convienience wrapper around context.WithValue and context.Value
@@ -13,38 +13,801 @@ import (
 )
 
 type Factory struct {
-	baseCategoryMods        CategoryModSlice
-	baseSchemaMigrationMods SchemaMigrationModSlice
-	baseUserMods            UserModSlice
+	baseActivityMods               ActivityModSlice
+	baseActivityTargetMods         ActivityTargetModSlice
+	baseAnswerMods                 AnswerModSlice
+	baseAttachmentMods             AttachmentModSlice
+	baseBookmarkCollectionMods     BookmarkCollectionModSlice
+	baseBookmarkMods               BookmarkModSlice
+	baseCategoryMods               CategoryModSlice
+	baseCommentMods                CommentModSlice
+	baseEmailOutboxMods            EmailOutboxModSlice
+	baseFlagMods                   FlagModSlice
+	baseFollowMods                 FollowModSlice
+	baseLoginHistoryMods           LoginHistoryModSlice
+	baseModerationActionMods       ModerationActionModSlice
+	baseNotificationDigestMods     NotificationDigestModSlice
+	baseNotificationPreferenceMods NotificationPreferenceModSlice
+	baseNotificationMods           NotificationModSlice
+	basePermissionMods             PermissionModSlice
+	basePostRevisionMods           PostRevisionModSlice
+	baseQuestionCategoryMods       QuestionCategoryModSlice
+	baseQuestionMods               QuestionModSlice
+	baseRolePermissionMods         RolePermissionModSlice
+	baseSchemaMigrationMods        SchemaMigrationModSlice
+	baseUnsubscribeVersionMods     UnsubscribeVersionModSlice
+	baseUserMods                   UserModSlice
+	baseVoteMods                   VoteModSlice
 }
 
 func New() *Factory {
 	return &Factory{}
 }
 
+func (f *Factory) NewActivity(mods ...ActivityMod) *ActivityTemplate {
+	return f.NewActivityWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewActivityWithContext(ctx context.Context, mods ...ActivityMod) *ActivityTemplate {
+	o := &ActivityTemplate{f: f}
+
+	if f != nil {
+		f.baseActivityMods.Apply(ctx, o)
+	}
+
+	ActivityModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingActivity(m *models.Activity) *ActivityTemplate {
+	o := &ActivityTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.ActorID = func() null.Val[int64] { return m.ActorID }
+	o.Type = func() string { return m.Type }
+	o.PostID = func() int64 { return m.PostID }
+	o.QuestionID = func() int64 { return m.QuestionID }
+	o.Excerpt = func() string { return m.Excerpt }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.AnswerID = func() null.Val[int64] { return m.AnswerID }
+	o.CommentID = func() null.Val[int64] { return m.CommentID }
+
+	ctx := context.Background()
+	if m.R.ActorUser != nil {
+		ActivityMods.WithExistingActorUser(m.R.ActorUser).Apply(ctx, o)
+	}
+	if m.R.Question != nil {
+		ActivityMods.WithExistingQuestion(m.R.Question).Apply(ctx, o)
+	}
+	if len(m.R.ActivityTargets) > 0 {
+		ActivityMods.AddExistingActivityTargets(m.R.ActivityTargets...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewActivityTarget(mods ...ActivityTargetMod) *ActivityTargetTemplate {
+	return f.NewActivityTargetWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewActivityTargetWithContext(ctx context.Context, mods ...ActivityTargetMod) *ActivityTargetTemplate {
+	o := &ActivityTargetTemplate{f: f}
+
+	if f != nil {
+		f.baseActivityTargetMods.Apply(ctx, o)
+	}
+
+	ActivityTargetModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingActivityTarget(m *models.ActivityTarget) *ActivityTargetTemplate {
+	o := &ActivityTargetTemplate{f: f, alreadyPersisted: true}
+
+	o.ActivityID = func() int64 { return m.ActivityID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+
+	ctx := context.Background()
+	if m.R.Activity != nil {
+		ActivityTargetMods.WithExistingActivity(m.R.Activity).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewAnswer(mods ...AnswerMod) *AnswerTemplate {
+	return f.NewAnswerWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAnswerWithContext(ctx context.Context, mods ...AnswerMod) *AnswerTemplate {
+	o := &AnswerTemplate{f: f}
+
+	if f != nil {
+		f.baseAnswerMods.Apply(ctx, o)
+	}
+
+	AnswerModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAnswer(m *models.Answer) *AnswerTemplate {
+	o := &AnswerTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.QuestionID = func() int64 { return m.QuestionID }
+	o.AuthorID = func() null.Val[int64] { return m.AuthorID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.AuthorUser != nil {
+		AnswerMods.WithExistingAuthorUser(m.R.AuthorUser).Apply(ctx, o)
+	}
+	if m.R.Question != nil {
+		AnswerMods.WithExistingQuestion(m.R.Question).Apply(ctx, o)
+	}
+	if len(m.R.AcceptedAnswerQuestions) > 0 {
+		AnswerMods.AddExistingAcceptedAnswerQuestions(m.R.AcceptedAnswerQuestions...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewAttachment(mods ...AttachmentMod) *AttachmentTemplate {
+	return f.NewAttachmentWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAttachmentWithContext(ctx context.Context, mods ...AttachmentMod) *AttachmentTemplate {
+	o := &AttachmentTemplate{f: f}
+
+	if f != nil {
+		f.baseAttachmentMods.Apply(ctx, o)
+	}
+
+	AttachmentModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAttachment(m *models.Attachment) *AttachmentTemplate {
+	o := &AttachmentTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() null.Val[int64] { return m.UserID }
+	o.StorageKey = func() string { return m.StorageKey }
+	o.URL = func() string { return m.URL }
+	o.ContentType = func() string { return m.ContentType }
+	o.SizeBytes = func() int64 { return m.SizeBytes }
+	o.Width = func() int32 { return m.Width }
+	o.Height = func() int32 { return m.Height }
+	o.TargetType = func() null.Val[string] { return m.TargetType }
+	o.TargetID = func() null.Val[int64] { return m.TargetID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.AttachedAt = func() null.Val[time.Time] { return m.AttachedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		AttachmentMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewBookmarkCollection(mods ...BookmarkCollectionMod) *BookmarkCollectionTemplate {
+	return f.NewBookmarkCollectionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewBookmarkCollectionWithContext(ctx context.Context, mods ...BookmarkCollectionMod) *BookmarkCollectionTemplate {
+	o := &BookmarkCollectionTemplate{f: f}
+
+	if f != nil {
+		f.baseBookmarkCollectionMods.Apply(ctx, o)
+	}
+
+	BookmarkCollectionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingBookmarkCollection(m *models.BookmarkCollection) *BookmarkCollectionTemplate {
+	o := &BookmarkCollectionTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.IsPublic = func() bool { return m.IsPublic }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ShareToken = func() null.Val[string] { return m.ShareToken }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		BookmarkCollectionMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if len(m.R.CollectionBookmarks) > 0 {
+		BookmarkCollectionMods.AddExistingCollectionBookmarks(m.R.CollectionBookmarks...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewBookmark(mods ...BookmarkMod) *BookmarkTemplate {
+	return f.NewBookmarkWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewBookmarkWithContext(ctx context.Context, mods ...BookmarkMod) *BookmarkTemplate {
+	o := &BookmarkTemplate{f: f}
+
+	if f != nil {
+		f.baseBookmarkMods.Apply(ctx, o)
+	}
+
+	BookmarkModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingBookmark(m *models.Bookmark) *BookmarkTemplate {
+	o := &BookmarkTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.CollectionID = func() null.Val[int64] { return m.CollectionID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+	o.Position = func() int32 { return m.Position }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.QuestionID = func() null.Val[int64] { return m.QuestionID }
+	o.AnswerID = func() null.Val[int64] { return m.AnswerID }
+
+	ctx := context.Background()
+	if m.R.CollectionBookmarkCollection != nil {
+		BookmarkMods.WithExistingCollectionBookmarkCollection(m.R.CollectionBookmarkCollection).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		BookmarkMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewCategory(mods ...CategoryMod) *CategoryTemplate {
 	return f.NewCategoryWithContext(context.Background(), mods...)
 }
 
-func (f *Factory) NewCategoryWithContext(ctx context.Context, mods ...CategoryMod) *CategoryTemplate {
-	o := &CategoryTemplate{f: f}
+func (f *Factory) NewCategoryWithContext(ctx context.Context, mods ...CategoryMod) *CategoryTemplate {
+	o := &CategoryTemplate{f: f}
+
+	if f != nil {
+		f.baseCategoryMods.Apply(ctx, o)
+	}
+
+	CategoryModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingCategory(m *models.Category) *CategoryTemplate {
+	o := &CategoryTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int32 { return m.ID }
+	o.Title = func() string { return m.Title }
+	o.Slug = func() string { return m.Slug }
+	o.Description = func() null.Val[string] { return m.Description }
+
+	ctx := context.Background()
+	if len(m.R.Questions) > 0 {
+		CategoryMods.AddExistingQuestions(m.R.Questions...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewComment(mods ...CommentMod) *CommentTemplate {
+	return f.NewCommentWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewCommentWithContext(ctx context.Context, mods ...CommentMod) *CommentTemplate {
+	o := &CommentTemplate{f: f}
+
+	if f != nil {
+		f.baseCommentMods.Apply(ctx, o)
+	}
+
+	CommentModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingComment(m *models.Comment) *CommentTemplate {
+	o := &CommentTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+	o.AuthorID = func() null.Val[int64] { return m.AuthorID }
+	o.Body = func() string { return m.Body }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.QuestionID = func() null.Val[int64] { return m.QuestionID }
+	o.AnswerID = func() null.Val[int64] { return m.AnswerID }
+
+	ctx := context.Background()
+	if m.R.AuthorUser != nil {
+		CommentMods.WithExistingAuthorUser(m.R.AuthorUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewEmailOutbox(mods ...EmailOutboxMod) *EmailOutboxTemplate {
+	return f.NewEmailOutboxWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewEmailOutboxWithContext(ctx context.Context, mods ...EmailOutboxMod) *EmailOutboxTemplate {
+	o := &EmailOutboxTemplate{f: f}
+
+	if f != nil {
+		f.baseEmailOutboxMods.Apply(ctx, o)
+	}
+
+	EmailOutboxModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingEmailOutbox(m *models.EmailOutbox) *EmailOutboxTemplate {
+	o := &EmailOutboxTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.Recipient = func() string { return m.Recipient }
+	o.Subject = func() string { return m.Subject }
+	o.HTMLBody = func() string { return m.HTMLBody }
+	o.TextBody = func() string { return m.TextBody }
+	o.Status = func() string { return m.Status }
+	o.Attempts = func() int32 { return m.Attempts }
+	o.LastError = func() null.Val[string] { return m.LastError }
+	o.NextAttemptAt = func() time.Time { return m.NextAttemptAt }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.SentAt = func() null.Val[time.Time] { return m.SentAt }
+	o.UnsubscribeURL = func() null.Val[string] { return m.UnsubscribeURL }
+
+	return o
+}
+
+func (f *Factory) NewFlag(mods ...FlagMod) *FlagTemplate {
+	return f.NewFlagWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewFlagWithContext(ctx context.Context, mods ...FlagMod) *FlagTemplate {
+	o := &FlagTemplate{f: f}
+
+	if f != nil {
+		f.baseFlagMods.Apply(ctx, o)
+	}
+
+	FlagModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingFlag(m *models.Flag) *FlagTemplate {
+	o := &FlagTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+	o.ReporterID = func() int64 { return m.ReporterID }
+	o.Reason = func() string { return m.Reason }
+	o.Details = func() string { return m.Details }
+	o.ActionID = func() null.Val[int64] { return m.ActionID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ResolvedAt = func() null.Val[time.Time] { return m.ResolvedAt }
+	o.QuestionID = func() null.Val[int64] { return m.QuestionID }
+	o.AnswerID = func() null.Val[int64] { return m.AnswerID }
+	o.CommentID = func() null.Val[int64] { return m.CommentID }
+
+	ctx := context.Background()
+	if m.R.ActionModerationAction != nil {
+		FlagMods.WithExistingActionModerationAction(m.R.ActionModerationAction).Apply(ctx, o)
+	}
+	if m.R.ReporterUser != nil {
+		FlagMods.WithExistingReporterUser(m.R.ReporterUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewFollow(mods ...FollowMod) *FollowTemplate {
+	return f.NewFollowWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewFollowWithContext(ctx context.Context, mods ...FollowMod) *FollowTemplate {
+	o := &FollowTemplate{f: f}
+
+	if f != nil {
+		f.baseFollowMods.Apply(ctx, o)
+	}
+
+	FollowModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingFollow(m *models.Follow) *FollowTemplate {
+	o := &FollowTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() int64 { return m.UserID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.QuestionID = func() null.Val[int64] { return m.QuestionID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		FollowMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewLoginHistory(mods ...LoginHistoryMod) *LoginHistoryTemplate {
+	return f.NewLoginHistoryWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewLoginHistoryWithContext(ctx context.Context, mods ...LoginHistoryMod) *LoginHistoryTemplate {
+	o := &LoginHistoryTemplate{f: f}
+
+	if f != nil {
+		f.baseLoginHistoryMods.Apply(ctx, o)
+	}
+
+	LoginHistoryModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingLoginHistory(m *models.LoginHistory) *LoginHistoryTemplate {
+	o := &LoginHistoryTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Login = func() string { return m.Login }
+	o.ChangedAt = func() time.Time { return m.ChangedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		LoginHistoryMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewModerationAction(mods ...ModerationActionMod) *ModerationActionTemplate {
+	return f.NewModerationActionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewModerationActionWithContext(ctx context.Context, mods ...ModerationActionMod) *ModerationActionTemplate {
+	o := &ModerationActionTemplate{f: f}
+
+	if f != nil {
+		f.baseModerationActionMods.Apply(ctx, o)
+	}
+
+	ModerationActionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingModerationAction(m *models.ModerationAction) *ModerationActionTemplate {
+	o := &ModerationActionTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+	o.ModeratorID = func() null.Val[int64] { return m.ModeratorID }
+	o.Action = func() string { return m.Action }
+	o.Reason = func() string { return m.Reason }
+	o.UserID = func() null.Val[int64] { return m.UserID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if len(m.R.ActionFlags) > 0 {
+		ModerationActionMods.AddExistingActionFlags(m.R.ActionFlags...).Apply(ctx, o)
+	}
+	if m.R.ModeratorUser != nil {
+		ModerationActionMods.WithExistingModeratorUser(m.R.ModeratorUser).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		ModerationActionMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewNotificationDigest(mods ...NotificationDigestMod) *NotificationDigestTemplate {
+	return f.NewNotificationDigestWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewNotificationDigestWithContext(ctx context.Context, mods ...NotificationDigestMod) *NotificationDigestTemplate {
+	o := &NotificationDigestTemplate{f: f}
+
+	if f != nil {
+		f.baseNotificationDigestMods.Apply(ctx, o)
+	}
+
+	NotificationDigestModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingNotificationDigest(m *models.NotificationDigest) *NotificationDigestTemplate {
+	o := &NotificationDigestTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() int64 { return m.UserID }
+	o.Cadence = func() string { return m.Cadence }
+	o.SentAt = func() time.Time { return m.SentAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		NotificationDigestMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewNotificationPreference(mods ...NotificationPreferenceMod) *NotificationPreferenceTemplate {
+	return f.NewNotificationPreferenceWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewNotificationPreferenceWithContext(ctx context.Context, mods ...NotificationPreferenceMod) *NotificationPreferenceTemplate {
+	o := &NotificationPreferenceTemplate{f: f}
+
+	if f != nil {
+		f.baseNotificationPreferenceMods.Apply(ctx, o)
+	}
+
+	NotificationPreferenceModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingNotificationPreference(m *models.NotificationPreference) *NotificationPreferenceTemplate {
+	o := &NotificationPreferenceTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() int64 { return m.UserID }
+	o.Type = func() string { return m.Type }
+	o.Delivery = func() string { return m.Delivery }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		NotificationPreferenceMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewNotification(mods ...NotificationMod) *NotificationTemplate {
+	return f.NewNotificationWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewNotificationWithContext(ctx context.Context, mods ...NotificationMod) *NotificationTemplate {
+	o := &NotificationTemplate{f: f}
 
 	if f != nil {
-		f.baseCategoryMods.Apply(ctx, o)
+		f.baseNotificationMods.Apply(ctx, o)
 	}
 
-	CategoryModSlice(mods).Apply(ctx, o)
+	NotificationModSlice(mods).Apply(ctx, o)
 
 	return o
 }
 
-func (f *Factory) FromExistingCategory(m *models.Category) *CategoryTemplate {
-	o := &CategoryTemplate{f: f, alreadyPersisted: true}
+func (f *Factory) FromExistingNotification(m *models.Notification) *NotificationTemplate {
+	o := &NotificationTemplate{f: f, alreadyPersisted: true}
 
-	o.ID = func() int32 { return m.ID }
-	o.Title = func() string { return m.Title }
-	o.Slug = func() string { return m.Slug }
-	o.Description = func() null.Val[string] { return m.Description }
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.ActorID = func() null.Val[int64] { return m.ActorID }
+	o.Type = func() string { return m.Type }
+	o.TargetType = func() null.Val[string] { return m.TargetType }
+	o.TargetID = func() null.Val[int64] { return m.TargetID }
+	o.Excerpt = func() string { return m.Excerpt }
+	o.Milestone = func() null.Val[int32] { return m.Milestone }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ReadAt = func() null.Val[time.Time] { return m.ReadAt }
+	o.EmailedAt = func() null.Val[time.Time] { return m.EmailedAt }
+	o.QuestionID = func() null.Val[int64] { return m.QuestionID }
+	o.AnswerID = func() null.Val[int64] { return m.AnswerID }
+	o.CommentID = func() null.Val[int64] { return m.CommentID }
+
+	ctx := context.Background()
+	if m.R.ActorUser != nil {
+		NotificationMods.WithExistingActorUser(m.R.ActorUser).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		NotificationMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewPermission(mods ...PermissionMod) *PermissionTemplate {
+	return f.NewPermissionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewPermissionWithContext(ctx context.Context, mods ...PermissionMod) *PermissionTemplate {
+	o := &PermissionTemplate{f: f}
+
+	if f != nil {
+		f.basePermissionMods.Apply(ctx, o)
+	}
+
+	PermissionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingPermission(m *models.Permission) *PermissionTemplate {
+	o := &PermissionTemplate{f: f, alreadyPersisted: true}
+
+	o.Name = func() string { return m.Name }
+	o.Description = func() string { return m.Description }
+
+	ctx := context.Background()
+	if len(m.R.RolePermissions) > 0 {
+		PermissionMods.AddExistingRolePermissions(m.R.RolePermissions...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewPostRevision(mods ...PostRevisionMod) *PostRevisionTemplate {
+	return f.NewPostRevisionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewPostRevisionWithContext(ctx context.Context, mods ...PostRevisionMod) *PostRevisionTemplate {
+	o := &PostRevisionTemplate{f: f}
+
+	if f != nil {
+		f.basePostRevisionMods.Apply(ctx, o)
+	}
+
+	PostRevisionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingPostRevision(m *models.PostRevision) *PostRevisionTemplate {
+	o := &PostRevisionTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+	o.Revision = func() int32 { return m.Revision }
+	o.AuthorID = func() null.Val[int64] { return m.AuthorID }
+	o.Title = func() null.Val[string] { return m.Title }
+	o.Body = func() string { return m.Body }
+	o.Summary = func() string { return m.Summary }
+	o.RollbackOf = func() null.Val[int32] { return m.RollbackOf }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.BodyHTML = func() string { return m.BodyHTML }
+	o.RenderVersion = func() int32 { return m.RenderVersion }
+	o.QuestionID = func() null.Val[int64] { return m.QuestionID }
+	o.AnswerID = func() null.Val[int64] { return m.AnswerID }
+
+	ctx := context.Background()
+	if m.R.AuthorUser != nil {
+		PostRevisionMods.WithExistingAuthorUser(m.R.AuthorUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewQuestionCategory(mods ...QuestionCategoryMod) *QuestionCategoryTemplate {
+	return f.NewQuestionCategoryWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewQuestionCategoryWithContext(ctx context.Context, mods ...QuestionCategoryMod) *QuestionCategoryTemplate {
+	o := &QuestionCategoryTemplate{f: f}
+
+	if f != nil {
+		f.baseQuestionCategoryMods.Apply(ctx, o)
+	}
+
+	QuestionCategoryModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingQuestionCategory(m *models.QuestionCategory) *QuestionCategoryTemplate {
+	o := &QuestionCategoryTemplate{f: f, alreadyPersisted: true}
+
+	o.QuestionID = func() int64 { return m.QuestionID }
+	o.CategoryID = func() int32 { return m.CategoryID }
+
+	ctx := context.Background()
+	if m.R.Category != nil {
+		QuestionCategoryMods.WithExistingCategory(m.R.Category).Apply(ctx, o)
+	}
+	if m.R.Question != nil {
+		QuestionCategoryMods.WithExistingQuestion(m.R.Question).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewQuestion(mods ...QuestionMod) *QuestionTemplate {
+	return f.NewQuestionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewQuestionWithContext(ctx context.Context, mods ...QuestionMod) *QuestionTemplate {
+	o := &QuestionTemplate{f: f}
+
+	if f != nil {
+		f.baseQuestionMods.Apply(ctx, o)
+	}
+
+	QuestionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingQuestion(m *models.Question) *QuestionTemplate {
+	o := &QuestionTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.AuthorID = func() null.Val[int64] { return m.AuthorID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.AcceptedAnswerID = func() null.Val[int64] { return m.AcceptedAnswerID }
+
+	ctx := context.Background()
+	if len(m.R.Activities) > 0 {
+		QuestionMods.AddExistingActivities(m.R.Activities...).Apply(ctx, o)
+	}
+	if len(m.R.Answers) > 0 {
+		QuestionMods.AddExistingAnswers(m.R.Answers...).Apply(ctx, o)
+	}
+	if len(m.R.Categories) > 0 {
+		QuestionMods.AddExistingCategories(m.R.Categories...).Apply(ctx, o)
+	}
+	if m.R.AcceptedAnswerAnswer != nil {
+		QuestionMods.WithExistingAcceptedAnswerAnswer(m.R.AcceptedAnswerAnswer).Apply(ctx, o)
+	}
+	if m.R.AuthorUser != nil {
+		QuestionMods.WithExistingAuthorUser(m.R.AuthorUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewRolePermission(mods ...RolePermissionMod) *RolePermissionTemplate {
+	return f.NewRolePermissionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewRolePermissionWithContext(ctx context.Context, mods ...RolePermissionMod) *RolePermissionTemplate {
+	o := &RolePermissionTemplate{f: f}
+
+	if f != nil {
+		f.baseRolePermissionMods.Apply(ctx, o)
+	}
+
+	RolePermissionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingRolePermission(m *models.RolePermission) *RolePermissionTemplate {
+	o := &RolePermissionTemplate{f: f, alreadyPersisted: true}
+
+	o.Role = func() enums.UserRole { return m.Role }
+	o.Permission = func() string { return m.Permission }
+
+	ctx := context.Background()
+	if m.R.Permission != nil {
+		RolePermissionMods.WithExistingPermission(m.R.Permission).Apply(ctx, o)
+	}
 
 	return o
 }
@@ -74,6 +837,36 @@ func (f *Factory) FromExistingSchemaMigration(m *models.SchemaMigration) *Schema
 	return o
 }
 
+func (f *Factory) NewUnsubscribeVersion(mods ...UnsubscribeVersionMod) *UnsubscribeVersionTemplate {
+	return f.NewUnsubscribeVersionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewUnsubscribeVersionWithContext(ctx context.Context, mods ...UnsubscribeVersionMod) *UnsubscribeVersionTemplate {
+	o := &UnsubscribeVersionTemplate{f: f}
+
+	if f != nil {
+		f.baseUnsubscribeVersionMods.Apply(ctx, o)
+	}
+
+	UnsubscribeVersionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingUnsubscribeVersion(m *models.UnsubscribeVersion) *UnsubscribeVersionTemplate {
+	o := &UnsubscribeVersionTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() int64 { return m.UserID }
+	o.Version = func() int32 { return m.Version }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		UnsubscribeVersionMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewUser(mods ...UserMod) *UserTemplate {
 	return f.NewUserWithContext(context.Background(), mods...)
 }
@@ -103,10 +896,156 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	o.CreatedAt = func() time.Time { return m.CreatedAt }
 	o.EmailVerified = func() bool { return m.EmailVerified }
 	o.GoogleID = func() null.Val[string] { return m.GoogleID }
+	o.HasPassword = func() bool { return m.HasPassword }
+	o.Avatar = func() null.Val[string] { return m.Avatar }
+	o.LoginChangedAt = func() null.Val[time.Time] { return m.LoginChangedAt }
+	o.BannedAt = func() null.Val[time.Time] { return m.BannedAt }
+
+	ctx := context.Background()
+	if len(m.R.ActorActivities) > 0 {
+		UserMods.AddExistingActorActivities(m.R.ActorActivities...).Apply(ctx, o)
+	}
+	if len(m.R.AuthorAnswers) > 0 {
+		UserMods.AddExistingAuthorAnswers(m.R.AuthorAnswers...).Apply(ctx, o)
+	}
+	if len(m.R.Attachments) > 0 {
+		UserMods.AddExistingAttachments(m.R.Attachments...).Apply(ctx, o)
+	}
+	if len(m.R.BookmarkCollections) > 0 {
+		UserMods.AddExistingBookmarkCollections(m.R.BookmarkCollections...).Apply(ctx, o)
+	}
+	if len(m.R.Bookmarks) > 0 {
+		UserMods.AddExistingBookmarks(m.R.Bookmarks...).Apply(ctx, o)
+	}
+	if len(m.R.AuthorComments) > 0 {
+		UserMods.AddExistingAuthorComments(m.R.AuthorComments...).Apply(ctx, o)
+	}
+	if len(m.R.ReporterFlags) > 0 {
+		UserMods.AddExistingReporterFlags(m.R.ReporterFlags...).Apply(ctx, o)
+	}
+	if len(m.R.Follows) > 0 {
+		UserMods.AddExistingFollows(m.R.Follows...).Apply(ctx, o)
+	}
+	if len(m.R.LoginHistories) > 0 {
+		UserMods.AddExistingLoginHistories(m.R.LoginHistories...).Apply(ctx, o)
+	}
+	if len(m.R.ModeratorModerationActions) > 0 {
+		UserMods.AddExistingModeratorModerationActions(m.R.ModeratorModerationActions...).Apply(ctx, o)
+	}
+	if len(m.R.ModerationActions) > 0 {
+		UserMods.AddExistingModerationActions(m.R.ModerationActions...).Apply(ctx, o)
+	}
+	if len(m.R.NotificationDigests) > 0 {
+		UserMods.AddExistingNotificationDigests(m.R.NotificationDigests...).Apply(ctx, o)
+	}
+	if len(m.R.NotificationPreferences) > 0 {
+		UserMods.AddExistingNotificationPreferences(m.R.NotificationPreferences...).Apply(ctx, o)
+	}
+	if len(m.R.ActorNotifications) > 0 {
+		UserMods.AddExistingActorNotifications(m.R.ActorNotifications...).Apply(ctx, o)
+	}
+	if len(m.R.Notifications) > 0 {
+		UserMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
+	if len(m.R.AuthorPostRevisions) > 0 {
+		UserMods.AddExistingAuthorPostRevisions(m.R.AuthorPostRevisions...).Apply(ctx, o)
+	}
+	if len(m.R.AuthorQuestions) > 0 {
+		UserMods.AddExistingAuthorQuestions(m.R.AuthorQuestions...).Apply(ctx, o)
+	}
+	if m.R.UnsubscribeVersion != nil {
+		UserMods.WithExistingUnsubscribeVersion(m.R.UnsubscribeVersion).Apply(ctx, o)
+	}
+	if len(m.R.Votes) > 0 {
+		UserMods.AddExistingVotes(m.R.Votes...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewVote(mods ...VoteMod) *VoteTemplate {
+	return f.NewVoteWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewVoteWithContext(ctx context.Context, mods ...VoteMod) *VoteTemplate {
+	o := &VoteTemplate{f: f}
+
+	if f != nil {
+		f.baseVoteMods.Apply(ctx, o)
+	}
+
+	VoteModSlice(mods).Apply(ctx, o)
 
 	return o
 }
 
+func (f *Factory) FromExistingVote(m *models.Vote) *VoteTemplate {
+	o := &VoteTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() int64 { return m.UserID }
+	o.TargetType = func() string { return m.TargetType }
+	o.TargetID = func() int64 { return m.TargetID }
+	o.Value = func() int16 { return m.Value }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.QuestionID = func() null.Val[int64] { return m.QuestionID }
+	o.AnswerID = func() null.Val[int64] { return m.AnswerID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		VoteMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) ClearBaseActivityMods() {
+	f.baseActivityMods = nil
+}
+
+func (f *Factory) AddBaseActivityMod(mods ...ActivityMod) {
+	f.baseActivityMods = append(f.baseActivityMods, mods...)
+}
+
+func (f *Factory) ClearBaseActivityTargetMods() {
+	f.baseActivityTargetMods = nil
+}
+
+func (f *Factory) AddBaseActivityTargetMod(mods ...ActivityTargetMod) {
+	f.baseActivityTargetMods = append(f.baseActivityTargetMods, mods...)
+}
+
+func (f *Factory) ClearBaseAnswerMods() {
+	f.baseAnswerMods = nil
+}
+
+func (f *Factory) AddBaseAnswerMod(mods ...AnswerMod) {
+	f.baseAnswerMods = append(f.baseAnswerMods, mods...)
+}
+
+func (f *Factory) ClearBaseAttachmentMods() {
+	f.baseAttachmentMods = nil
+}
+
+func (f *Factory) AddBaseAttachmentMod(mods ...AttachmentMod) {
+	f.baseAttachmentMods = append(f.baseAttachmentMods, mods...)
+}
+
+func (f *Factory) ClearBaseBookmarkCollectionMods() {
+	f.baseBookmarkCollectionMods = nil
+}
+
+func (f *Factory) AddBaseBookmarkCollectionMod(mods ...BookmarkCollectionMod) {
+	f.baseBookmarkCollectionMods = append(f.baseBookmarkCollectionMods, mods...)
+}
+
+func (f *Factory) ClearBaseBookmarkMods() {
+	f.baseBookmarkMods = nil
+}
+
+func (f *Factory) AddBaseBookmarkMod(mods ...BookmarkMod) {
+	f.baseBookmarkMods = append(f.baseBookmarkMods, mods...)
+}
+
 func (f *Factory) ClearBaseCategoryMods() {
 	f.baseCategoryMods = nil
 }
@@ -115,6 +1054,118 @@ func (f *Factory) AddBaseCategoryMod(mods ...CategoryMod) {
 	f.baseCategoryMods = append(f.baseCategoryMods, mods...)
 }
 
+func (f *Factory) ClearBaseCommentMods() {
+	f.baseCommentMods = nil
+}
+
+func (f *Factory) AddBaseCommentMod(mods ...CommentMod) {
+	f.baseCommentMods = append(f.baseCommentMods, mods...)
+}
+
+func (f *Factory) ClearBaseEmailOutboxMods() {
+	f.baseEmailOutboxMods = nil
+}
+
+func (f *Factory) AddBaseEmailOutboxMod(mods ...EmailOutboxMod) {
+	f.baseEmailOutboxMods = append(f.baseEmailOutboxMods, mods...)
+}
+
+func (f *Factory) ClearBaseFlagMods() {
+	f.baseFlagMods = nil
+}
+
+func (f *Factory) AddBaseFlagMod(mods ...FlagMod) {
+	f.baseFlagMods = append(f.baseFlagMods, mods...)
+}
+
+func (f *Factory) ClearBaseFollowMods() {
+	f.baseFollowMods = nil
+}
+
+func (f *Factory) AddBaseFollowMod(mods ...FollowMod) {
+	f.baseFollowMods = append(f.baseFollowMods, mods...)
+}
+
+func (f *Factory) ClearBaseLoginHistoryMods() {
+	f.baseLoginHistoryMods = nil
+}
+
+func (f *Factory) AddBaseLoginHistoryMod(mods ...LoginHistoryMod) {
+	f.baseLoginHistoryMods = append(f.baseLoginHistoryMods, mods...)
+}
+
+func (f *Factory) ClearBaseModerationActionMods() {
+	f.baseModerationActionMods = nil
+}
+
+func (f *Factory) AddBaseModerationActionMod(mods ...ModerationActionMod) {
+	f.baseModerationActionMods = append(f.baseModerationActionMods, mods...)
+}
+
+func (f *Factory) ClearBaseNotificationDigestMods() {
+	f.baseNotificationDigestMods = nil
+}
+
+func (f *Factory) AddBaseNotificationDigestMod(mods ...NotificationDigestMod) {
+	f.baseNotificationDigestMods = append(f.baseNotificationDigestMods, mods...)
+}
+
+func (f *Factory) ClearBaseNotificationPreferenceMods() {
+	f.baseNotificationPreferenceMods = nil
+}
+
+func (f *Factory) AddBaseNotificationPreferenceMod(mods ...NotificationPreferenceMod) {
+	f.baseNotificationPreferenceMods = append(f.baseNotificationPreferenceMods, mods...)
+}
+
+func (f *Factory) ClearBaseNotificationMods() {
+	f.baseNotificationMods = nil
+}
+
+func (f *Factory) AddBaseNotificationMod(mods ...NotificationMod) {
+	f.baseNotificationMods = append(f.baseNotificationMods, mods...)
+}
+
+func (f *Factory) ClearBasePermissionMods() {
+	f.basePermissionMods = nil
+}
+
+func (f *Factory) AddBasePermissionMod(mods ...PermissionMod) {
+	f.basePermissionMods = append(f.basePermissionMods, mods...)
+}
+
+func (f *Factory) ClearBasePostRevisionMods() {
+	f.basePostRevisionMods = nil
+}
+
+func (f *Factory) AddBasePostRevisionMod(mods ...PostRevisionMod) {
+	f.basePostRevisionMods = append(f.basePostRevisionMods, mods...)
+}
+
+func (f *Factory) ClearBaseQuestionCategoryMods() {
+	f.baseQuestionCategoryMods = nil
+}
+
+func (f *Factory) AddBaseQuestionCategoryMod(mods ...QuestionCategoryMod) {
+	f.baseQuestionCategoryMods = append(f.baseQuestionCategoryMods, mods...)
+}
+
+func (f *Factory) ClearBaseQuestionMods() {
+	f.baseQuestionMods = nil
+}
+
+func (f *Factory) AddBaseQuestionMod(mods ...QuestionMod) {
+	f.baseQuestionMods = append(f.baseQuestionMods, mods...)
+}
+
+func (f *Factory) ClearBaseRolePermissionMods() {
+	f.baseRolePermissionMods = nil
+}
+
+func (f *Factory) AddBaseRolePermissionMod(mods ...RolePermissionMod) {
+	f.baseRolePermissionMods = append(f.baseRolePermissionMods, mods...)
+}
+
 func (f *Factory) ClearBaseSchemaMigrationMods() {
 	f.baseSchemaMigrationMods = nil
 }
@@ -123,6 +1174,14 @@ func (f *Factory) AddBaseSchemaMigrationMod(mods ...SchemaMigrationMod) {
 	f.baseSchemaMigrationMods = append(f.baseSchemaMigrationMods, mods...)
 }
 
+func (f *Factory) ClearBaseUnsubscribeVersionMods() {
+	f.baseUnsubscribeVersionMods = nil
+}
+
+func (f *Factory) AddBaseUnsubscribeVersionMod(mods ...UnsubscribeVersionMod) {
+	f.baseUnsubscribeVersionMods = append(f.baseUnsubscribeVersionMods, mods...)
+}
+
 func (f *Factory) ClearBaseUserMods() {
 	f.baseUserMods = nil
 }
@@ -130,3 +1189,11 @@ func (f *Factory) ClearBaseUserMods() {
 func (f *Factory) AddBaseUserMod(mods ...UserMod) {
 	f.baseUserMods = append(f.baseUserMods, mods...)
 }
+
+func (f *Factory) ClearBaseVoteMods() {
+	f.baseVoteMods = nil
+}
+
+func (f *Factory) AddBaseVoteMod(mods ...VoteMod) {
+	f.baseVoteMods = append(f.baseVoteMods, mods...)
+}
@@ -32,6 +32,14 @@ func random_enums_UserRole(f *faker.Faker, limits ...string) enums.UserRole {
 	return all[f.IntBetween(0, len(all)-1)]
 }
 
+func random_int16(f *faker.Faker, limits ...string) int16 {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	return f.Int16()
+}
+
 func random_int32(f *faker.Faker, limits ...string) int32 {
 	if f == nil {
 		f = &defaultFaker
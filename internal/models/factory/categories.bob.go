@@ -41,11 +41,21 @@ type CategoryTemplate struct {
 	Slug        func() string
 	Description func() null.Val[string]
 
+	r categoryR
 	f *Factory
 
 	alreadyPersisted bool
 }
 
+type categoryR struct {
+	Questions []*categoryRQuestionsR
+}
+
+type categoryRQuestionsR struct {
+	number int
+	o      *QuestionTemplate
+}
+
 // Apply mods to the CategoryTemplate
 func (o *CategoryTemplate) Apply(ctx context.Context, mods ...CategoryMod) {
 	for _, mod := range mods {
@@ -55,7 +65,19 @@ func (o *CategoryTemplate) Apply(ctx context.Context, mods ...CategoryMod) {
 
 // setModelRels creates and sets the relationships on *models.Category
 // according to the relationships in the template. Nothing is inserted into the db
-func (t CategoryTemplate) setModelRels(o *models.Category) {}
+func (t CategoryTemplate) setModelRels(o *models.Category) {
+	if t.r.Questions != nil {
+		rel := models.QuestionSlice{}
+		for _, r := range t.r.Questions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Categories = append(rel.R.Categories, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Questions = rel
+	}
+}
 
 // BuildSetter returns an *models.CategorySetter
 // this does nothing with the relationship templates
@@ -148,6 +170,26 @@ func ensureCreatableCategory(m *models.CategorySetter) {
 func (o *CategoryTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Category) error {
 	var err error
 
+	isQuestionsDone, _ := categoryRelQuestionsCtx.Value(ctx)
+	if !isQuestionsDone && o.r.Questions != nil {
+		ctx = categoryRelQuestionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Questions {
+			if r.o.alreadyPersisted {
+				m.R.Questions = append(m.R.Questions, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachQuestions(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return err
 }
 
@@ -401,3 +443,51 @@ func (m categoryMods) WithParentsCascading() CategoryMod {
 		ctx = categoryWithParentsCascadingCtx.WithValue(ctx, true)
 	})
 }
+
+func (m categoryMods) WithQuestions(number int, related *QuestionTemplate) CategoryMod {
+	return CategoryModFunc(func(ctx context.Context, o *CategoryTemplate) {
+		o.r.Questions = []*categoryRQuestionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m categoryMods) WithNewQuestions(number int, mods ...QuestionMod) CategoryMod {
+	return CategoryModFunc(func(ctx context.Context, o *CategoryTemplate) {
+		related := o.f.NewQuestionWithContext(ctx, mods...)
+		m.WithQuestions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m categoryMods) AddQuestions(number int, related *QuestionTemplate) CategoryMod {
+	return CategoryModFunc(func(ctx context.Context, o *CategoryTemplate) {
+		o.r.Questions = append(o.r.Questions, &categoryRQuestionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m categoryMods) AddNewQuestions(number int, mods ...QuestionMod) CategoryMod {
+	return CategoryModFunc(func(ctx context.Context, o *CategoryTemplate) {
+		related := o.f.NewQuestionWithContext(ctx, mods...)
+		m.AddQuestions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m categoryMods) AddExistingQuestions(existingModels ...*models.Question) CategoryMod {
+	return CategoryModFunc(func(ctx context.Context, o *CategoryTemplate) {
+		for _, em := range existingModels {
+			o.r.Questions = append(o.r.Questions, &categoryRQuestionsR{
+				o: o.f.FromExistingQuestion(em),
+			})
+		}
+	})
+}
+
+func (m categoryMods) WithoutQuestions() CategoryMod {
+	return CategoryModFunc(func(ctx context.Context, o *CategoryTemplate) {
+		o.r.Questions = nil
+	})
+}
@@ -38,22 +38,125 @@ func (mods UserModSlice) Apply(ctx context.Context, n *UserTemplate) {
 // UserTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type UserTemplate struct {
-	ID            func() int64
-	Login         func() string
-	Email         func() string
-	Fullname      func() string
-	Rating        func() int32
-	Role          func() enums.UserRole
-	Password      func() string
-	CreatedAt     func() time.Time
-	EmailVerified func() bool
-	GoogleID      func() null.Val[string]
-
+	ID             func() int64
+	Login          func() string
+	Email          func() string
+	Fullname       func() string
+	Rating         func() int32
+	Role           func() enums.UserRole
+	Password       func() string
+	CreatedAt      func() time.Time
+	EmailVerified  func() bool
+	GoogleID       func() null.Val[string]
+	HasPassword    func() bool
+	Avatar         func() null.Val[string]
+	LoginChangedAt func() null.Val[time.Time]
+	BannedAt       func() null.Val[time.Time]
+
+	r userR
 	f *Factory
 
 	alreadyPersisted bool
 }
 
+type userR struct {
+	ActorActivities            []*userRActorActivitiesR
+	AuthorAnswers              []*userRAuthorAnswersR
+	Attachments                []*userRAttachmentsR
+	BookmarkCollections        []*userRBookmarkCollectionsR
+	Bookmarks                  []*userRBookmarksR
+	AuthorComments             []*userRAuthorCommentsR
+	ReporterFlags              []*userRReporterFlagsR
+	Follows                    []*userRFollowsR
+	LoginHistories             []*userRLoginHistoriesR
+	ModeratorModerationActions []*userRModeratorModerationActionsR
+	ModerationActions          []*userRModerationActionsR
+	NotificationDigests        []*userRNotificationDigestsR
+	NotificationPreferences    []*userRNotificationPreferencesR
+	ActorNotifications         []*userRActorNotificationsR
+	Notifications              []*userRNotificationsR
+	AuthorPostRevisions        []*userRAuthorPostRevisionsR
+	AuthorQuestions            []*userRAuthorQuestionsR
+	UnsubscribeVersion         *userRUnsubscribeVersionR
+	Votes                      []*userRVotesR
+}
+
+type userRActorActivitiesR struct {
+	number int
+	o      *ActivityTemplate
+}
+type userRAuthorAnswersR struct {
+	number int
+	o      *AnswerTemplate
+}
+type userRAttachmentsR struct {
+	number int
+	o      *AttachmentTemplate
+}
+type userRBookmarkCollectionsR struct {
+	number int
+	o      *BookmarkCollectionTemplate
+}
+type userRBookmarksR struct {
+	number int
+	o      *BookmarkTemplate
+}
+type userRAuthorCommentsR struct {
+	number int
+	o      *CommentTemplate
+}
+type userRReporterFlagsR struct {
+	number int
+	o      *FlagTemplate
+}
+type userRFollowsR struct {
+	number int
+	o      *FollowTemplate
+}
+type userRLoginHistoriesR struct {
+	number int
+	o      *LoginHistoryTemplate
+}
+type userRModeratorModerationActionsR struct {
+	number int
+	o      *ModerationActionTemplate
+}
+type userRModerationActionsR struct {
+	number int
+	o      *ModerationActionTemplate
+}
+type userRNotificationDigestsR struct {
+	number int
+	o      *NotificationDigestTemplate
+}
+type userRNotificationPreferencesR struct {
+	number int
+	o      *NotificationPreferenceTemplate
+}
+type userRActorNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type userRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type userRAuthorPostRevisionsR struct {
+	number int
+	o      *PostRevisionTemplate
+}
+type userRAuthorQuestionsR struct {
+	number int
+	o      *QuestionTemplate
+}
+type userRUnsubscribeVersionR struct {
+	o *UnsubscribeVersionTemplate
+}
+type userRVotesR struct {
+	number int
+	o      *VoteTemplate
+}
+
 // Apply mods to the UserTemplate
 func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
 	for _, mod := range mods {
@@ -63,7 +166,248 @@ func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
 
 // setModelRels creates and sets the relationships on *models.User
 // according to the relationships in the template. Nothing is inserted into the db
-func (t UserTemplate) setModelRels(o *models.User) {}
+func (t UserTemplate) setModelRels(o *models.User) {
+	if t.r.ActorActivities != nil {
+		rel := models.ActivitySlice{}
+		for _, r := range t.r.ActorActivities {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ActorID = null.From(o.ID) // h2
+				rel.R.ActorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ActorActivities = rel
+	}
+
+	if t.r.AuthorAnswers != nil {
+		rel := models.AnswerSlice{}
+		for _, r := range t.r.AuthorAnswers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AuthorID = null.From(o.ID) // h2
+				rel.R.AuthorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AuthorAnswers = rel
+	}
+
+	if t.r.Attachments != nil {
+		rel := models.AttachmentSlice{}
+		for _, r := range t.r.Attachments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = null.From(o.ID) // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Attachments = rel
+	}
+
+	if t.r.BookmarkCollections != nil {
+		rel := models.BookmarkCollectionSlice{}
+		for _, r := range t.r.BookmarkCollections {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.BookmarkCollections = rel
+	}
+
+	if t.r.Bookmarks != nil {
+		rel := models.BookmarkSlice{}
+		for _, r := range t.r.Bookmarks {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Bookmarks = rel
+	}
+
+	if t.r.AuthorComments != nil {
+		rel := models.CommentSlice{}
+		for _, r := range t.r.AuthorComments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AuthorID = null.From(o.ID) // h2
+				rel.R.AuthorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AuthorComments = rel
+	}
+
+	if t.r.ReporterFlags != nil {
+		rel := models.FlagSlice{}
+		for _, r := range t.r.ReporterFlags {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ReporterID = o.ID // h2
+				rel.R.ReporterUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ReporterFlags = rel
+	}
+
+	if t.r.Follows != nil {
+		rel := models.FollowSlice{}
+		for _, r := range t.r.Follows {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Follows = rel
+	}
+
+	if t.r.LoginHistories != nil {
+		rel := models.LoginHistorySlice{}
+		for _, r := range t.r.LoginHistories {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.LoginHistories = rel
+	}
+
+	if t.r.ModeratorModerationActions != nil {
+		rel := models.ModerationActionSlice{}
+		for _, r := range t.r.ModeratorModerationActions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ModeratorID = null.From(o.ID) // h2
+				rel.R.ModeratorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ModeratorModerationActions = rel
+	}
+
+	if t.r.ModerationActions != nil {
+		rel := models.ModerationActionSlice{}
+		for _, r := range t.r.ModerationActions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = null.From(o.ID) // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ModerationActions = rel
+	}
+
+	if t.r.NotificationDigests != nil {
+		rel := models.NotificationDigestSlice{}
+		for _, r := range t.r.NotificationDigests {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.NotificationDigests = rel
+	}
+
+	if t.r.NotificationPreferences != nil {
+		rel := models.NotificationPreferenceSlice{}
+		for _, r := range t.r.NotificationPreferences {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.NotificationPreferences = rel
+	}
+
+	if t.r.ActorNotifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.ActorNotifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ActorID = null.From(o.ID) // h2
+				rel.R.ActorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ActorNotifications = rel
+	}
+
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+
+	if t.r.AuthorPostRevisions != nil {
+		rel := models.PostRevisionSlice{}
+		for _, r := range t.r.AuthorPostRevisions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AuthorID = null.From(o.ID) // h2
+				rel.R.AuthorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AuthorPostRevisions = rel
+	}
+
+	if t.r.AuthorQuestions != nil {
+		rel := models.QuestionSlice{}
+		for _, r := range t.r.AuthorQuestions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AuthorID = null.From(o.ID) // h2
+				rel.R.AuthorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AuthorQuestions = rel
+	}
+
+	if t.r.UnsubscribeVersion != nil {
+		rel := t.r.UnsubscribeVersion.o.Build()
+		rel.R.User = o
+		rel.UserID = o.ID // h2
+		o.R.UnsubscribeVersion = rel
+	}
+
+	if t.r.Votes != nil {
+		rel := models.VoteSlice{}
+		for _, r := range t.r.Votes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Votes = rel
+	}
+}
 
 // BuildSetter returns an *models.UserSetter
 // this does nothing with the relationship templates
@@ -110,6 +454,22 @@ func (o UserTemplate) BuildSetter() *models.UserSetter {
 		val := o.GoogleID()
 		m.GoogleID = omitnull.FromNull(val)
 	}
+	if o.HasPassword != nil {
+		val := o.HasPassword()
+		m.HasPassword = omit.From(val)
+	}
+	if o.Avatar != nil {
+		val := o.Avatar()
+		m.Avatar = omitnull.FromNull(val)
+	}
+	if o.LoginChangedAt != nil {
+		val := o.LoginChangedAt()
+		m.LoginChangedAt = omitnull.FromNull(val)
+	}
+	if o.BannedAt != nil {
+		val := o.BannedAt()
+		m.BannedAt = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -162,6 +522,18 @@ func (o UserTemplate) Build() *models.User {
 	if o.GoogleID != nil {
 		m.GoogleID = o.GoogleID()
 	}
+	if o.HasPassword != nil {
+		m.HasPassword = o.HasPassword()
+	}
+	if o.Avatar != nil {
+		m.Avatar = o.Avatar()
+	}
+	if o.LoginChangedAt != nil {
+		m.LoginChangedAt = o.LoginChangedAt()
+	}
+	if o.BannedAt != nil {
+		m.BannedAt = o.BannedAt()
+	}
 
 	o.setModelRels(m)
 
@@ -206,6 +578,385 @@ func ensureCreatableUser(m *models.UserSetter) {
 func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.User) error {
 	var err error
 
+	isActorActivitiesDone, _ := userRelActorActivitiesCtx.Value(ctx)
+	if !isActorActivitiesDone && o.r.ActorActivities != nil {
+		ctx = userRelActorActivitiesCtx.WithValue(ctx, true)
+		for _, r := range o.r.ActorActivities {
+			if r.o.alreadyPersisted {
+				m.R.ActorActivities = append(m.R.ActorActivities, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachActorActivities(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAuthorAnswersDone, _ := userRelAuthorAnswersCtx.Value(ctx)
+	if !isAuthorAnswersDone && o.r.AuthorAnswers != nil {
+		ctx = userRelAuthorAnswersCtx.WithValue(ctx, true)
+		for _, r := range o.r.AuthorAnswers {
+			if r.o.alreadyPersisted {
+				m.R.AuthorAnswers = append(m.R.AuthorAnswers, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAuthorAnswers(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAttachmentsDone, _ := userRelAttachmentsCtx.Value(ctx)
+	if !isAttachmentsDone && o.r.Attachments != nil {
+		ctx = userRelAttachmentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Attachments {
+			if r.o.alreadyPersisted {
+				m.R.Attachments = append(m.R.Attachments, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAttachments(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isBookmarkCollectionsDone, _ := userRelBookmarkCollectionsCtx.Value(ctx)
+	if !isBookmarkCollectionsDone && o.r.BookmarkCollections != nil {
+		ctx = userRelBookmarkCollectionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.BookmarkCollections {
+			if r.o.alreadyPersisted {
+				m.R.BookmarkCollections = append(m.R.BookmarkCollections, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachBookmarkCollections(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isBookmarksDone, _ := userRelBookmarksCtx.Value(ctx)
+	if !isBookmarksDone && o.r.Bookmarks != nil {
+		ctx = userRelBookmarksCtx.WithValue(ctx, true)
+		for _, r := range o.r.Bookmarks {
+			if r.o.alreadyPersisted {
+				m.R.Bookmarks = append(m.R.Bookmarks, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachBookmarks(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAuthorCommentsDone, _ := userRelAuthorCommentsCtx.Value(ctx)
+	if !isAuthorCommentsDone && o.r.AuthorComments != nil {
+		ctx = userRelAuthorCommentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.AuthorComments {
+			if r.o.alreadyPersisted {
+				m.R.AuthorComments = append(m.R.AuthorComments, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAuthorComments(ctx, exec, rel5...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isReporterFlagsDone, _ := userRelReporterFlagsCtx.Value(ctx)
+	if !isReporterFlagsDone && o.r.ReporterFlags != nil {
+		ctx = userRelReporterFlagsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ReporterFlags {
+			if r.o.alreadyPersisted {
+				m.R.ReporterFlags = append(m.R.ReporterFlags, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachReporterFlags(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isFollowsDone, _ := userRelFollowsCtx.Value(ctx)
+	if !isFollowsDone && o.r.Follows != nil {
+		ctx = userRelFollowsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Follows {
+			if r.o.alreadyPersisted {
+				m.R.Follows = append(m.R.Follows, r.o.Build())
+			} else {
+				rel7, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachFollows(ctx, exec, rel7...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isLoginHistoriesDone, _ := userRelLoginHistoriesCtx.Value(ctx)
+	if !isLoginHistoriesDone && o.r.LoginHistories != nil {
+		ctx = userRelLoginHistoriesCtx.WithValue(ctx, true)
+		for _, r := range o.r.LoginHistories {
+			if r.o.alreadyPersisted {
+				m.R.LoginHistories = append(m.R.LoginHistories, r.o.Build())
+			} else {
+				rel8, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachLoginHistories(ctx, exec, rel8...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isModeratorModerationActionsDone, _ := userRelModeratorModerationActionsCtx.Value(ctx)
+	if !isModeratorModerationActionsDone && o.r.ModeratorModerationActions != nil {
+		ctx = userRelModeratorModerationActionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ModeratorModerationActions {
+			if r.o.alreadyPersisted {
+				m.R.ModeratorModerationActions = append(m.R.ModeratorModerationActions, r.o.Build())
+			} else {
+				rel9, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachModeratorModerationActions(ctx, exec, rel9...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isModerationActionsDone, _ := userRelModerationActionsCtx.Value(ctx)
+	if !isModerationActionsDone && o.r.ModerationActions != nil {
+		ctx = userRelModerationActionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ModerationActions {
+			if r.o.alreadyPersisted {
+				m.R.ModerationActions = append(m.R.ModerationActions, r.o.Build())
+			} else {
+				rel10, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachModerationActions(ctx, exec, rel10...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationDigestsDone, _ := userRelNotificationDigestsCtx.Value(ctx)
+	if !isNotificationDigestsDone && o.r.NotificationDigests != nil {
+		ctx = userRelNotificationDigestsCtx.WithValue(ctx, true)
+		for _, r := range o.r.NotificationDigests {
+			if r.o.alreadyPersisted {
+				m.R.NotificationDigests = append(m.R.NotificationDigests, r.o.Build())
+			} else {
+				rel11, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotificationDigests(ctx, exec, rel11...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationPreferencesDone, _ := userRelNotificationPreferencesCtx.Value(ctx)
+	if !isNotificationPreferencesDone && o.r.NotificationPreferences != nil {
+		ctx = userRelNotificationPreferencesCtx.WithValue(ctx, true)
+		for _, r := range o.r.NotificationPreferences {
+			if r.o.alreadyPersisted {
+				m.R.NotificationPreferences = append(m.R.NotificationPreferences, r.o.Build())
+			} else {
+				rel12, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotificationPreferences(ctx, exec, rel12...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isActorNotificationsDone, _ := userRelActorNotificationsCtx.Value(ctx)
+	if !isActorNotificationsDone && o.r.ActorNotifications != nil {
+		ctx = userRelActorNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ActorNotifications {
+			if r.o.alreadyPersisted {
+				m.R.ActorNotifications = append(m.R.ActorNotifications, r.o.Build())
+			} else {
+				rel13, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachActorNotifications(ctx, exec, rel13...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationsDone, _ := userRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = userRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
+			if r.o.alreadyPersisted {
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
+			} else {
+				rel14, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotifications(ctx, exec, rel14...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAuthorPostRevisionsDone, _ := userRelAuthorPostRevisionsCtx.Value(ctx)
+	if !isAuthorPostRevisionsDone && o.r.AuthorPostRevisions != nil {
+		ctx = userRelAuthorPostRevisionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.AuthorPostRevisions {
+			if r.o.alreadyPersisted {
+				m.R.AuthorPostRevisions = append(m.R.AuthorPostRevisions, r.o.Build())
+			} else {
+				rel15, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAuthorPostRevisions(ctx, exec, rel15...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAuthorQuestionsDone, _ := userRelAuthorQuestionsCtx.Value(ctx)
+	if !isAuthorQuestionsDone && o.r.AuthorQuestions != nil {
+		ctx = userRelAuthorQuestionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.AuthorQuestions {
+			if r.o.alreadyPersisted {
+				m.R.AuthorQuestions = append(m.R.AuthorQuestions, r.o.Build())
+			} else {
+				rel16, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAuthorQuestions(ctx, exec, rel16...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isUnsubscribeVersionDone, _ := userRelUnsubscribeVersionCtx.Value(ctx)
+	if !isUnsubscribeVersionDone && o.r.UnsubscribeVersion != nil {
+		ctx = userRelUnsubscribeVersionCtx.WithValue(ctx, true)
+		if o.r.UnsubscribeVersion.o.alreadyPersisted {
+			m.R.UnsubscribeVersion = o.r.UnsubscribeVersion.o.Build()
+		} else {
+			var rel17 *models.UnsubscribeVersion
+			rel17, err = o.r.UnsubscribeVersion.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachUnsubscribeVersion(ctx, exec, rel17)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isVotesDone, _ := userRelVotesCtx.Value(ctx)
+	if !isVotesDone && o.r.Votes != nil {
+		ctx = userRelVotesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Votes {
+			if r.o.alreadyPersisted {
+				m.R.Votes = append(m.R.Votes, r.o.Build())
+			} else {
+				rel18, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachVotes(ctx, exec, rel18...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return err
 }
 
@@ -308,6 +1059,10 @@ func (m userMods) RandomizeAllColumns(f *faker.Faker) UserMod {
 		UserMods.RandomCreatedAt(f),
 		UserMods.RandomEmailVerified(f),
 		UserMods.RandomGoogleID(f),
+		UserMods.RandomHasPassword(f),
+		UserMods.RandomAvatar(f),
+		UserMods.RandomLoginChangedAt(f),
+		UserMods.RandomBannedAt(f),
 	}
 }
 
@@ -643,11 +1398,1100 @@ func (m userMods) RandomGoogleIDNotNull(f *faker.Faker) UserMod {
 	})
 }
 
-func (m userMods) WithParentsCascading() UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
-			return
+// Set the model columns to this value
+func (m userMods) HasPassword(val bool) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.HasPassword = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) HasPasswordFunc(f func() bool) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.HasPassword = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetHasPassword() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.HasPassword = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomHasPassword(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.HasPassword = func() bool {
+			return random_bool(f)
 		}
-		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) Avatar(val null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Avatar = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) AvatarFunc(f func() null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Avatar = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetAvatar() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Avatar = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomAvatar(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Avatar = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomAvatarNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Avatar = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) LoginChangedAt(val null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.LoginChangedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) LoginChangedAtFunc(f func() null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.LoginChangedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetLoginChangedAt() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.LoginChangedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomLoginChangedAt(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.LoginChangedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomLoginChangedAtNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.LoginChangedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) BannedAt(val null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.BannedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) BannedAtFunc(f func() null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.BannedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetBannedAt() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.BannedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomBannedAt(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.BannedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomBannedAtNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.BannedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m userMods) WithParentsCascading() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUnsubscribeVersionWithContext(ctx, UnsubscribeVersionMods.WithParentsCascading())
+			m.WithUnsubscribeVersion(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m userMods) WithUnsubscribeVersion(rel *UnsubscribeVersionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UnsubscribeVersion = &userRUnsubscribeVersionR{
+			o: rel,
+		}
+	})
+}
+
+func (m userMods) WithNewUnsubscribeVersion(mods ...UnsubscribeVersionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewUnsubscribeVersionWithContext(ctx, mods...)
+
+		m.WithUnsubscribeVersion(related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) WithExistingUnsubscribeVersion(em *models.UnsubscribeVersion) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UnsubscribeVersion = &userRUnsubscribeVersionR{
+			o: o.f.FromExistingUnsubscribeVersion(em),
+		}
+	})
+}
+
+func (m userMods) WithoutUnsubscribeVersion() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UnsubscribeVersion = nil
+	})
+}
+
+func (m userMods) WithActorActivities(number int, related *ActivityTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorActivities = []*userRActorActivitiesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewActorActivities(number int, mods ...ActivityMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewActivityWithContext(ctx, mods...)
+		m.WithActorActivities(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddActorActivities(number int, related *ActivityTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorActivities = append(o.r.ActorActivities, &userRActorActivitiesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewActorActivities(number int, mods ...ActivityMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewActivityWithContext(ctx, mods...)
+		m.AddActorActivities(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingActorActivities(existingModels ...*models.Activity) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ActorActivities = append(o.r.ActorActivities, &userRActorActivitiesR{
+				o: o.f.FromExistingActivity(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutActorActivities() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorActivities = nil
+	})
+}
+
+func (m userMods) WithAuthorAnswers(number int, related *AnswerTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorAnswers = []*userRAuthorAnswersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAuthorAnswers(number int, mods ...AnswerMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAnswerWithContext(ctx, mods...)
+		m.WithAuthorAnswers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAuthorAnswers(number int, related *AnswerTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorAnswers = append(o.r.AuthorAnswers, &userRAuthorAnswersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAuthorAnswers(number int, mods ...AnswerMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAnswerWithContext(ctx, mods...)
+		m.AddAuthorAnswers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAuthorAnswers(existingModels ...*models.Answer) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AuthorAnswers = append(o.r.AuthorAnswers, &userRAuthorAnswersR{
+				o: o.f.FromExistingAnswer(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAuthorAnswers() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorAnswers = nil
+	})
+}
+
+func (m userMods) WithAttachments(number int, related *AttachmentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Attachments = []*userRAttachmentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAttachments(number int, mods ...AttachmentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAttachmentWithContext(ctx, mods...)
+		m.WithAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAttachments(number int, related *AttachmentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Attachments = append(o.r.Attachments, &userRAttachmentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAttachments(number int, mods ...AttachmentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAttachmentWithContext(ctx, mods...)
+		m.AddAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAttachments(existingModels ...*models.Attachment) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Attachments = append(o.r.Attachments, &userRAttachmentsR{
+				o: o.f.FromExistingAttachment(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAttachments() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Attachments = nil
+	})
+}
+
+func (m userMods) WithBookmarkCollections(number int, related *BookmarkCollectionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BookmarkCollections = []*userRBookmarkCollectionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewBookmarkCollections(number int, mods ...BookmarkCollectionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBookmarkCollectionWithContext(ctx, mods...)
+		m.WithBookmarkCollections(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddBookmarkCollections(number int, related *BookmarkCollectionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BookmarkCollections = append(o.r.BookmarkCollections, &userRBookmarkCollectionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewBookmarkCollections(number int, mods ...BookmarkCollectionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBookmarkCollectionWithContext(ctx, mods...)
+		m.AddBookmarkCollections(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingBookmarkCollections(existingModels ...*models.BookmarkCollection) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.BookmarkCollections = append(o.r.BookmarkCollections, &userRBookmarkCollectionsR{
+				o: o.f.FromExistingBookmarkCollection(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutBookmarkCollections() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BookmarkCollections = nil
+	})
+}
+
+func (m userMods) WithBookmarks(number int, related *BookmarkTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Bookmarks = []*userRBookmarksR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewBookmarks(number int, mods ...BookmarkMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBookmarkWithContext(ctx, mods...)
+		m.WithBookmarks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddBookmarks(number int, related *BookmarkTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Bookmarks = append(o.r.Bookmarks, &userRBookmarksR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewBookmarks(number int, mods ...BookmarkMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBookmarkWithContext(ctx, mods...)
+		m.AddBookmarks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingBookmarks(existingModels ...*models.Bookmark) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Bookmarks = append(o.r.Bookmarks, &userRBookmarksR{
+				o: o.f.FromExistingBookmark(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutBookmarks() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Bookmarks = nil
+	})
+}
+
+func (m userMods) WithAuthorComments(number int, related *CommentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorComments = []*userRAuthorCommentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAuthorComments(number int, mods ...CommentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.WithAuthorComments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAuthorComments(number int, related *CommentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorComments = append(o.r.AuthorComments, &userRAuthorCommentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAuthorComments(number int, mods ...CommentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.AddAuthorComments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAuthorComments(existingModels ...*models.Comment) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AuthorComments = append(o.r.AuthorComments, &userRAuthorCommentsR{
+				o: o.f.FromExistingComment(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAuthorComments() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorComments = nil
+	})
+}
+
+func (m userMods) WithReporterFlags(number int, related *FlagTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ReporterFlags = []*userRReporterFlagsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewReporterFlags(number int, mods ...FlagMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFlagWithContext(ctx, mods...)
+		m.WithReporterFlags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddReporterFlags(number int, related *FlagTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ReporterFlags = append(o.r.ReporterFlags, &userRReporterFlagsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewReporterFlags(number int, mods ...FlagMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFlagWithContext(ctx, mods...)
+		m.AddReporterFlags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingReporterFlags(existingModels ...*models.Flag) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ReporterFlags = append(o.r.ReporterFlags, &userRReporterFlagsR{
+				o: o.f.FromExistingFlag(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutReporterFlags() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ReporterFlags = nil
+	})
+}
+
+func (m userMods) WithFollows(number int, related *FollowTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Follows = []*userRFollowsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewFollows(number int, mods ...FollowMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFollowWithContext(ctx, mods...)
+		m.WithFollows(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddFollows(number int, related *FollowTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Follows = append(o.r.Follows, &userRFollowsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewFollows(number int, mods ...FollowMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFollowWithContext(ctx, mods...)
+		m.AddFollows(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingFollows(existingModels ...*models.Follow) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Follows = append(o.r.Follows, &userRFollowsR{
+				o: o.f.FromExistingFollow(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutFollows() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Follows = nil
+	})
+}
+
+func (m userMods) WithLoginHistories(number int, related *LoginHistoryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.LoginHistories = []*userRLoginHistoriesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewLoginHistories(number int, mods ...LoginHistoryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewLoginHistoryWithContext(ctx, mods...)
+		m.WithLoginHistories(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddLoginHistories(number int, related *LoginHistoryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.LoginHistories = append(o.r.LoginHistories, &userRLoginHistoriesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewLoginHistories(number int, mods ...LoginHistoryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewLoginHistoryWithContext(ctx, mods...)
+		m.AddLoginHistories(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingLoginHistories(existingModels ...*models.LoginHistory) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.LoginHistories = append(o.r.LoginHistories, &userRLoginHistoriesR{
+				o: o.f.FromExistingLoginHistory(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutLoginHistories() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.LoginHistories = nil
+	})
+}
+
+func (m userMods) WithModeratorModerationActions(number int, related *ModerationActionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ModeratorModerationActions = []*userRModeratorModerationActionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewModeratorModerationActions(number int, mods ...ModerationActionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewModerationActionWithContext(ctx, mods...)
+		m.WithModeratorModerationActions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddModeratorModerationActions(number int, related *ModerationActionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ModeratorModerationActions = append(o.r.ModeratorModerationActions, &userRModeratorModerationActionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewModeratorModerationActions(number int, mods ...ModerationActionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewModerationActionWithContext(ctx, mods...)
+		m.AddModeratorModerationActions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingModeratorModerationActions(existingModels ...*models.ModerationAction) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ModeratorModerationActions = append(o.r.ModeratorModerationActions, &userRModeratorModerationActionsR{
+				o: o.f.FromExistingModerationAction(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutModeratorModerationActions() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ModeratorModerationActions = nil
+	})
+}
+
+func (m userMods) WithModerationActions(number int, related *ModerationActionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ModerationActions = []*userRModerationActionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewModerationActions(number int, mods ...ModerationActionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewModerationActionWithContext(ctx, mods...)
+		m.WithModerationActions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddModerationActions(number int, related *ModerationActionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ModerationActions = append(o.r.ModerationActions, &userRModerationActionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewModerationActions(number int, mods ...ModerationActionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewModerationActionWithContext(ctx, mods...)
+		m.AddModerationActions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingModerationActions(existingModels ...*models.ModerationAction) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ModerationActions = append(o.r.ModerationActions, &userRModerationActionsR{
+				o: o.f.FromExistingModerationAction(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutModerationActions() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ModerationActions = nil
+	})
+}
+
+func (m userMods) WithNotificationDigests(number int, related *NotificationDigestTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.NotificationDigests = []*userRNotificationDigestsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewNotificationDigests(number int, mods ...NotificationDigestMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationDigestWithContext(ctx, mods...)
+		m.WithNotificationDigests(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddNotificationDigests(number int, related *NotificationDigestTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.NotificationDigests = append(o.r.NotificationDigests, &userRNotificationDigestsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewNotificationDigests(number int, mods ...NotificationDigestMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationDigestWithContext(ctx, mods...)
+		m.AddNotificationDigests(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingNotificationDigests(existingModels ...*models.NotificationDigest) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.NotificationDigests = append(o.r.NotificationDigests, &userRNotificationDigestsR{
+				o: o.f.FromExistingNotificationDigest(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutNotificationDigests() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.NotificationDigests = nil
+	})
+}
+
+func (m userMods) WithNotificationPreferences(number int, related *NotificationPreferenceTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.NotificationPreferences = []*userRNotificationPreferencesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewNotificationPreferences(number int, mods ...NotificationPreferenceMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationPreferenceWithContext(ctx, mods...)
+		m.WithNotificationPreferences(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddNotificationPreferences(number int, related *NotificationPreferenceTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.NotificationPreferences = append(o.r.NotificationPreferences, &userRNotificationPreferencesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewNotificationPreferences(number int, mods ...NotificationPreferenceMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationPreferenceWithContext(ctx, mods...)
+		m.AddNotificationPreferences(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingNotificationPreferences(existingModels ...*models.NotificationPreference) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.NotificationPreferences = append(o.r.NotificationPreferences, &userRNotificationPreferencesR{
+				o: o.f.FromExistingNotificationPreference(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutNotificationPreferences() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.NotificationPreferences = nil
+	})
+}
+
+func (m userMods) WithActorNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = []*userRActorNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewActorNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithActorNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddActorNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = append(o.r.ActorNotifications, &userRActorNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewActorNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddActorNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingActorNotifications(existingModels ...*models.Notification) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ActorNotifications = append(o.r.ActorNotifications, &userRActorNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutActorNotifications() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = nil
+	})
+}
+
+func (m userMods) WithNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = []*userRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingNotifications(existingModels ...*models.Notification) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutNotifications() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = nil
+	})
+}
+
+func (m userMods) WithAuthorPostRevisions(number int, related *PostRevisionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorPostRevisions = []*userRAuthorPostRevisionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAuthorPostRevisions(number int, mods ...PostRevisionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewPostRevisionWithContext(ctx, mods...)
+		m.WithAuthorPostRevisions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAuthorPostRevisions(number int, related *PostRevisionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorPostRevisions = append(o.r.AuthorPostRevisions, &userRAuthorPostRevisionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAuthorPostRevisions(number int, mods ...PostRevisionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewPostRevisionWithContext(ctx, mods...)
+		m.AddAuthorPostRevisions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAuthorPostRevisions(existingModels ...*models.PostRevision) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AuthorPostRevisions = append(o.r.AuthorPostRevisions, &userRAuthorPostRevisionsR{
+				o: o.f.FromExistingPostRevision(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAuthorPostRevisions() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorPostRevisions = nil
+	})
+}
+
+func (m userMods) WithAuthorQuestions(number int, related *QuestionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorQuestions = []*userRAuthorQuestionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAuthorQuestions(number int, mods ...QuestionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewQuestionWithContext(ctx, mods...)
+		m.WithAuthorQuestions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAuthorQuestions(number int, related *QuestionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorQuestions = append(o.r.AuthorQuestions, &userRAuthorQuestionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAuthorQuestions(number int, mods ...QuestionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewQuestionWithContext(ctx, mods...)
+		m.AddAuthorQuestions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAuthorQuestions(existingModels ...*models.Question) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AuthorQuestions = append(o.r.AuthorQuestions, &userRAuthorQuestionsR{
+				o: o.f.FromExistingQuestion(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAuthorQuestions() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthorQuestions = nil
+	})
+}
+
+func (m userMods) WithVotes(number int, related *VoteTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Votes = []*userRVotesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewVotes(number int, mods ...VoteMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewVoteWithContext(ctx, mods...)
+		m.WithVotes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddVotes(number int, related *VoteTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Votes = append(o.r.Votes, &userRVotesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewVotes(number int, mods ...VoteMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewVoteWithContext(ctx, mods...)
+		m.AddVotes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingVotes(existingModels ...*models.Vote) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Votes = append(o.r.Votes, &userRVotesR{
+				o: o.f.FromExistingVote(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutVotes() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Votes = nil
 	})
 }
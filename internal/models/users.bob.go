@@ -5,6 +5,7 @@ package models
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
@@ -19,20 +20,29 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 // User is an object representing the database table.
 type User struct {
-	ID            int64            `db:"id,pk" `
-	Login         string           `db:"login" `
-	Email         string           `db:"email" `
-	Fullname      string           `db:"fullname" `
-	Rating        int32            `db:"rating" `
-	Role          enums.UserRole   `db:"role" `
-	Password      string           `db:"password" `
-	CreatedAt     time.Time        `db:"created_at" `
-	EmailVerified bool             `db:"email_verified" `
-	GoogleID      null.Val[string] `db:"google_id" `
+	ID             int64               `db:"id,pk" `
+	Login          string              `db:"login" `
+	Email          string              `db:"email" `
+	Fullname       string              `db:"fullname" `
+	Rating         int32               `db:"rating" `
+	Role           enums.UserRole      `db:"role" `
+	Password       string              `db:"password" `
+	CreatedAt      time.Time           `db:"created_at" `
+	EmailVerified  bool                `db:"email_verified" `
+	GoogleID       null.Val[string]    `db:"google_id" `
+	HasPassword    bool                `db:"has_password" `
+	Avatar         null.Val[string]    `db:"avatar" `
+	LoginChangedAt null.Val[time.Time] `db:"login_changed_at" `
+	BannedAt       null.Val[time.Time] `db:"banned_at" `
+
+	R userR `db:"-" `
 }
 
 // UserSlice is an alias for a slice of pointers to User.
@@ -45,38 +55,69 @@ var Users = psql.NewTablex[*User, UserSlice, *UserSetter]("", "users", buildUser
 // UsersQuery is a query on the users table
 type UsersQuery = *psql.ViewQuery[*User, UserSlice]
 
+// userR is where relationships are stored.
+type userR struct {
+	ActorActivities            ActivitySlice               // activities.activities_actor_id_fkey
+	AuthorAnswers              AnswerSlice                 // answers.answers_author_id_fkey
+	Attachments                AttachmentSlice             // attachments.attachments_user_id_fkey
+	BookmarkCollections        BookmarkCollectionSlice     // bookmark_collections.bookmark_collections_user_id_fkey
+	Bookmarks                  BookmarkSlice               // bookmarks.bookmarks_user_id_fkey
+	AuthorComments             CommentSlice                // comments.comments_author_id_fkey
+	ReporterFlags              FlagSlice                   // flags.flags_reporter_id_fkey
+	Follows                    FollowSlice                 // follows.follows_user_id_fkey
+	LoginHistories             LoginHistorySlice           // login_history.login_history_user_id_fkey
+	ModeratorModerationActions ModerationActionSlice       // moderation_actions.moderation_actions_moderator_id_fkey
+	ModerationActions          ModerationActionSlice       // moderation_actions.moderation_actions_user_id_fkey
+	NotificationDigests        NotificationDigestSlice     // notification_digests.notification_digests_user_id_fkey
+	NotificationPreferences    NotificationPreferenceSlice // notification_preferences.notification_preferences_user_id_fkey
+	ActorNotifications         NotificationSlice           // notifications.notifications_actor_id_fkey
+	Notifications              NotificationSlice           // notifications.notifications_user_id_fkey
+	AuthorPostRevisions        PostRevisionSlice           // post_revisions.post_revisions_author_id_fkey
+	AuthorQuestions            QuestionSlice               // questions.questions_author_id_fkey
+	UnsubscribeVersion         *UnsubscribeVersion         // unsubscribe_versions.unsubscribe_versions_user_id_fkey
+	Votes                      VoteSlice                   // votes.votes_user_id_fkey
+}
+
 func buildUserColumns(alias string) userColumns {
 	return userColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "login", "email", "fullname", "rating", "role", "password", "created_at", "email_verified", "google_id",
+			"id", "login", "email", "fullname", "rating", "role", "password", "created_at", "email_verified", "google_id", "has_password", "avatar", "login_changed_at", "banned_at",
 		).WithParent("users"),
-		tableAlias:    alias,
-		ID:            psql.Quote(alias, "id"),
-		Login:         psql.Quote(alias, "login"),
-		Email:         psql.Quote(alias, "email"),
-		Fullname:      psql.Quote(alias, "fullname"),
-		Rating:        psql.Quote(alias, "rating"),
-		Role:          psql.Quote(alias, "role"),
-		Password:      psql.Quote(alias, "password"),
-		CreatedAt:     psql.Quote(alias, "created_at"),
-		EmailVerified: psql.Quote(alias, "email_verified"),
-		GoogleID:      psql.Quote(alias, "google_id"),
+		tableAlias:     alias,
+		ID:             psql.Quote(alias, "id"),
+		Login:          psql.Quote(alias, "login"),
+		Email:          psql.Quote(alias, "email"),
+		Fullname:       psql.Quote(alias, "fullname"),
+		Rating:         psql.Quote(alias, "rating"),
+		Role:           psql.Quote(alias, "role"),
+		Password:       psql.Quote(alias, "password"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+		EmailVerified:  psql.Quote(alias, "email_verified"),
+		GoogleID:       psql.Quote(alias, "google_id"),
+		HasPassword:    psql.Quote(alias, "has_password"),
+		Avatar:         psql.Quote(alias, "avatar"),
+		LoginChangedAt: psql.Quote(alias, "login_changed_at"),
+		BannedAt:       psql.Quote(alias, "banned_at"),
 	}
 }
 
 type userColumns struct {
 	expr.ColumnsExpr
-	tableAlias    string
-	ID            psql.Expression
-	Login         psql.Expression
-	Email         psql.Expression
-	Fullname      psql.Expression
-	Rating        psql.Expression
-	Role          psql.Expression
-	Password      psql.Expression
-	CreatedAt     psql.Expression
-	EmailVerified psql.Expression
-	GoogleID      psql.Expression
+	tableAlias     string
+	ID             psql.Expression
+	Login          psql.Expression
+	Email          psql.Expression
+	Fullname       psql.Expression
+	Rating         psql.Expression
+	Role           psql.Expression
+	Password       psql.Expression
+	CreatedAt      psql.Expression
+	EmailVerified  psql.Expression
+	GoogleID       psql.Expression
+	HasPassword    psql.Expression
+	Avatar         psql.Expression
+	LoginChangedAt psql.Expression
+	BannedAt       psql.Expression
 }
 
 func (c userColumns) Alias() string {
@@ -91,20 +132,24 @@ func (userColumns) AliasedAs(alias string) userColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type UserSetter struct {
-	ID            omit.Val[int64]          `db:"id,pk" `
-	Login         omit.Val[string]         `db:"login" `
-	Email         omit.Val[string]         `db:"email" `
-	Fullname      omit.Val[string]         `db:"fullname" `
-	Rating        omit.Val[int32]          `db:"rating" `
-	Role          omit.Val[enums.UserRole] `db:"role" `
-	Password      omit.Val[string]         `db:"password" `
-	CreatedAt     omit.Val[time.Time]      `db:"created_at" `
-	EmailVerified omit.Val[bool]           `db:"email_verified" `
-	GoogleID      omitnull.Val[string]     `db:"google_id" `
+	ID             omit.Val[int64]          `db:"id,pk" `
+	Login          omit.Val[string]         `db:"login" `
+	Email          omit.Val[string]         `db:"email" `
+	Fullname       omit.Val[string]         `db:"fullname" `
+	Rating         omit.Val[int32]          `db:"rating" `
+	Role           omit.Val[enums.UserRole] `db:"role" `
+	Password       omit.Val[string]         `db:"password" `
+	CreatedAt      omit.Val[time.Time]      `db:"created_at" `
+	EmailVerified  omit.Val[bool]           `db:"email_verified" `
+	GoogleID       omitnull.Val[string]     `db:"google_id" `
+	HasPassword    omit.Val[bool]           `db:"has_password" `
+	Avatar         omitnull.Val[string]     `db:"avatar" `
+	LoginChangedAt omitnull.Val[time.Time]  `db:"login_changed_at" `
+	BannedAt       omitnull.Val[time.Time]  `db:"banned_at" `
 }
 
 func (s UserSetter) SetColumns() []string {
-	vals := make([]string, 0, 10)
+	vals := make([]string, 0, 14)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -135,6 +180,18 @@ func (s UserSetter) SetColumns() []string {
 	if !s.GoogleID.IsUnset() {
 		vals = append(vals, "google_id")
 	}
+	if s.HasPassword.IsValue() {
+		vals = append(vals, "has_password")
+	}
+	if !s.Avatar.IsUnset() {
+		vals = append(vals, "avatar")
+	}
+	if !s.LoginChangedAt.IsUnset() {
+		vals = append(vals, "login_changed_at")
+	}
+	if !s.BannedAt.IsUnset() {
+		vals = append(vals, "banned_at")
+	}
 	return vals
 }
 
@@ -169,6 +226,18 @@ func (s UserSetter) Overwrite(t *User) {
 	if !s.GoogleID.IsUnset() {
 		t.GoogleID = s.GoogleID.MustGetNull()
 	}
+	if s.HasPassword.IsValue() {
+		t.HasPassword = s.HasPassword.MustGet()
+	}
+	if !s.Avatar.IsUnset() {
+		t.Avatar = s.Avatar.MustGetNull()
+	}
+	if !s.LoginChangedAt.IsUnset() {
+		t.LoginChangedAt = s.LoginChangedAt.MustGetNull()
+	}
+	if !s.BannedAt.IsUnset() {
+		t.BannedAt = s.BannedAt.MustGetNull()
+	}
 }
 
 func (s *UserSetter) Apply(q *dialect.InsertQuery) {
@@ -177,7 +246,7 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 10)
+		vals := make([]bob.Expression, 14)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -238,6 +307,30 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 			vals[9] = psql.Raw("DEFAULT")
 		}
 
+		if s.HasPassword.IsValue() {
+			vals[10] = psql.Arg(s.HasPassword.MustGet())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Avatar.IsUnset() {
+			vals[11] = psql.Arg(s.Avatar.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LoginChangedAt.IsUnset() {
+			vals[12] = psql.Arg(s.LoginChangedAt.MustGetNull())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		if !s.BannedAt.IsUnset() {
+			vals[13] = psql.Arg(s.BannedAt.MustGetNull())
+		} else {
+			vals[13] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -247,7 +340,7 @@ func (s UserSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 10)
+	exprs := make([]bob.Expression, 0, 14)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -319,6 +412,34 @@ func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.HasPassword.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "has_password")...),
+			psql.Arg(s.HasPassword),
+		}})
+	}
+
+	if !s.Avatar.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "avatar")...),
+			psql.Arg(s.Avatar),
+		}})
+	}
+
+	if !s.LoginChangedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "login_changed_at")...),
+			psql.Arg(s.LoginChangedAt),
+		}})
+	}
+
+	if !s.BannedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "banned_at")...),
+			psql.Arg(s.BannedAt),
+		}})
+	}
+
 	return exprs
 }
 
@@ -380,6 +501,7 @@ func (o *User) Update(ctx context.Context, exec bob.Executor, s *UserSetter) err
 		return err
 	}
 
+	o.R = v.R
 	*o = *v
 
 	return nil
@@ -399,7 +521,7 @@ func (o *User) Reload(ctx context.Context, exec bob.Executor) error {
 	if err != nil {
 		return err
 	}
-
+	o2.R = o.R
 	*o = *o2
 
 	return nil
@@ -446,7 +568,7 @@ func (o UserSlice) copyMatchingRows(from ...*User) {
 			if new.ID != old.ID {
 				continue
 			}
-
+			new.R = old.R
 			o[i] = new
 			break
 		}
@@ -544,34 +666,3748 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
-type userWhere[Q psql.Filterable] struct {
-	ID            psql.WhereMod[Q, int64]
-	Login         psql.WhereMod[Q, string]
-	Email         psql.WhereMod[Q, string]
-	Fullname      psql.WhereMod[Q, string]
-	Rating        psql.WhereMod[Q, int32]
-	Role          psql.WhereMod[Q, enums.UserRole]
-	Password      psql.WhereMod[Q, string]
-	CreatedAt     psql.WhereMod[Q, time.Time]
-	EmailVerified psql.WhereMod[Q, bool]
-	GoogleID      psql.WhereNullMod[Q, string]
+// ActorActivities starts a query for related objects on activities
+func (o *User) ActorActivities(mods ...bob.Mod[*dialect.SelectQuery]) ActivitiesQuery {
+	return Activities.Query(append(mods,
+		sm.Where(Activities.Columns.ActorID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
-	return buildUserWhere[Q](buildUserColumns(alias))
+func (os UserSlice) ActorActivities(mods ...bob.Mod[*dialect.SelectQuery]) ActivitiesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Activities.Query(append(mods,
+		sm.Where(psql.Group(Activities.Columns.ActorID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
-	return userWhere[Q]{
-		ID:            psql.Where[Q, int64](cols.ID),
-		Login:         psql.Where[Q, string](cols.Login),
-		Email:         psql.Where[Q, string](cols.Email),
-		Fullname:      psql.Where[Q, string](cols.Fullname),
-		Rating:        psql.Where[Q, int32](cols.Rating),
-		Role:          psql.Where[Q, enums.UserRole](cols.Role),
-		Password:      psql.Where[Q, string](cols.Password),
-		CreatedAt:     psql.Where[Q, time.Time](cols.CreatedAt),
-		EmailVerified: psql.Where[Q, bool](cols.EmailVerified),
-		GoogleID:      psql.WhereNull[Q, string](cols.GoogleID),
+// AuthorAnswers starts a query for related objects on answers
+func (o *User) AuthorAnswers(mods ...bob.Mod[*dialect.SelectQuery]) AnswersQuery {
+	return Answers.Query(append(mods,
+		sm.Where(Answers.Columns.AuthorID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AuthorAnswers(mods ...bob.Mod[*dialect.SelectQuery]) AnswersQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Answers.Query(append(mods,
+		sm.Where(psql.Group(Answers.Columns.AuthorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Attachments starts a query for related objects on attachments
+func (o *User) Attachments(mods ...bob.Mod[*dialect.SelectQuery]) AttachmentsQuery {
+	return Attachments.Query(append(mods,
+		sm.Where(Attachments.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Attachments(mods ...bob.Mod[*dialect.SelectQuery]) AttachmentsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Attachments.Query(append(mods,
+		sm.Where(psql.Group(Attachments.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// BookmarkCollections starts a query for related objects on bookmark_collections
+func (o *User) BookmarkCollections(mods ...bob.Mod[*dialect.SelectQuery]) BookmarkCollectionsQuery {
+	return BookmarkCollections.Query(append(mods,
+		sm.Where(BookmarkCollections.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) BookmarkCollections(mods ...bob.Mod[*dialect.SelectQuery]) BookmarkCollectionsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return BookmarkCollections.Query(append(mods,
+		sm.Where(psql.Group(BookmarkCollections.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Bookmarks starts a query for related objects on bookmarks
+func (o *User) Bookmarks(mods ...bob.Mod[*dialect.SelectQuery]) BookmarksQuery {
+	return Bookmarks.Query(append(mods,
+		sm.Where(Bookmarks.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Bookmarks(mods ...bob.Mod[*dialect.SelectQuery]) BookmarksQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Bookmarks.Query(append(mods,
+		sm.Where(psql.Group(Bookmarks.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// AuthorComments starts a query for related objects on comments
+func (o *User) AuthorComments(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	return Comments.Query(append(mods,
+		sm.Where(Comments.Columns.AuthorID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AuthorComments(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Comments.Query(append(mods,
+		sm.Where(psql.Group(Comments.Columns.AuthorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ReporterFlags starts a query for related objects on flags
+func (o *User) ReporterFlags(mods ...bob.Mod[*dialect.SelectQuery]) FlagsQuery {
+	return Flags.Query(append(mods,
+		sm.Where(Flags.Columns.ReporterID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ReporterFlags(mods ...bob.Mod[*dialect.SelectQuery]) FlagsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Flags.Query(append(mods,
+		sm.Where(psql.Group(Flags.Columns.ReporterID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Follows starts a query for related objects on follows
+func (o *User) Follows(mods ...bob.Mod[*dialect.SelectQuery]) FollowsQuery {
+	return Follows.Query(append(mods,
+		sm.Where(Follows.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Follows(mods ...bob.Mod[*dialect.SelectQuery]) FollowsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Follows.Query(append(mods,
+		sm.Where(psql.Group(Follows.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// LoginHistories starts a query for related objects on login_history
+func (o *User) LoginHistories(mods ...bob.Mod[*dialect.SelectQuery]) LoginHistoriesQuery {
+	return LoginHistories.Query(append(mods,
+		sm.Where(LoginHistories.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) LoginHistories(mods ...bob.Mod[*dialect.SelectQuery]) LoginHistoriesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return LoginHistories.Query(append(mods,
+		sm.Where(psql.Group(LoginHistories.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ModeratorModerationActions starts a query for related objects on moderation_actions
+func (o *User) ModeratorModerationActions(mods ...bob.Mod[*dialect.SelectQuery]) ModerationActionsQuery {
+	return ModerationActions.Query(append(mods,
+		sm.Where(ModerationActions.Columns.ModeratorID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ModeratorModerationActions(mods ...bob.Mod[*dialect.SelectQuery]) ModerationActionsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return ModerationActions.Query(append(mods,
+		sm.Where(psql.Group(ModerationActions.Columns.ModeratorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ModerationActions starts a query for related objects on moderation_actions
+func (o *User) ModerationActions(mods ...bob.Mod[*dialect.SelectQuery]) ModerationActionsQuery {
+	return ModerationActions.Query(append(mods,
+		sm.Where(ModerationActions.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ModerationActions(mods ...bob.Mod[*dialect.SelectQuery]) ModerationActionsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return ModerationActions.Query(append(mods,
+		sm.Where(psql.Group(ModerationActions.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// NotificationDigests starts a query for related objects on notification_digests
+func (o *User) NotificationDigests(mods ...bob.Mod[*dialect.SelectQuery]) NotificationDigestsQuery {
+	return NotificationDigests.Query(append(mods,
+		sm.Where(NotificationDigests.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) NotificationDigests(mods ...bob.Mod[*dialect.SelectQuery]) NotificationDigestsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return NotificationDigests.Query(append(mods,
+		sm.Where(psql.Group(NotificationDigests.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// NotificationPreferences starts a query for related objects on notification_preferences
+func (o *User) NotificationPreferences(mods ...bob.Mod[*dialect.SelectQuery]) NotificationPreferencesQuery {
+	return NotificationPreferences.Query(append(mods,
+		sm.Where(NotificationPreferences.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) NotificationPreferences(mods ...bob.Mod[*dialect.SelectQuery]) NotificationPreferencesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return NotificationPreferences.Query(append(mods,
+		sm.Where(psql.Group(NotificationPreferences.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ActorNotifications starts a query for related objects on notifications
+func (o *User) ActorNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.ActorID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ActorNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Notifications.Query(append(mods,
+		sm.Where(psql.Group(Notifications.Columns.ActorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Notifications starts a query for related objects on notifications
+func (o *User) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Notifications.Query(append(mods,
+		sm.Where(psql.Group(Notifications.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// AuthorPostRevisions starts a query for related objects on post_revisions
+func (o *User) AuthorPostRevisions(mods ...bob.Mod[*dialect.SelectQuery]) PostRevisionsQuery {
+	return PostRevisions.Query(append(mods,
+		sm.Where(PostRevisions.Columns.AuthorID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AuthorPostRevisions(mods ...bob.Mod[*dialect.SelectQuery]) PostRevisionsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return PostRevisions.Query(append(mods,
+		sm.Where(psql.Group(PostRevisions.Columns.AuthorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// AuthorQuestions starts a query for related objects on questions
+func (o *User) AuthorQuestions(mods ...bob.Mod[*dialect.SelectQuery]) QuestionsQuery {
+	return Questions.Query(append(mods,
+		sm.Where(Questions.Columns.AuthorID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AuthorQuestions(mods ...bob.Mod[*dialect.SelectQuery]) QuestionsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Questions.Query(append(mods,
+		sm.Where(psql.Group(Questions.Columns.AuthorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// UnsubscribeVersion starts a query for related objects on unsubscribe_versions
+func (o *User) UnsubscribeVersion(mods ...bob.Mod[*dialect.SelectQuery]) UnsubscribeVersionsQuery {
+	return UnsubscribeVersions.Query(append(mods,
+		sm.Where(UnsubscribeVersions.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) UnsubscribeVersion(mods ...bob.Mod[*dialect.SelectQuery]) UnsubscribeVersionsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return UnsubscribeVersions.Query(append(mods,
+		sm.Where(psql.Group(UnsubscribeVersions.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Votes starts a query for related objects on votes
+func (o *User) Votes(mods ...bob.Mod[*dialect.SelectQuery]) VotesQuery {
+	return Votes.Query(append(mods,
+		sm.Where(Votes.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Votes(mods ...bob.Mod[*dialect.SelectQuery]) VotesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Votes.Query(append(mods,
+		sm.Where(psql.Group(Votes.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertUserActorActivities0(ctx context.Context, exec bob.Executor, activities1 []*ActivitySetter, user0 *User) (ActivitySlice, error) {
+	for i := range activities1 {
+		activities1[i].ActorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Activities.Insert(bob.ToMods(activities1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserActorActivities0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserActorActivities0(ctx context.Context, exec bob.Executor, count int, activities1 ActivitySlice, user0 *User) (ActivitySlice, error) {
+	setter := &ActivitySetter{
+		ActorID: omitnull.From(user0.ID),
+	}
+
+	err := activities1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserActorActivities0: %w", err)
+	}
+
+	return activities1, nil
+}
+
+func (user0 *User) InsertActorActivities(ctx context.Context, exec bob.Executor, related ...*ActivitySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	activities1, err := insertUserActorActivities0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorActivities = append(user0.R.ActorActivities, activities1...)
+
+	for _, rel := range activities1 {
+		rel.R.ActorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachActorActivities(ctx context.Context, exec bob.Executor, related ...*Activity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	activities1 := ActivitySlice(related)
+
+	_, err = attachUserActorActivities0(ctx, exec, len(related), activities1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorActivities = append(user0.R.ActorActivities, activities1...)
+
+	for _, rel := range related {
+		rel.R.ActorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserAuthorAnswers0(ctx context.Context, exec bob.Executor, answers1 []*AnswerSetter, user0 *User) (AnswerSlice, error) {
+	for i := range answers1 {
+		answers1[i].AuthorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Answers.Insert(bob.ToMods(answers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAuthorAnswers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAuthorAnswers0(ctx context.Context, exec bob.Executor, count int, answers1 AnswerSlice, user0 *User) (AnswerSlice, error) {
+	setter := &AnswerSetter{
+		AuthorID: omitnull.From(user0.ID),
+	}
+
+	err := answers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAuthorAnswers0: %w", err)
+	}
+
+	return answers1, nil
+}
+
+func (user0 *User) InsertAuthorAnswers(ctx context.Context, exec bob.Executor, related ...*AnswerSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	answers1, err := insertUserAuthorAnswers0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorAnswers = append(user0.R.AuthorAnswers, answers1...)
+
+	for _, rel := range answers1 {
+		rel.R.AuthorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAuthorAnswers(ctx context.Context, exec bob.Executor, related ...*Answer) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	answers1 := AnswerSlice(related)
+
+	_, err = attachUserAuthorAnswers0(ctx, exec, len(related), answers1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorAnswers = append(user0.R.AuthorAnswers, answers1...)
+
+	for _, rel := range related {
+		rel.R.AuthorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserAttachments0(ctx context.Context, exec bob.Executor, attachments1 []*AttachmentSetter, user0 *User) (AttachmentSlice, error) {
+	for i := range attachments1 {
+		attachments1[i].UserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Attachments.Insert(bob.ToMods(attachments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAttachments0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAttachments0(ctx context.Context, exec bob.Executor, count int, attachments1 AttachmentSlice, user0 *User) (AttachmentSlice, error) {
+	setter := &AttachmentSetter{
+		UserID: omitnull.From(user0.ID),
+	}
+
+	err := attachments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAttachments0: %w", err)
+	}
+
+	return attachments1, nil
+}
+
+func (user0 *User) InsertAttachments(ctx context.Context, exec bob.Executor, related ...*AttachmentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	attachments1, err := insertUserAttachments0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Attachments = append(user0.R.Attachments, attachments1...)
+
+	for _, rel := range attachments1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAttachments(ctx context.Context, exec bob.Executor, related ...*Attachment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	attachments1 := AttachmentSlice(related)
+
+	_, err = attachUserAttachments0(ctx, exec, len(related), attachments1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Attachments = append(user0.R.Attachments, attachments1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserBookmarkCollections0(ctx context.Context, exec bob.Executor, bookmarkCollections1 []*BookmarkCollectionSetter, user0 *User) (BookmarkCollectionSlice, error) {
+	for i := range bookmarkCollections1 {
+		bookmarkCollections1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := BookmarkCollections.Insert(bob.ToMods(bookmarkCollections1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserBookmarkCollections0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserBookmarkCollections0(ctx context.Context, exec bob.Executor, count int, bookmarkCollections1 BookmarkCollectionSlice, user0 *User) (BookmarkCollectionSlice, error) {
+	setter := &BookmarkCollectionSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := bookmarkCollections1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserBookmarkCollections0: %w", err)
+	}
+
+	return bookmarkCollections1, nil
+}
+
+func (user0 *User) InsertBookmarkCollections(ctx context.Context, exec bob.Executor, related ...*BookmarkCollectionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	bookmarkCollections1, err := insertUserBookmarkCollections0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.BookmarkCollections = append(user0.R.BookmarkCollections, bookmarkCollections1...)
+
+	for _, rel := range bookmarkCollections1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachBookmarkCollections(ctx context.Context, exec bob.Executor, related ...*BookmarkCollection) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	bookmarkCollections1 := BookmarkCollectionSlice(related)
+
+	_, err = attachUserBookmarkCollections0(ctx, exec, len(related), bookmarkCollections1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.BookmarkCollections = append(user0.R.BookmarkCollections, bookmarkCollections1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserBookmarks0(ctx context.Context, exec bob.Executor, bookmarks1 []*BookmarkSetter, user0 *User) (BookmarkSlice, error) {
+	for i := range bookmarks1 {
+		bookmarks1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Bookmarks.Insert(bob.ToMods(bookmarks1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserBookmarks0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserBookmarks0(ctx context.Context, exec bob.Executor, count int, bookmarks1 BookmarkSlice, user0 *User) (BookmarkSlice, error) {
+	setter := &BookmarkSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := bookmarks1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserBookmarks0: %w", err)
+	}
+
+	return bookmarks1, nil
+}
+
+func (user0 *User) InsertBookmarks(ctx context.Context, exec bob.Executor, related ...*BookmarkSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	bookmarks1, err := insertUserBookmarks0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Bookmarks = append(user0.R.Bookmarks, bookmarks1...)
+
+	for _, rel := range bookmarks1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachBookmarks(ctx context.Context, exec bob.Executor, related ...*Bookmark) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	bookmarks1 := BookmarkSlice(related)
+
+	_, err = attachUserBookmarks0(ctx, exec, len(related), bookmarks1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Bookmarks = append(user0.R.Bookmarks, bookmarks1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserAuthorComments0(ctx context.Context, exec bob.Executor, comments1 []*CommentSetter, user0 *User) (CommentSlice, error) {
+	for i := range comments1 {
+		comments1[i].AuthorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Comments.Insert(bob.ToMods(comments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAuthorComments0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAuthorComments0(ctx context.Context, exec bob.Executor, count int, comments1 CommentSlice, user0 *User) (CommentSlice, error) {
+	setter := &CommentSetter{
+		AuthorID: omitnull.From(user0.ID),
+	}
+
+	err := comments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAuthorComments0: %w", err)
+	}
+
+	return comments1, nil
+}
+
+func (user0 *User) InsertAuthorComments(ctx context.Context, exec bob.Executor, related ...*CommentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	comments1, err := insertUserAuthorComments0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorComments = append(user0.R.AuthorComments, comments1...)
+
+	for _, rel := range comments1 {
+		rel.R.AuthorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAuthorComments(ctx context.Context, exec bob.Executor, related ...*Comment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	comments1 := CommentSlice(related)
+
+	_, err = attachUserAuthorComments0(ctx, exec, len(related), comments1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorComments = append(user0.R.AuthorComments, comments1...)
+
+	for _, rel := range related {
+		rel.R.AuthorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserReporterFlags0(ctx context.Context, exec bob.Executor, flags1 []*FlagSetter, user0 *User) (FlagSlice, error) {
+	for i := range flags1 {
+		flags1[i].ReporterID = omit.From(user0.ID)
+	}
+
+	ret, err := Flags.Insert(bob.ToMods(flags1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserReporterFlags0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserReporterFlags0(ctx context.Context, exec bob.Executor, count int, flags1 FlagSlice, user0 *User) (FlagSlice, error) {
+	setter := &FlagSetter{
+		ReporterID: omit.From(user0.ID),
+	}
+
+	err := flags1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserReporterFlags0: %w", err)
+	}
+
+	return flags1, nil
+}
+
+func (user0 *User) InsertReporterFlags(ctx context.Context, exec bob.Executor, related ...*FlagSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	flags1, err := insertUserReporterFlags0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ReporterFlags = append(user0.R.ReporterFlags, flags1...)
+
+	for _, rel := range flags1 {
+		rel.R.ReporterUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachReporterFlags(ctx context.Context, exec bob.Executor, related ...*Flag) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	flags1 := FlagSlice(related)
+
+	_, err = attachUserReporterFlags0(ctx, exec, len(related), flags1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ReporterFlags = append(user0.R.ReporterFlags, flags1...)
+
+	for _, rel := range related {
+		rel.R.ReporterUser = user0
+	}
+
+	return nil
+}
+
+func insertUserFollows0(ctx context.Context, exec bob.Executor, follows1 []*FollowSetter, user0 *User) (FollowSlice, error) {
+	for i := range follows1 {
+		follows1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Follows.Insert(bob.ToMods(follows1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserFollows0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserFollows0(ctx context.Context, exec bob.Executor, count int, follows1 FollowSlice, user0 *User) (FollowSlice, error) {
+	setter := &FollowSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := follows1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserFollows0: %w", err)
+	}
+
+	return follows1, nil
+}
+
+func (user0 *User) InsertFollows(ctx context.Context, exec bob.Executor, related ...*FollowSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	follows1, err := insertUserFollows0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Follows = append(user0.R.Follows, follows1...)
+
+	for _, rel := range follows1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachFollows(ctx context.Context, exec bob.Executor, related ...*Follow) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	follows1 := FollowSlice(related)
+
+	_, err = attachUserFollows0(ctx, exec, len(related), follows1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Follows = append(user0.R.Follows, follows1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserLoginHistories0(ctx context.Context, exec bob.Executor, loginHistories1 []*LoginHistorySetter, user0 *User) (LoginHistorySlice, error) {
+	for i := range loginHistories1 {
+		loginHistories1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := LoginHistories.Insert(bob.ToMods(loginHistories1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserLoginHistories0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserLoginHistories0(ctx context.Context, exec bob.Executor, count int, loginHistories1 LoginHistorySlice, user0 *User) (LoginHistorySlice, error) {
+	setter := &LoginHistorySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := loginHistories1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserLoginHistories0: %w", err)
+	}
+
+	return loginHistories1, nil
+}
+
+func (user0 *User) InsertLoginHistories(ctx context.Context, exec bob.Executor, related ...*LoginHistorySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	loginHistories1, err := insertUserLoginHistories0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.LoginHistories = append(user0.R.LoginHistories, loginHistories1...)
+
+	for _, rel := range loginHistories1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachLoginHistories(ctx context.Context, exec bob.Executor, related ...*LoginHistory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	loginHistories1 := LoginHistorySlice(related)
+
+	_, err = attachUserLoginHistories0(ctx, exec, len(related), loginHistories1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.LoginHistories = append(user0.R.LoginHistories, loginHistories1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserModeratorModerationActions0(ctx context.Context, exec bob.Executor, moderationActions1 []*ModerationActionSetter, user0 *User) (ModerationActionSlice, error) {
+	for i := range moderationActions1 {
+		moderationActions1[i].ModeratorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := ModerationActions.Insert(bob.ToMods(moderationActions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserModeratorModerationActions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserModeratorModerationActions0(ctx context.Context, exec bob.Executor, count int, moderationActions1 ModerationActionSlice, user0 *User) (ModerationActionSlice, error) {
+	setter := &ModerationActionSetter{
+		ModeratorID: omitnull.From(user0.ID),
+	}
+
+	err := moderationActions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserModeratorModerationActions0: %w", err)
+	}
+
+	return moderationActions1, nil
+}
+
+func (user0 *User) InsertModeratorModerationActions(ctx context.Context, exec bob.Executor, related ...*ModerationActionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	moderationActions1, err := insertUserModeratorModerationActions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ModeratorModerationActions = append(user0.R.ModeratorModerationActions, moderationActions1...)
+
+	for _, rel := range moderationActions1 {
+		rel.R.ModeratorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachModeratorModerationActions(ctx context.Context, exec bob.Executor, related ...*ModerationAction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	moderationActions1 := ModerationActionSlice(related)
+
+	_, err = attachUserModeratorModerationActions0(ctx, exec, len(related), moderationActions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ModeratorModerationActions = append(user0.R.ModeratorModerationActions, moderationActions1...)
+
+	for _, rel := range related {
+		rel.R.ModeratorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserModerationActions0(ctx context.Context, exec bob.Executor, moderationActions1 []*ModerationActionSetter, user0 *User) (ModerationActionSlice, error) {
+	for i := range moderationActions1 {
+		moderationActions1[i].UserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := ModerationActions.Insert(bob.ToMods(moderationActions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserModerationActions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserModerationActions0(ctx context.Context, exec bob.Executor, count int, moderationActions1 ModerationActionSlice, user0 *User) (ModerationActionSlice, error) {
+	setter := &ModerationActionSetter{
+		UserID: omitnull.From(user0.ID),
+	}
+
+	err := moderationActions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserModerationActions0: %w", err)
+	}
+
+	return moderationActions1, nil
+}
+
+func (user0 *User) InsertModerationActions(ctx context.Context, exec bob.Executor, related ...*ModerationActionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	moderationActions1, err := insertUserModerationActions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ModerationActions = append(user0.R.ModerationActions, moderationActions1...)
+
+	for _, rel := range moderationActions1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachModerationActions(ctx context.Context, exec bob.Executor, related ...*ModerationAction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	moderationActions1 := ModerationActionSlice(related)
+
+	_, err = attachUserModerationActions0(ctx, exec, len(related), moderationActions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ModerationActions = append(user0.R.ModerationActions, moderationActions1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserNotificationDigests0(ctx context.Context, exec bob.Executor, notificationDigests1 []*NotificationDigestSetter, user0 *User) (NotificationDigestSlice, error) {
+	for i := range notificationDigests1 {
+		notificationDigests1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := NotificationDigests.Insert(bob.ToMods(notificationDigests1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserNotificationDigests0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserNotificationDigests0(ctx context.Context, exec bob.Executor, count int, notificationDigests1 NotificationDigestSlice, user0 *User) (NotificationDigestSlice, error) {
+	setter := &NotificationDigestSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := notificationDigests1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserNotificationDigests0: %w", err)
+	}
+
+	return notificationDigests1, nil
+}
+
+func (user0 *User) InsertNotificationDigests(ctx context.Context, exec bob.Executor, related ...*NotificationDigestSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notificationDigests1, err := insertUserNotificationDigests0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.NotificationDigests = append(user0.R.NotificationDigests, notificationDigests1...)
+
+	for _, rel := range notificationDigests1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachNotificationDigests(ctx context.Context, exec bob.Executor, related ...*NotificationDigest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notificationDigests1 := NotificationDigestSlice(related)
+
+	_, err = attachUserNotificationDigests0(ctx, exec, len(related), notificationDigests1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.NotificationDigests = append(user0.R.NotificationDigests, notificationDigests1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserNotificationPreferences0(ctx context.Context, exec bob.Executor, notificationPreferences1 []*NotificationPreferenceSetter, user0 *User) (NotificationPreferenceSlice, error) {
+	for i := range notificationPreferences1 {
+		notificationPreferences1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := NotificationPreferences.Insert(bob.ToMods(notificationPreferences1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserNotificationPreferences0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserNotificationPreferences0(ctx context.Context, exec bob.Executor, count int, notificationPreferences1 NotificationPreferenceSlice, user0 *User) (NotificationPreferenceSlice, error) {
+	setter := &NotificationPreferenceSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := notificationPreferences1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserNotificationPreferences0: %w", err)
+	}
+
+	return notificationPreferences1, nil
+}
+
+func (user0 *User) InsertNotificationPreferences(ctx context.Context, exec bob.Executor, related ...*NotificationPreferenceSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notificationPreferences1, err := insertUserNotificationPreferences0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.NotificationPreferences = append(user0.R.NotificationPreferences, notificationPreferences1...)
+
+	for _, rel := range notificationPreferences1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachNotificationPreferences(ctx context.Context, exec bob.Executor, related ...*NotificationPreference) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notificationPreferences1 := NotificationPreferenceSlice(related)
+
+	_, err = attachUserNotificationPreferences0(ctx, exec, len(related), notificationPreferences1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.NotificationPreferences = append(user0.R.NotificationPreferences, notificationPreferences1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserActorNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].ActorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserActorNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserActorNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		ActorID: omitnull.From(user0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserActorNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (user0 *User) InsertActorNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertUserActorNotifications0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorNotifications = append(user0.R.ActorNotifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.ActorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachActorNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachUserActorNotifications0(ctx, exec, len(related), notifications1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorNotifications = append(user0.R.ActorNotifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.ActorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (user0 *User) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertUserNotifications0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachUserNotifications0(ctx, exec, len(related), notifications1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserAuthorPostRevisions0(ctx context.Context, exec bob.Executor, postRevisions1 []*PostRevisionSetter, user0 *User) (PostRevisionSlice, error) {
+	for i := range postRevisions1 {
+		postRevisions1[i].AuthorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := PostRevisions.Insert(bob.ToMods(postRevisions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAuthorPostRevisions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAuthorPostRevisions0(ctx context.Context, exec bob.Executor, count int, postRevisions1 PostRevisionSlice, user0 *User) (PostRevisionSlice, error) {
+	setter := &PostRevisionSetter{
+		AuthorID: omitnull.From(user0.ID),
+	}
+
+	err := postRevisions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAuthorPostRevisions0: %w", err)
+	}
+
+	return postRevisions1, nil
+}
+
+func (user0 *User) InsertAuthorPostRevisions(ctx context.Context, exec bob.Executor, related ...*PostRevisionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	postRevisions1, err := insertUserAuthorPostRevisions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorPostRevisions = append(user0.R.AuthorPostRevisions, postRevisions1...)
+
+	for _, rel := range postRevisions1 {
+		rel.R.AuthorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAuthorPostRevisions(ctx context.Context, exec bob.Executor, related ...*PostRevision) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	postRevisions1 := PostRevisionSlice(related)
+
+	_, err = attachUserAuthorPostRevisions0(ctx, exec, len(related), postRevisions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorPostRevisions = append(user0.R.AuthorPostRevisions, postRevisions1...)
+
+	for _, rel := range related {
+		rel.R.AuthorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserAuthorQuestions0(ctx context.Context, exec bob.Executor, questions1 []*QuestionSetter, user0 *User) (QuestionSlice, error) {
+	for i := range questions1 {
+		questions1[i].AuthorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Questions.Insert(bob.ToMods(questions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAuthorQuestions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAuthorQuestions0(ctx context.Context, exec bob.Executor, count int, questions1 QuestionSlice, user0 *User) (QuestionSlice, error) {
+	setter := &QuestionSetter{
+		AuthorID: omitnull.From(user0.ID),
+	}
+
+	err := questions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAuthorQuestions0: %w", err)
+	}
+
+	return questions1, nil
+}
+
+func (user0 *User) InsertAuthorQuestions(ctx context.Context, exec bob.Executor, related ...*QuestionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	questions1, err := insertUserAuthorQuestions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorQuestions = append(user0.R.AuthorQuestions, questions1...)
+
+	for _, rel := range questions1 {
+		rel.R.AuthorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAuthorQuestions(ctx context.Context, exec bob.Executor, related ...*Question) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	questions1 := QuestionSlice(related)
+
+	_, err = attachUserAuthorQuestions0(ctx, exec, len(related), questions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthorQuestions = append(user0.R.AuthorQuestions, questions1...)
+
+	for _, rel := range related {
+		rel.R.AuthorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserUnsubscribeVersion0(ctx context.Context, exec bob.Executor, unsubscribeVersion1 *UnsubscribeVersionSetter, user0 *User) (*UnsubscribeVersion, error) {
+	unsubscribeVersion1.UserID = omit.From(user0.ID)
+
+	ret, err := UnsubscribeVersions.Insert(unsubscribeVersion1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUnsubscribeVersion0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUnsubscribeVersion0(ctx context.Context, exec bob.Executor, count int, unsubscribeVersion1 *UnsubscribeVersion, user0 *User) (*UnsubscribeVersion, error) {
+	setter := &UnsubscribeVersionSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := unsubscribeVersion1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUnsubscribeVersion0: %w", err)
+	}
+
+	return unsubscribeVersion1, nil
+}
+
+func (user0 *User) InsertUnsubscribeVersion(ctx context.Context, exec bob.Executor, related *UnsubscribeVersionSetter) error {
+	var err error
+
+	unsubscribeVersion1, err := insertUserUnsubscribeVersion0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UnsubscribeVersion = unsubscribeVersion1
+
+	unsubscribeVersion1.R.User = user0
+
+	return nil
+}
+
+func (user0 *User) AttachUnsubscribeVersion(ctx context.Context, exec bob.Executor, unsubscribeVersion1 *UnsubscribeVersion) error {
+	var err error
+
+	_, err = attachUserUnsubscribeVersion0(ctx, exec, 1, unsubscribeVersion1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UnsubscribeVersion = unsubscribeVersion1
+
+	unsubscribeVersion1.R.User = user0
+
+	return nil
+}
+
+func insertUserVotes0(ctx context.Context, exec bob.Executor, votes1 []*VoteSetter, user0 *User) (VoteSlice, error) {
+	for i := range votes1 {
+		votes1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Votes.Insert(bob.ToMods(votes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserVotes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserVotes0(ctx context.Context, exec bob.Executor, count int, votes1 VoteSlice, user0 *User) (VoteSlice, error) {
+	setter := &VoteSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := votes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserVotes0: %w", err)
+	}
+
+	return votes1, nil
+}
+
+func (user0 *User) InsertVotes(ctx context.Context, exec bob.Executor, related ...*VoteSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	votes1, err := insertUserVotes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Votes = append(user0.R.Votes, votes1...)
+
+	for _, rel := range votes1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachVotes(ctx context.Context, exec bob.Executor, related ...*Vote) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	votes1 := VoteSlice(related)
+
+	_, err = attachUserVotes0(ctx, exec, len(related), votes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Votes = append(user0.R.Votes, votes1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+type userWhere[Q psql.Filterable] struct {
+	ID             psql.WhereMod[Q, int64]
+	Login          psql.WhereMod[Q, string]
+	Email          psql.WhereMod[Q, string]
+	Fullname       psql.WhereMod[Q, string]
+	Rating         psql.WhereMod[Q, int32]
+	Role           psql.WhereMod[Q, enums.UserRole]
+	Password       psql.WhereMod[Q, string]
+	CreatedAt      psql.WhereMod[Q, time.Time]
+	EmailVerified  psql.WhereMod[Q, bool]
+	GoogleID       psql.WhereNullMod[Q, string]
+	HasPassword    psql.WhereMod[Q, bool]
+	Avatar         psql.WhereNullMod[Q, string]
+	LoginChangedAt psql.WhereNullMod[Q, time.Time]
+	BannedAt       psql.WhereNullMod[Q, time.Time]
+}
+
+func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
+	return buildUserWhere[Q](buildUserColumns(alias))
+}
+
+func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
+	return userWhere[Q]{
+		ID:             psql.Where[Q, int64](cols.ID),
+		Login:          psql.Where[Q, string](cols.Login),
+		Email:          psql.Where[Q, string](cols.Email),
+		Fullname:       psql.Where[Q, string](cols.Fullname),
+		Rating:         psql.Where[Q, int32](cols.Rating),
+		Role:           psql.Where[Q, enums.UserRole](cols.Role),
+		Password:       psql.Where[Q, string](cols.Password),
+		CreatedAt:      psql.Where[Q, time.Time](cols.CreatedAt),
+		EmailVerified:  psql.Where[Q, bool](cols.EmailVerified),
+		GoogleID:       psql.WhereNull[Q, string](cols.GoogleID),
+		HasPassword:    psql.Where[Q, bool](cols.HasPassword),
+		Avatar:         psql.WhereNull[Q, string](cols.Avatar),
+		LoginChangedAt: psql.WhereNull[Q, time.Time](cols.LoginChangedAt),
+		BannedAt:       psql.WhereNull[Q, time.Time](cols.BannedAt),
+	}
+}
+
+func (o *User) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ActorActivities":
+		rels, ok := retrieved.(ActivitySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ActorActivities = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ActorUser = o
+			}
+		}
+		return nil
+	case "AuthorAnswers":
+		rels, ok := retrieved.(AnswerSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AuthorAnswers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.AuthorUser = o
+			}
+		}
+		return nil
+	case "Attachments":
+		rels, ok := retrieved.(AttachmentSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Attachments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "BookmarkCollections":
+		rels, ok := retrieved.(BookmarkCollectionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BookmarkCollections = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Bookmarks":
+		rels, ok := retrieved.(BookmarkSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Bookmarks = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "AuthorComments":
+		rels, ok := retrieved.(CommentSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AuthorComments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.AuthorUser = o
+			}
+		}
+		return nil
+	case "ReporterFlags":
+		rels, ok := retrieved.(FlagSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ReporterFlags = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ReporterUser = o
+			}
+		}
+		return nil
+	case "Follows":
+		rels, ok := retrieved.(FollowSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Follows = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "LoginHistories":
+		rels, ok := retrieved.(LoginHistorySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.LoginHistories = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ModeratorModerationActions":
+		rels, ok := retrieved.(ModerationActionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ModeratorModerationActions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ModeratorUser = o
+			}
+		}
+		return nil
+	case "ModerationActions":
+		rels, ok := retrieved.(ModerationActionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ModerationActions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "NotificationDigests":
+		rels, ok := retrieved.(NotificationDigestSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.NotificationDigests = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "NotificationPreferences":
+		rels, ok := retrieved.(NotificationPreferenceSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.NotificationPreferences = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ActorNotifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ActorNotifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ActorUser = o
+			}
+		}
+		return nil
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "AuthorPostRevisions":
+		rels, ok := retrieved.(PostRevisionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AuthorPostRevisions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.AuthorUser = o
+			}
+		}
+		return nil
+	case "AuthorQuestions":
+		rels, ok := retrieved.(QuestionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AuthorQuestions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.AuthorUser = o
+			}
+		}
+		return nil
+	case "UnsubscribeVersion":
+		rel, ok := retrieved.(*UnsubscribeVersion)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UnsubscribeVersion = rel
+
+		if rel != nil {
+			rel.R.User = o
+		}
+		return nil
+	case "Votes":
+		rels, ok := retrieved.(VoteSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Votes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("user has no relationship %q", name)
+	}
+}
+
+type userPreloader struct {
+	UnsubscribeVersion func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildUserPreloader() userPreloader {
+	return userPreloader{
+		UnsubscribeVersion: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*UnsubscribeVersion, UnsubscribeVersionSlice](psql.PreloadRel{
+				Name: "UnsubscribeVersion",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Users,
+						To:          UnsubscribeVersions,
+						FromColumns: []string{"id"},
+						ToColumns:   []string{"user_id"},
+					},
+				},
+			}, UnsubscribeVersions.Columns.Names(), opts...)
+		},
+	}
+}
+
+type userThenLoader[Q orm.Loadable] struct {
+	ActorActivities            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AuthorAnswers              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Attachments                func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	BookmarkCollections        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Bookmarks                  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AuthorComments             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ReporterFlags              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Follows                    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	LoginHistories             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ModeratorModerationActions func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ModerationActions          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	NotificationDigests        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	NotificationPreferences    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ActorNotifications         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Notifications              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AuthorPostRevisions        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AuthorQuestions            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	UnsubscribeVersion         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Votes                      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
+	type ActorActivitiesLoadInterface interface {
+		LoadActorActivities(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AuthorAnswersLoadInterface interface {
+		LoadAuthorAnswers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AttachmentsLoadInterface interface {
+		LoadAttachments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type BookmarkCollectionsLoadInterface interface {
+		LoadBookmarkCollections(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type BookmarksLoadInterface interface {
+		LoadBookmarks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AuthorCommentsLoadInterface interface {
+		LoadAuthorComments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ReporterFlagsLoadInterface interface {
+		LoadReporterFlags(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type FollowsLoadInterface interface {
+		LoadFollows(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type LoginHistoriesLoadInterface interface {
+		LoadLoginHistories(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ModeratorModerationActionsLoadInterface interface {
+		LoadModeratorModerationActions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ModerationActionsLoadInterface interface {
+		LoadModerationActions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationDigestsLoadInterface interface {
+		LoadNotificationDigests(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationPreferencesLoadInterface interface {
+		LoadNotificationPreferences(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ActorNotificationsLoadInterface interface {
+		LoadActorNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AuthorPostRevisionsLoadInterface interface {
+		LoadAuthorPostRevisions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AuthorQuestionsLoadInterface interface {
+		LoadAuthorQuestions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UnsubscribeVersionLoadInterface interface {
+		LoadUnsubscribeVersion(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type VotesLoadInterface interface {
+		LoadVotes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userThenLoader[Q]{
+		ActorActivities: thenLoadBuilder[Q](
+			"ActorActivities",
+			func(ctx context.Context, exec bob.Executor, retrieved ActorActivitiesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadActorActivities(ctx, exec, mods...)
+			},
+		),
+		AuthorAnswers: thenLoadBuilder[Q](
+			"AuthorAnswers",
+			func(ctx context.Context, exec bob.Executor, retrieved AuthorAnswersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAuthorAnswers(ctx, exec, mods...)
+			},
+		),
+		Attachments: thenLoadBuilder[Q](
+			"Attachments",
+			func(ctx context.Context, exec bob.Executor, retrieved AttachmentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAttachments(ctx, exec, mods...)
+			},
+		),
+		BookmarkCollections: thenLoadBuilder[Q](
+			"BookmarkCollections",
+			func(ctx context.Context, exec bob.Executor, retrieved BookmarkCollectionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBookmarkCollections(ctx, exec, mods...)
+			},
+		),
+		Bookmarks: thenLoadBuilder[Q](
+			"Bookmarks",
+			func(ctx context.Context, exec bob.Executor, retrieved BookmarksLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBookmarks(ctx, exec, mods...)
+			},
+		),
+		AuthorComments: thenLoadBuilder[Q](
+			"AuthorComments",
+			func(ctx context.Context, exec bob.Executor, retrieved AuthorCommentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAuthorComments(ctx, exec, mods...)
+			},
+		),
+		ReporterFlags: thenLoadBuilder[Q](
+			"ReporterFlags",
+			func(ctx context.Context, exec bob.Executor, retrieved ReporterFlagsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadReporterFlags(ctx, exec, mods...)
+			},
+		),
+		Follows: thenLoadBuilder[Q](
+			"Follows",
+			func(ctx context.Context, exec bob.Executor, retrieved FollowsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadFollows(ctx, exec, mods...)
+			},
+		),
+		LoginHistories: thenLoadBuilder[Q](
+			"LoginHistories",
+			func(ctx context.Context, exec bob.Executor, retrieved LoginHistoriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLoginHistories(ctx, exec, mods...)
+			},
+		),
+		ModeratorModerationActions: thenLoadBuilder[Q](
+			"ModeratorModerationActions",
+			func(ctx context.Context, exec bob.Executor, retrieved ModeratorModerationActionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadModeratorModerationActions(ctx, exec, mods...)
+			},
+		),
+		ModerationActions: thenLoadBuilder[Q](
+			"ModerationActions",
+			func(ctx context.Context, exec bob.Executor, retrieved ModerationActionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadModerationActions(ctx, exec, mods...)
+			},
+		),
+		NotificationDigests: thenLoadBuilder[Q](
+			"NotificationDigests",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationDigestsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotificationDigests(ctx, exec, mods...)
+			},
+		),
+		NotificationPreferences: thenLoadBuilder[Q](
+			"NotificationPreferences",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationPreferencesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotificationPreferences(ctx, exec, mods...)
+			},
+		),
+		ActorNotifications: thenLoadBuilder[Q](
+			"ActorNotifications",
+			func(ctx context.Context, exec bob.Executor, retrieved ActorNotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadActorNotifications(ctx, exec, mods...)
+			},
+		),
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+		AuthorPostRevisions: thenLoadBuilder[Q](
+			"AuthorPostRevisions",
+			func(ctx context.Context, exec bob.Executor, retrieved AuthorPostRevisionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAuthorPostRevisions(ctx, exec, mods...)
+			},
+		),
+		AuthorQuestions: thenLoadBuilder[Q](
+			"AuthorQuestions",
+			func(ctx context.Context, exec bob.Executor, retrieved AuthorQuestionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAuthorQuestions(ctx, exec, mods...)
+			},
+		),
+		UnsubscribeVersion: thenLoadBuilder[Q](
+			"UnsubscribeVersion",
+			func(ctx context.Context, exec bob.Executor, retrieved UnsubscribeVersionLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUnsubscribeVersion(ctx, exec, mods...)
+			},
+		),
+		Votes: thenLoadBuilder[Q](
+			"Votes",
+			func(ctx context.Context, exec bob.Executor, retrieved VotesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadVotes(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadActorActivities loads the user's ActorActivities into the .R struct
+func (o *User) LoadActorActivities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ActorActivities = nil
+
+	related, err := o.ActorActivities(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ActorUser = o
+	}
+
+	o.R.ActorActivities = related
+	return nil
+}
+
+// LoadActorActivities loads the user's ActorActivities into the .R struct
+func (os UserSlice) LoadActorActivities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	activities, err := os.ActorActivities(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ActorActivities = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range activities {
+
+			if !rel.ActorID.IsValue() {
+				continue
+			}
+			if !(rel.ActorID.IsValue() && o.ID == rel.ActorID.MustGet()) {
+				continue
+			}
+
+			rel.R.ActorUser = o
+
+			o.R.ActorActivities = append(o.R.ActorActivities, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorAnswers loads the user's AuthorAnswers into the .R struct
+func (o *User) LoadAuthorAnswers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AuthorAnswers = nil
+
+	related, err := o.AuthorAnswers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.AuthorUser = o
+	}
+
+	o.R.AuthorAnswers = related
+	return nil
+}
+
+// LoadAuthorAnswers loads the user's AuthorAnswers into the .R struct
+func (os UserSlice) LoadAuthorAnswers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	answers, err := os.AuthorAnswers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AuthorAnswers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range answers {
+
+			if !rel.AuthorID.IsValue() {
+				continue
+			}
+			if !(rel.AuthorID.IsValue() && o.ID == rel.AuthorID.MustGet()) {
+				continue
+			}
+
+			rel.R.AuthorUser = o
+
+			o.R.AuthorAnswers = append(o.R.AuthorAnswers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAttachments loads the user's Attachments into the .R struct
+func (o *User) LoadAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Attachments = nil
+
+	related, err := o.Attachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Attachments = related
+	return nil
+}
+
+// LoadAttachments loads the user's Attachments into the .R struct
+func (os UserSlice) LoadAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	attachments, err := os.Attachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Attachments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range attachments {
+
+			if !rel.UserID.IsValue() {
+				continue
+			}
+			if !(rel.UserID.IsValue() && o.ID == rel.UserID.MustGet()) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Attachments = append(o.R.Attachments, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadBookmarkCollections loads the user's BookmarkCollections into the .R struct
+func (o *User) LoadBookmarkCollections(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BookmarkCollections = nil
+
+	related, err := o.BookmarkCollections(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.BookmarkCollections = related
+	return nil
+}
+
+// LoadBookmarkCollections loads the user's BookmarkCollections into the .R struct
+func (os UserSlice) LoadBookmarkCollections(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	bookmarkCollections, err := os.BookmarkCollections(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.BookmarkCollections = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range bookmarkCollections {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.BookmarkCollections = append(o.R.BookmarkCollections, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadBookmarks loads the user's Bookmarks into the .R struct
+func (o *User) LoadBookmarks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Bookmarks = nil
+
+	related, err := o.Bookmarks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Bookmarks = related
+	return nil
+}
+
+// LoadBookmarks loads the user's Bookmarks into the .R struct
+func (os UserSlice) LoadBookmarks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	bookmarks, err := os.Bookmarks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Bookmarks = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range bookmarks {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Bookmarks = append(o.R.Bookmarks, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorComments loads the user's AuthorComments into the .R struct
+func (o *User) LoadAuthorComments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AuthorComments = nil
+
+	related, err := o.AuthorComments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.AuthorUser = o
+	}
+
+	o.R.AuthorComments = related
+	return nil
+}
+
+// LoadAuthorComments loads the user's AuthorComments into the .R struct
+func (os UserSlice) LoadAuthorComments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	comments, err := os.AuthorComments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AuthorComments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range comments {
+
+			if !rel.AuthorID.IsValue() {
+				continue
+			}
+			if !(rel.AuthorID.IsValue() && o.ID == rel.AuthorID.MustGet()) {
+				continue
+			}
+
+			rel.R.AuthorUser = o
+
+			o.R.AuthorComments = append(o.R.AuthorComments, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadReporterFlags loads the user's ReporterFlags into the .R struct
+func (o *User) LoadReporterFlags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ReporterFlags = nil
+
+	related, err := o.ReporterFlags(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ReporterUser = o
+	}
+
+	o.R.ReporterFlags = related
+	return nil
+}
+
+// LoadReporterFlags loads the user's ReporterFlags into the .R struct
+func (os UserSlice) LoadReporterFlags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	flags, err := os.ReporterFlags(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ReporterFlags = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range flags {
+
+			if !(o.ID == rel.ReporterID) {
+				continue
+			}
+
+			rel.R.ReporterUser = o
+
+			o.R.ReporterFlags = append(o.R.ReporterFlags, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadFollows loads the user's Follows into the .R struct
+func (o *User) LoadFollows(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Follows = nil
+
+	related, err := o.Follows(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Follows = related
+	return nil
+}
+
+// LoadFollows loads the user's Follows into the .R struct
+func (os UserSlice) LoadFollows(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	follows, err := os.Follows(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Follows = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range follows {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Follows = append(o.R.Follows, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadLoginHistories loads the user's LoginHistories into the .R struct
+func (o *User) LoadLoginHistories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.LoginHistories = nil
+
+	related, err := o.LoginHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.LoginHistories = related
+	return nil
+}
+
+// LoadLoginHistories loads the user's LoginHistories into the .R struct
+func (os UserSlice) LoadLoginHistories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	loginHistories, err := os.LoginHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.LoginHistories = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range loginHistories {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.LoginHistories = append(o.R.LoginHistories, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadModeratorModerationActions loads the user's ModeratorModerationActions into the .R struct
+func (o *User) LoadModeratorModerationActions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ModeratorModerationActions = nil
+
+	related, err := o.ModeratorModerationActions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ModeratorUser = o
+	}
+
+	o.R.ModeratorModerationActions = related
+	return nil
+}
+
+// LoadModeratorModerationActions loads the user's ModeratorModerationActions into the .R struct
+func (os UserSlice) LoadModeratorModerationActions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	moderationActions, err := os.ModeratorModerationActions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ModeratorModerationActions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range moderationActions {
+
+			if !rel.ModeratorID.IsValue() {
+				continue
+			}
+			if !(rel.ModeratorID.IsValue() && o.ID == rel.ModeratorID.MustGet()) {
+				continue
+			}
+
+			rel.R.ModeratorUser = o
+
+			o.R.ModeratorModerationActions = append(o.R.ModeratorModerationActions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadModerationActions loads the user's ModerationActions into the .R struct
+func (o *User) LoadModerationActions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ModerationActions = nil
+
+	related, err := o.ModerationActions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.ModerationActions = related
+	return nil
+}
+
+// LoadModerationActions loads the user's ModerationActions into the .R struct
+func (os UserSlice) LoadModerationActions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	moderationActions, err := os.ModerationActions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ModerationActions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range moderationActions {
+
+			if !rel.UserID.IsValue() {
+				continue
+			}
+			if !(rel.UserID.IsValue() && o.ID == rel.UserID.MustGet()) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.ModerationActions = append(o.R.ModerationActions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationDigests loads the user's NotificationDigests into the .R struct
+func (o *User) LoadNotificationDigests(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.NotificationDigests = nil
+
+	related, err := o.NotificationDigests(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.NotificationDigests = related
+	return nil
+}
+
+// LoadNotificationDigests loads the user's NotificationDigests into the .R struct
+func (os UserSlice) LoadNotificationDigests(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notificationDigests, err := os.NotificationDigests(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.NotificationDigests = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notificationDigests {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.NotificationDigests = append(o.R.NotificationDigests, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationPreferences loads the user's NotificationPreferences into the .R struct
+func (o *User) LoadNotificationPreferences(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.NotificationPreferences = nil
+
+	related, err := o.NotificationPreferences(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.NotificationPreferences = related
+	return nil
+}
+
+// LoadNotificationPreferences loads the user's NotificationPreferences into the .R struct
+func (os UserSlice) LoadNotificationPreferences(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notificationPreferences, err := os.NotificationPreferences(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.NotificationPreferences = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notificationPreferences {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.NotificationPreferences = append(o.R.NotificationPreferences, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadActorNotifications loads the user's ActorNotifications into the .R struct
+func (o *User) LoadActorNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ActorNotifications = nil
+
+	related, err := o.ActorNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ActorUser = o
+	}
+
+	o.R.ActorNotifications = related
+	return nil
+}
+
+// LoadActorNotifications loads the user's ActorNotifications into the .R struct
+func (os UserSlice) LoadActorNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.ActorNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ActorNotifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !rel.ActorID.IsValue() {
+				continue
+			}
+			if !(rel.ActorID.IsValue() && o.ID == rel.ActorID.MustGet()) {
+				continue
+			}
+
+			rel.R.ActorUser = o
+
+			o.R.ActorNotifications = append(o.R.ActorNotifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (o *User) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Notifications = related
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (os UserSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorPostRevisions loads the user's AuthorPostRevisions into the .R struct
+func (o *User) LoadAuthorPostRevisions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AuthorPostRevisions = nil
+
+	related, err := o.AuthorPostRevisions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.AuthorUser = o
+	}
+
+	o.R.AuthorPostRevisions = related
+	return nil
+}
+
+// LoadAuthorPostRevisions loads the user's AuthorPostRevisions into the .R struct
+func (os UserSlice) LoadAuthorPostRevisions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	postRevisions, err := os.AuthorPostRevisions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AuthorPostRevisions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range postRevisions {
+
+			if !rel.AuthorID.IsValue() {
+				continue
+			}
+			if !(rel.AuthorID.IsValue() && o.ID == rel.AuthorID.MustGet()) {
+				continue
+			}
+
+			rel.R.AuthorUser = o
+
+			o.R.AuthorPostRevisions = append(o.R.AuthorPostRevisions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorQuestions loads the user's AuthorQuestions into the .R struct
+func (o *User) LoadAuthorQuestions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AuthorQuestions = nil
+
+	related, err := o.AuthorQuestions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.AuthorUser = o
+	}
+
+	o.R.AuthorQuestions = related
+	return nil
+}
+
+// LoadAuthorQuestions loads the user's AuthorQuestions into the .R struct
+func (os UserSlice) LoadAuthorQuestions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	questions, err := os.AuthorQuestions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AuthorQuestions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range questions {
+
+			if !rel.AuthorID.IsValue() {
+				continue
+			}
+			if !(rel.AuthorID.IsValue() && o.ID == rel.AuthorID.MustGet()) {
+				continue
+			}
+
+			rel.R.AuthorUser = o
+
+			o.R.AuthorQuestions = append(o.R.AuthorQuestions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUnsubscribeVersion loads the user's UnsubscribeVersion into the .R struct
+func (o *User) LoadUnsubscribeVersion(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UnsubscribeVersion = nil
+
+	related, err := o.UnsubscribeVersion(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.User = o
+
+	o.R.UnsubscribeVersion = related
+	return nil
+}
+
+// LoadUnsubscribeVersion loads the user's UnsubscribeVersion into the .R struct
+func (os UserSlice) LoadUnsubscribeVersion(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	unsubscribeVersions, err := os.UnsubscribeVersion(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range unsubscribeVersions {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.UnsubscribeVersion = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadVotes loads the user's Votes into the .R struct
+func (o *User) LoadVotes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Votes = nil
+
+	related, err := o.Votes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Votes = related
+	return nil
+}
+
+// LoadVotes loads the user's Votes into the .R struct
+func (os UserSlice) LoadVotes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	votes, err := os.Votes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Votes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range votes {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Votes = append(o.R.Votes, rel)
+		}
+	}
+
+	return nil
+}
+
+type userJoins[Q dialect.Joinable] struct {
+	typ                        string
+	ActorActivities            modAs[Q, activityColumns]
+	AuthorAnswers              modAs[Q, answerColumns]
+	Attachments                modAs[Q, attachmentColumns]
+	BookmarkCollections        modAs[Q, bookmarkCollectionColumns]
+	Bookmarks                  modAs[Q, bookmarkColumns]
+	AuthorComments             modAs[Q, commentColumns]
+	ReporterFlags              modAs[Q, flagColumns]
+	Follows                    modAs[Q, followColumns]
+	LoginHistories             modAs[Q, loginHistoryColumns]
+	ModeratorModerationActions modAs[Q, moderationActionColumns]
+	ModerationActions          modAs[Q, moderationActionColumns]
+	NotificationDigests        modAs[Q, notificationDigestColumns]
+	NotificationPreferences    modAs[Q, notificationPreferenceColumns]
+	ActorNotifications         modAs[Q, notificationColumns]
+	Notifications              modAs[Q, notificationColumns]
+	AuthorPostRevisions        modAs[Q, postRevisionColumns]
+	AuthorQuestions            modAs[Q, questionColumns]
+	UnsubscribeVersion         modAs[Q, unsubscribeVersionColumns]
+	Votes                      modAs[Q, voteColumns]
+}
+
+func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
+	return buildUserJoins[Q](buildUserColumns(alias), j.typ)
+}
+
+func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[Q] {
+	return userJoins[Q]{
+		typ: typ,
+		ActorActivities: modAs[Q, activityColumns]{
+			c: Activities.Columns,
+			f: func(to activityColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Activities.Name().As(to.Alias())).On(
+						to.ActorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		AuthorAnswers: modAs[Q, answerColumns]{
+			c: Answers.Columns,
+			f: func(to answerColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Answers.Name().As(to.Alias())).On(
+						to.AuthorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Attachments: modAs[Q, attachmentColumns]{
+			c: Attachments.Columns,
+			f: func(to attachmentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Attachments.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		BookmarkCollections: modAs[Q, bookmarkCollectionColumns]{
+			c: BookmarkCollections.Columns,
+			f: func(to bookmarkCollectionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, BookmarkCollections.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Bookmarks: modAs[Q, bookmarkColumns]{
+			c: Bookmarks.Columns,
+			f: func(to bookmarkColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Bookmarks.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		AuthorComments: modAs[Q, commentColumns]{
+			c: Comments.Columns,
+			f: func(to commentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Comments.Name().As(to.Alias())).On(
+						to.AuthorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ReporterFlags: modAs[Q, flagColumns]{
+			c: Flags.Columns,
+			f: func(to flagColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Flags.Name().As(to.Alias())).On(
+						to.ReporterID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Follows: modAs[Q, followColumns]{
+			c: Follows.Columns,
+			f: func(to followColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Follows.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		LoginHistories: modAs[Q, loginHistoryColumns]{
+			c: LoginHistories.Columns,
+			f: func(to loginHistoryColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, LoginHistories.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ModeratorModerationActions: modAs[Q, moderationActionColumns]{
+			c: ModerationActions.Columns,
+			f: func(to moderationActionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ModerationActions.Name().As(to.Alias())).On(
+						to.ModeratorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ModerationActions: modAs[Q, moderationActionColumns]{
+			c: ModerationActions.Columns,
+			f: func(to moderationActionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ModerationActions.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		NotificationDigests: modAs[Q, notificationDigestColumns]{
+			c: NotificationDigests.Columns,
+			f: func(to notificationDigestColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, NotificationDigests.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		NotificationPreferences: modAs[Q, notificationPreferenceColumns]{
+			c: NotificationPreferences.Columns,
+			f: func(to notificationPreferenceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, NotificationPreferences.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ActorNotifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.ActorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		AuthorPostRevisions: modAs[Q, postRevisionColumns]{
+			c: PostRevisions.Columns,
+			f: func(to postRevisionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, PostRevisions.Name().As(to.Alias())).On(
+						to.AuthorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		AuthorQuestions: modAs[Q, questionColumns]{
+			c: Questions.Columns,
+			f: func(to questionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Questions.Name().As(to.Alias())).On(
+						to.AuthorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		UnsubscribeVersion: modAs[Q, unsubscribeVersionColumns]{
+			c: UnsubscribeVersions.Columns,
+			f: func(to unsubscribeVersionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, UnsubscribeVersions.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Votes: modAs[Q, voteColumns]{
+			c: Votes.Columns,
+			f: func(to voteColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Votes.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 	}
 }
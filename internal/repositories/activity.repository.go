@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type ActivityRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewActivityRepository(db *pgxpool.Pool) *ActivityRepository {
+	return &ActivityRepository{db: db}
+}
+
+// Create inserts the activity and its targets in one transaction.
+func (r *ActivityRepository) Create(ctx context.Context, activity *domain.Activity) error {
+	setter := &models.ActivitySetter{
+		Type:       omit.From(activity.Type),
+		PostID:     omit.From(activity.PostID),
+		QuestionID: omit.From(activity.QuestionID),
+		Excerpt:    omit.From(activity.Excerpt),
+	}
+	if activity.ActorID != 0 {
+		setter.ActorID = omitnull.From(activity.ActorID)
+	}
+
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	return db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		model, err := models.Activities.Insert(setter).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("insert failed: %w", err)
+		}
+
+		if len(activity.Targets) > 0 {
+			mods := make([]bob.Mod[*dialect.InsertQuery], 0, len(activity.Targets)+1)
+			for _, target := range activity.Targets {
+				mods = append(mods, &models.ActivityTargetSetter{
+					ActivityID: omit.From(model.ID),
+					TargetType: omit.From(target.Type),
+					TargetID:   omit.From(target.ID),
+				})
+			}
+			mods = append(mods, im.OnConflict().DoNothing())
+			if _, err := models.ActivityTargets.Insert(mods...).Exec(ctx, exec); err != nil {
+				return fmt.Errorf("insert failed: %w", err)
+			}
+		}
+
+		activity.ID = model.ID
+		activity.CreatedAt = model.CreatedAt
+		return nil
+	})
+}
+
+// Feed matches activity targets against userID's follows in a subquery, so
+// an activity reached through several follows is listed once.
+func (r *ActivityRepository) Feed(ctx context.Context, userID, beforeID int64, limit int) ([]*domain.Activity, error) {
+	followed := psql.Select(
+		sm.Columns(models.ActivityTargets.Columns.ActivityID),
+		sm.From(models.ActivityTargets.Name()),
+		sm.InnerJoin(models.Follows.Name()).On(
+			models.Follows.Columns.TargetType.EQ(models.ActivityTargets.Columns.TargetType),
+			models.Follows.Columns.TargetID.EQ(models.ActivityTargets.Columns.TargetID),
+		),
+		sm.Where(models.Follows.Columns.UserID.EQ(psql.Arg(userID))),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Activities.Columns.ID.OP("IN", followed)),
+		sm.Where(models.Activities.Columns.ActorID.IsDistinctFrom(psql.Arg(userID))),
+		sm.OrderBy(models.Activities.Columns.ID).Desc(),
+		sm.Limit(limit),
+	}
+	if beforeID != 0 {
+		mods = append(mods, sm.Where(models.Activities.Columns.ID.LT(psql.Arg(beforeID))))
+	}
+
+	slice, err := models.Activities.Query(mods...).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	activities := make([]*domain.Activity, len(slice))
+	for i, m := range slice {
+		activities[i] = &domain.Activity{
+			ID:         m.ID,
+			ActorID:    m.ActorID.GetOr(0),
+			Type:       m.Type,
+			PostID:     m.PostID,
+			QuestionID: m.QuestionID,
+			Excerpt:    m.Excerpt,
+			CreatedAt:  m.CreatedAt,
+		}
+	}
+	return activities, nil
+}
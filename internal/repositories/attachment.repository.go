@@ -0,0 +1,242 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type AttachmentRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAttachmentRepository(db *pgxpool.Pool) *AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
+
+// Create locks the owner's row so concurrent uploads cannot both pass the
+// quota check.
+func (r *AttachmentRepository) Create(ctx context.Context, a *domain.Attachment, quota domain.AttachmentQuota) (bool, error) {
+	created := false
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		_, err := models.Users.Query(
+			sm.Where(models.Users.Columns.ID.EQ(psql.Arg(a.UserID))),
+			sm.ForUpdate(),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to lock user: %w", err)
+		}
+
+		usage, err := usageOf(ctx, exec, a.UserID)
+		if err != nil {
+			return err
+		}
+		if usage.Files+1 > quota.MaxFiles || usage.Bytes+a.Size > quota.MaxBytes {
+			return nil
+		}
+
+		model, err := models.Attachments.Insert(&models.AttachmentSetter{
+			UserID:      omitnull.From(a.UserID),
+			StorageKey:  omit.From(a.Key),
+			URL:         omit.From(a.URL),
+			ContentType: omit.From(a.ContentType),
+			SizeBytes:   omit.From(a.Size),
+			Width:       omit.From(int32(a.Width)),
+			Height:      omit.From(int32(a.Height)),
+		}).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("insert failed: %w", err)
+		}
+		a.ID = model.ID
+		a.CreatedAt = model.CreatedAt
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+func (r *AttachmentRepository) GetByID(ctx context.Context, id int64) (*domain.Attachment, error) {
+	model, err := models.Attachments.Query(
+		sm.Where(models.Attachments.Columns.ID.EQ(psql.Arg(id))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapAttachmentToDomain(model), nil
+}
+
+func (r *AttachmentRepository) ListByUser(ctx context.Context, userID int64) ([]*domain.Attachment, error) {
+	return r.list(ctx,
+		sm.Where(models.Attachments.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.OrderBy(models.Attachments.Columns.CreatedAt).Desc(),
+	)
+}
+
+func (r *AttachmentRepository) ListByTarget(ctx context.Context, targetType string, targetID int64) ([]*domain.Attachment, error) {
+	return r.list(ctx,
+		sm.Where(models.Attachments.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.Attachments.Columns.TargetID.EQ(psql.Arg(targetID))),
+		sm.OrderBy(models.Attachments.Columns.ID),
+	)
+}
+
+func (r *AttachmentRepository) Usage(ctx context.Context, userID int64) (domain.AttachmentUsage, error) {
+	return usageOf(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)), userID)
+}
+
+// errPartialLink rolls SetTarget back when some of the requested uploads
+// cannot be linked.
+var errPartialLink = errors.New("not every attachment could be linked")
+
+func (r *AttachmentRepository) SetTarget(ctx context.Context, userID int64, targetType string, targetID int64, ids []int64) (int, error) {
+	linked := 0
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		release := &models.AttachmentSetter{
+			TargetType: omitnull.FromPtr[string](nil),
+			TargetID:   omitnull.FromPtr[int64](nil),
+			AttachedAt: omitnull.FromPtr[time.Time](nil),
+		}
+		releaseMods := []bob.Mod[*dialect.UpdateQuery]{
+			release.UpdateMod(),
+			um.Where(models.Attachments.Columns.TargetType.EQ(psql.Arg(targetType))),
+			um.Where(models.Attachments.Columns.TargetID.EQ(psql.Arg(targetID))),
+		}
+		if len(ids) > 0 {
+			releaseMods = append(releaseMods, um.Where(models.Attachments.Columns.ID.NotIn(idArgs(ids)...)))
+		}
+		if _, err := models.Attachments.Update(releaseMods...).Exec(ctx, exec); err != nil {
+			return fmt.Errorf("failed to release attachments: %w", err)
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		link := &models.AttachmentSetter{
+			TargetType: omitnull.From(targetType),
+			TargetID:   omitnull.From(targetID),
+			AttachedAt: omitnull.From(time.Now()),
+		}
+		_, err := models.Attachments.Update(
+			link.UpdateMod(),
+			um.Where(models.Attachments.Columns.ID.In(idArgs(ids)...)),
+			um.Where(models.Attachments.Columns.UserID.EQ(psql.Arg(userID))),
+			um.Where(models.Attachments.Columns.TargetID.IsNull()),
+		).Exec(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to link attachments: %w", err)
+		}
+
+		current, err := models.Attachments.Query(
+			sm.Where(models.Attachments.Columns.ID.In(idArgs(ids)...)),
+			sm.Where(models.Attachments.Columns.TargetType.EQ(psql.Arg(targetType))),
+			sm.Where(models.Attachments.Columns.TargetID.EQ(psql.Arg(targetID))),
+		).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+		linked = len(current)
+		if linked != len(ids) {
+			return errPartialLink
+		}
+		return nil
+	})
+	if errors.Is(err, errPartialLink) {
+		return linked, nil
+	}
+	return linked, err
+}
+
+func (r *AttachmentRepository) ListOrphaned(ctx context.Context, before time.Time, afterID int64, limit int) ([]*domain.Attachment, error) {
+	return r.list(ctx,
+		sm.Where(models.Attachments.Columns.TargetID.IsNull()),
+		sm.Where(models.Attachments.Columns.ID.GT(psql.Arg(afterID))),
+		sm.Where(psql.Or(
+			models.Attachments.Columns.CreatedAt.LT(psql.Arg(before)),
+			models.Attachments.Columns.UserID.IsNull(),
+		)),
+		sm.OrderBy(models.Attachments.Columns.ID),
+		sm.Limit(limit),
+	)
+}
+
+func (r *AttachmentRepository) Delete(ctx context.Context, id int64) error {
+	_, err := models.Attachments.Delete(
+		dm.Where(models.Attachments.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+	return nil
+}
+
+func (r *AttachmentRepository) list(ctx context.Context, mods ...bob.Mod[*dialect.SelectQuery]) ([]*domain.Attachment, error) {
+	slice, err := models.Attachments.Query(mods...).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	attachments := make([]*domain.Attachment, len(slice))
+	for i, m := range slice {
+		attachments[i] = mapAttachmentToDomain(m)
+	}
+	return attachments, nil
+}
+
+// usageRow is the result of the usage query.
+type usageRow struct {
+	Files int   `db:"files"`
+	Bytes int64 `db:"bytes"`
+}
+
+func usageOf(ctx context.Context, exec bob.Executor, userID int64) (domain.AttachmentUsage, error) {
+	row, err := bob.One(ctx, exec, psql.Select(
+		sm.Columns(
+			psql.Raw("count(*)").As("files"),
+			psql.Raw("coalesce(sum(size_bytes), 0)::bigint").As("bytes"),
+		),
+		sm.From(models.Attachments.Name()),
+		sm.Where(models.Attachments.Columns.UserID.EQ(psql.Arg(userID))),
+	), scan.StructMapper[usageRow]())
+	if err != nil {
+		return domain.AttachmentUsage{}, fmt.Errorf("database error: %w", err)
+	}
+	return domain.AttachmentUsage{Files: row.Files, Bytes: row.Bytes}, nil
+}
+
+func mapAttachmentToDomain(m *models.Attachment) *domain.Attachment {
+	return &domain.Attachment{
+		ID:          m.ID,
+		UserID:      m.UserID.GetOrZero(),
+		Key:         m.StorageKey,
+		URL:         m.URL,
+		ContentType: m.ContentType,
+		Size:        m.SizeBytes,
+		Width:       int(m.Width),
+		Height:      int(m.Height),
+		TargetType:  m.TargetType.GetOrZero(),
+		TargetID:    m.TargetID.GetOrZero(),
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type BookmarkRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBookmarkRepository(db *pgxpool.Pool) *BookmarkRepository {
+	return &BookmarkRepository{db: db}
+}
+
+func (r *BookmarkRepository) Create(ctx context.Context, bookmark *domain.Bookmark) error {
+	setter := &models.BookmarkSetter{
+		UserID:       omit.From(bookmark.UserID),
+		CollectionID: omitnull.FromPtr(bookmark.CollectionID),
+		TargetType:   omit.From(bookmark.TargetType),
+		TargetID:     omit.From(bookmark.TargetID),
+		Position:     omit.From(int32(bookmark.Position)),
+	}
+	model, err := models.Bookmarks.Insert(setter).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("insert failed: %w", err)
+	}
+	bookmark.ID = model.ID
+	bookmark.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *BookmarkRepository) GetByID(ctx context.Context, id int64) (*domain.Bookmark, error) {
+	model, err := models.Bookmarks.Query(
+		sm.Where(models.Bookmarks.Columns.ID.EQ(psql.Arg(id))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapBookmarkToDomain(model), nil
+}
+
+func (r *BookmarkRepository) GetByTarget(ctx context.Context, userID int64, targetType string, targetID int64) (*domain.Bookmark, error) {
+	model, err := models.Bookmarks.Query(
+		sm.Where(models.Bookmarks.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Bookmarks.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.Bookmarks.Columns.TargetID.EQ(psql.Arg(targetID))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapBookmarkToDomain(model), nil
+}
+
+func (r *BookmarkRepository) List(ctx context.Context, userID int64, collectionID *int64) ([]*domain.Bookmark, error) {
+	slice, err := models.Bookmarks.Query(
+		sm.Where(models.Bookmarks.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(inCollection(collectionID)),
+		sm.OrderBy(models.Bookmarks.Columns.Position),
+		sm.OrderBy(models.Bookmarks.Columns.ID),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	bookmarks := make([]*domain.Bookmark, len(slice))
+	for i, m := range slice {
+		bookmarks[i] = mapBookmarkToDomain(m)
+	}
+	return bookmarks, nil
+}
+
+func (r *BookmarkRepository) NextPosition(ctx context.Context, userID int64, collectionID *int64) (int, error) {
+	last, err := models.Bookmarks.Query(
+		sm.Where(models.Bookmarks.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(inCollection(collectionID)),
+		sm.OrderBy(models.Bookmarks.Columns.Position).Desc(),
+		sm.Limit(1),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(last.Position) + 1, nil
+}
+
+func (r *BookmarkRepository) Move(ctx context.Context, id int64, collectionID *int64, position int) error {
+	setter := &models.BookmarkSetter{
+		CollectionID: omitnull.FromPtr(collectionID),
+		Position:     omit.From(int32(position)),
+	}
+	_, err := models.Bookmarks.Update(
+		setter.UpdateMod(),
+		um.Where(models.Bookmarks.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	return nil
+}
+
+// Reorder sets every position in a single statement, so readers never see a
+// half-applied order and large collections don't take a round trip per
+// bookmark.
+func (r *BookmarkRepository) Reorder(ctx context.Context, userID int64, ids []int64) error {
+	_, err := psql.RawQuery(`
+		UPDATE bookmarks AS b
+		SET position = o.ord - 1
+		FROM unnest(?::bigint[]) WITH ORDINALITY AS o(id, ord)
+		WHERE b.id = o.id AND b.user_id = ?`, ids, userID,
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) Delete(ctx context.Context, userID, id int64) (bool, error) {
+	deleted, err := models.Bookmarks.Delete(
+		dm.Where(models.Bookmarks.Columns.ID.EQ(psql.Arg(id))),
+		dm.Where(models.Bookmarks.Columns.UserID.EQ(psql.Arg(userID))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return false, fmt.Errorf("delete failed: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+func (r *BookmarkRepository) Count(ctx context.Context, userID int64) (int, error) {
+	count, err := models.Bookmarks.Query(
+		sm.Where(models.Bookmarks.Columns.UserID.EQ(psql.Arg(userID))),
+	).Count(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(count), nil
+}
+
+// inCollection matches bookmarks in collectionID, or outside any collection
+// when it is nil.
+func inCollection(collectionID *int64) psql.Expression {
+	if collectionID == nil {
+		return models.Bookmarks.Columns.CollectionID.IsNull()
+	}
+	return models.Bookmarks.Columns.CollectionID.EQ(psql.Arg(*collectionID))
+}
+
+func mapBookmarkToDomain(m *models.Bookmark) *domain.Bookmark {
+	return &domain.Bookmark{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		CollectionID: m.CollectionID.Ptr(),
+		TargetType:   m.TargetType,
+		TargetID:     m.TargetID,
+		Position:     int(m.Position),
+		CreatedAt:    m.CreatedAt,
+	}
+}
@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type BookmarkCollectionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBookmarkCollectionRepository(db *pgxpool.Pool) *BookmarkCollectionRepository {
+	return &BookmarkCollectionRepository{db: db}
+}
+
+func (r *BookmarkCollectionRepository) Create(ctx context.Context, collection *domain.BookmarkCollection) error {
+	setter := &models.BookmarkCollectionSetter{
+		UserID:     omit.From(collection.UserID),
+		Name:       omit.From(collection.Name),
+		IsPublic:   omit.From(collection.Public),
+		ShareToken: shareTokenValue(collection.ShareToken),
+	}
+	model, err := models.BookmarkCollections.Insert(setter).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("insert failed: %w", uniqueViolation(err))
+	}
+	collection.ID = model.ID
+	collection.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *BookmarkCollectionRepository) GetByID(ctx context.Context, id int64) (*domain.BookmarkCollection, error) {
+	model, err := models.BookmarkCollections.Query(
+		sm.Where(models.BookmarkCollections.Columns.ID.EQ(psql.Arg(id))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapBookmarkCollectionToDomain(model), nil
+}
+
+func (r *BookmarkCollectionRepository) GetByName(ctx context.Context, userID int64, name string) (*domain.BookmarkCollection, error) {
+	model, err := models.BookmarkCollections.Query(
+		sm.Where(models.BookmarkCollections.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.BookmarkCollections.Columns.Name.EQ(psql.Arg(name))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapBookmarkCollectionToDomain(model), nil
+}
+
+func (r *BookmarkCollectionRepository) GetByShareToken(ctx context.Context, token string) (*domain.BookmarkCollection, error) {
+	model, err := models.BookmarkCollections.Query(
+		sm.Where(models.BookmarkCollections.Columns.ShareToken.EQ(psql.Arg(token))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapBookmarkCollectionToDomain(model), nil
+}
+
+func (r *BookmarkCollectionRepository) List(ctx context.Context, userID int64) ([]*domain.BookmarkCollection, error) {
+	slice, err := models.BookmarkCollections.Query(
+		sm.Where(models.BookmarkCollections.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.OrderBy(models.BookmarkCollections.Columns.Name),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	collections := make([]*domain.BookmarkCollection, len(slice))
+	for i, m := range slice {
+		collections[i] = mapBookmarkCollectionToDomain(m)
+	}
+	return collections, nil
+}
+
+func (r *BookmarkCollectionRepository) Update(ctx context.Context, collection *domain.BookmarkCollection) error {
+	setter := &models.BookmarkCollectionSetter{
+		Name:       omit.From(collection.Name),
+		IsPublic:   omit.From(collection.Public),
+		ShareToken: shareTokenValue(collection.ShareToken),
+	}
+	rowsAffected, err := models.BookmarkCollections.Update(
+		setter.UpdateMod(),
+		um.Where(models.BookmarkCollections.Columns.ID.EQ(psql.Arg(collection.ID))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", uniqueViolation(err))
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("bookmark collection with ID %d not found", collection.ID)
+	}
+	return nil
+}
+
+// Delete moves the bookmarks out before deleting the collection. Left to
+// ON DELETE SET NULL, they would keep positions that clash with the
+// bookmarks already outside collections.
+func (r *BookmarkCollectionRepository) Delete(ctx context.Context, id int64) error {
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	return db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		_, err := psql.RawQuery(`
+			UPDATE bookmarks AS b
+			SET collection_id = NULL, position = base.next + moved.rank - 1
+			FROM (
+				SELECT id, row_number() OVER (ORDER BY position, id) AS rank
+				FROM bookmarks WHERE collection_id = ?
+			) AS moved, (
+				SELECT coalesce(max(position) + 1, 0) AS next
+				FROM bookmarks
+				WHERE collection_id IS NULL
+				  AND user_id = (SELECT user_id FROM bookmark_collections WHERE id = ?)
+			) AS base
+			WHERE b.id = moved.id`, id, id).Exec(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("update failed: %w", err)
+		}
+
+		rowsAffected, err := models.BookmarkCollections.Delete(
+			dm.Where(models.BookmarkCollections.Columns.ID.EQ(psql.Arg(id))),
+		).Exec(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("delete failed: %w", err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("bookmark collection with ID %d not found", id)
+		}
+		return nil
+	})
+}
+
+func (r *BookmarkCollectionRepository) Count(ctx context.Context, userID int64) (int, error) {
+	count, err := models.BookmarkCollections.Query(
+		sm.Where(models.BookmarkCollections.Columns.UserID.EQ(psql.Arg(userID))),
+	).Count(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(count), nil
+}
+
+func mapBookmarkCollectionToDomain(m *models.BookmarkCollection) *domain.BookmarkCollection {
+	return &domain.BookmarkCollection{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		Name:       m.Name,
+		Public:     m.IsPublic,
+		ShareToken: m.ShareToken.GetOr(""),
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// shareTokenValue stores an empty token as NULL, so private collections
+// don't collide on the unique constraint.
+func shareTokenValue(token string) omitnull.Val[string] {
+	if token == "" {
+		return omitnull.FromPtr[string](nil)
+	}
+	return omitnull.From(token)
+}
@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type CommentRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCommentRepository(db *pgxpool.Pool) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	setter := &models.CommentSetter{
+		TargetType: omit.From(comment.TargetType),
+		TargetID:   omit.From(comment.TargetID),
+		Body:       omit.From(comment.Body),
+	}
+	if comment.AuthorID != 0 {
+		setter.AuthorID = omitnull.From(comment.AuthorID)
+	}
+
+	model, err := models.Comments.Insert(setter).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("insert failed: %w", err)
+	}
+	comment.ID = model.ID
+	comment.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *CommentRepository) GetByID(ctx context.Context, id int64) (*domain.Comment, error) {
+	model, err := models.Comments.Query(
+		sm.Where(models.Comments.Columns.ID.EQ(psql.Arg(id))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapCommentToDomain(model), nil
+}
+
+func (r *CommentRepository) List(ctx context.Context, targetType string, targetID int64) ([]*domain.Comment, error) {
+	slice, err := models.Comments.Query(
+		sm.Where(models.Comments.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.Comments.Columns.TargetID.EQ(psql.Arg(targetID))),
+		notDeleted(domain.FlagTargetComment, models.Comments.Columns.ID),
+		sm.OrderBy(models.Comments.Columns.ID),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	comments := make([]*domain.Comment, len(slice))
+	for i, m := range slice {
+		comments[i] = mapCommentToDomain(m)
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, id int64) error {
+	rowsAffected, err := models.Comments.Delete(
+		dm.Where(models.Comments.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("comment with ID %d not found", id)
+	}
+	return nil
+}
+
+func mapCommentToDomain(m *models.Comment) *domain.Comment {
+	return &domain.Comment{
+		ID:         m.ID,
+		TargetType: m.TargetType,
+		TargetID:   m.TargetID,
+		AuthorID:   m.AuthorID.GetOr(0),
+		Body:       m.Body,
+		CreatedAt:  m.CreatedAt,
+	}
+}
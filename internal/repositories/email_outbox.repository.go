@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type EmailOutboxRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEmailOutboxRepository(db *pgxpool.Pool) *EmailOutboxRepository {
+	return &EmailOutboxRepository{db: db}
+}
+
+func (r *EmailOutboxRepository) Enqueue(ctx context.Context, email *domain.OutboxEmail) error {
+	setter := &models.EmailOutboxSetter{
+		Recipient: omit.From(email.Email.To),
+		Subject:   omit.From(email.Email.Subject),
+		HTMLBody:  omit.From(email.Email.HTML),
+		TextBody:  omit.From(email.Email.Text),
+	}
+	if email.Email.UnsubscribeURL != "" {
+		setter.UnsubscribeURL = omitnull.From(email.Email.UnsubscribeURL)
+	}
+	model, err := models.EmailOutboxes.Insert(setter).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("insert failed: %w", err)
+	}
+	email.ID = model.ID
+	email.Status = model.Status
+	email.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *EmailOutboxRepository) ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]*domain.OutboxEmail, error) {
+	var claimed []*domain.OutboxEmail
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		due, err := models.EmailOutboxes.Query(
+			sm.Where(models.EmailOutboxes.Columns.Status.EQ(psql.Arg(domain.OutboxPending))),
+			sm.Where(models.EmailOutboxes.Columns.NextAttemptAt.LTE(psql.Arg(time.Now()))),
+			sm.OrderBy(models.EmailOutboxes.Columns.NextAttemptAt),
+			sm.Limit(limit),
+			sm.ForUpdate().SkipLocked(),
+		).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+
+		for _, m := range due {
+			err := m.Update(ctx, exec, &models.EmailOutboxSetter{
+				Attempts:      omit.From(m.Attempts + 1),
+				NextAttemptAt: omit.From(leaseUntil),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to lease email %d: %w", m.ID, err)
+			}
+			claimed = append(claimed, mapOutboxToDomain(m))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func (r *EmailOutboxRepository) MarkSent(ctx context.Context, id int64) error {
+	return r.update(ctx, id, &models.EmailOutboxSetter{
+		Status: omit.From(domain.OutboxSent),
+		SentAt: omitnull.From(time.Now()),
+	})
+}
+
+func (r *EmailOutboxRepository) MarkFailed(ctx context.Context, id int64, lastError string, retryAt time.Time) error {
+	return r.update(ctx, id, &models.EmailOutboxSetter{
+		LastError:     omitnull.From(lastError),
+		NextAttemptAt: omit.From(retryAt),
+	})
+}
+
+func (r *EmailOutboxRepository) MarkDead(ctx context.Context, id int64, lastError string) error {
+	return r.update(ctx, id, &models.EmailOutboxSetter{
+		Status:    omit.From(domain.OutboxDead),
+		LastError: omitnull.From(lastError),
+	})
+}
+
+func (r *EmailOutboxRepository) PurgeSent(ctx context.Context, before time.Time) (int64, error) {
+	result, err := models.EmailOutboxes.Delete(
+		dm.Where(models.EmailOutboxes.Columns.Status.EQ(psql.Arg(domain.OutboxSent))),
+		dm.Where(models.EmailOutboxes.Columns.SentAt.LT(psql.Arg(before))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("delete failed: %w", err)
+	}
+	return result, nil
+}
+
+func (r *EmailOutboxRepository) update(ctx context.Context, id int64, setter *models.EmailOutboxSetter) error {
+	_, err := models.EmailOutboxes.Update(
+		setter.UpdateMod(),
+		um.Where(models.EmailOutboxes.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	return nil
+}
+
+func mapOutboxToDomain(m *models.EmailOutbox) *domain.OutboxEmail {
+	return &domain.OutboxEmail{
+		ID: m.ID,
+		Email: domain.Email{
+			To:             m.Recipient,
+			Subject:        m.Subject,
+			HTML:           m.HTMLBody,
+			Text:           m.TextBody,
+			UnsubscribeURL: m.UnsubscribeURL.GetOrZero(),
+		},
+		Status:    m.Status,
+		Attempts:  int(m.Attempts),
+		LastError: m.LastError.GetOrZero(),
+		CreatedAt: m.CreatedAt,
+	}
+}
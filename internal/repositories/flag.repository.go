@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+type FlagRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewFlagRepository(db *pgxpool.Pool) *FlagRepository {
+	return &FlagRepository{db: db}
+}
+
+func (r *FlagRepository) Create(ctx context.Context, flag *domain.Flag) (bool, error) {
+	setter := &models.FlagSetter{
+		TargetType: omit.From(flag.TargetType),
+		TargetID:   omit.From(flag.TargetID),
+		ReporterID: omit.From(flag.ReporterID),
+		Reason:     omit.From(flag.Reason),
+		Details:    omit.From(flag.Details),
+	}
+	model, err := models.Flags.Insert(
+		setter,
+		im.OnConflict("target_type", "target_id", "reporter_id").DoNothing(),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("insert failed: %w", err)
+	}
+	*flag = *mapFlagToDomain(model)
+	return true, nil
+}
+
+func (r *FlagRepository) ListForTarget(ctx context.Context, targetType string, targetID int64) ([]*domain.Flag, error) {
+	flags, err := models.Flags.Query(
+		sm.Where(models.Flags.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.Flags.Columns.TargetID.EQ(psql.Arg(targetID))),
+		sm.OrderBy(models.Flags.Columns.ID).Desc(),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	result := make([]*domain.Flag, len(flags))
+	for i, f := range flags {
+		result[i] = mapFlagToDomain(f)
+	}
+	return result, nil
+}
+
+// flaggedPostRow is a row of the queue query, with pending flags counted
+// per reason.
+type flaggedPostRow struct {
+	TargetType     string    `db:"target_type"`
+	TargetID       int64     `db:"target_id"`
+	Flags          int       `db:"flags"`
+	Spam           int       `db:"spam"`
+	Offensive      int       `db:"offensive"`
+	Duplicate      int       `db:"duplicate"`
+	LowQuality     int       `db:"low_quality"`
+	FirstFlaggedAt time.Time `db:"first_flagged_at"`
+	LastFlaggedAt  time.Time `db:"last_flagged_at"`
+}
+
+func (r *FlagRepository) Queue(ctx context.Context, reason string, limit, offset int) ([]*domain.FlaggedPost, error) {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Columns(
+			models.Flags.Columns.TargetType,
+			models.Flags.Columns.TargetID,
+			psql.Raw("count(*)").As("flags"),
+			reasonCount(domain.FlagSpam).As("spam"),
+			reasonCount(domain.FlagOffensive).As("offensive"),
+			reasonCount(domain.FlagDuplicate).As("duplicate"),
+			reasonCount(domain.FlagLowQuality).As("low_quality"),
+			psql.Raw("min(created_at)").As("first_flagged_at"),
+			psql.Raw("max(created_at)").As("last_flagged_at"),
+		),
+		sm.From(models.Flags.Name()),
+		sm.Where(models.Flags.Columns.ResolvedAt.IsNull()),
+		sm.GroupBy(models.Flags.Columns.TargetType),
+		sm.GroupBy(models.Flags.Columns.TargetID),
+		sm.OrderBy(psql.Raw("count(*)")).Desc(),
+		sm.OrderBy(psql.Raw("min(created_at)")).Asc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	}
+	if reason != "" {
+		mods = append(mods, sm.Having(reasonCount(reason).GT(psql.Arg(0))))
+	}
+
+	rows, err := bob.All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)), psql.Select(mods...), scan.StructMapper[flaggedPostRow]())
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	result := make([]*domain.FlaggedPost, len(rows))
+	for i, row := range rows {
+		reasons := map[string]int{
+			domain.FlagSpam:       row.Spam,
+			domain.FlagOffensive:  row.Offensive,
+			domain.FlagDuplicate:  row.Duplicate,
+			domain.FlagLowQuality: row.LowQuality,
+		}
+		for reason, count := range reasons {
+			if count == 0 {
+				delete(reasons, reason)
+			}
+		}
+		result[i] = &domain.FlaggedPost{
+			TargetType:     row.TargetType,
+			TargetID:       row.TargetID,
+			Flags:          row.Flags,
+			Reasons:        reasons,
+			FirstFlaggedAt: row.FirstFlaggedAt,
+			LastFlaggedAt:  row.LastFlaggedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *FlagRepository) CountQueue(ctx context.Context, reason string) (int, error) {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Columns(psql.Raw("count(DISTINCT (target_type, target_id))")),
+		sm.From(models.Flags.Name()),
+		sm.Where(models.Flags.Columns.ResolvedAt.IsNull()),
+	}
+	if reason != "" {
+		mods = append(mods, sm.Where(models.Flags.Columns.Reason.EQ(psql.Arg(reason))))
+	}
+
+	count, err := bob.One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)), psql.Select(mods...), scan.SingleColumnMapper[int])
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return count, nil
+}
+
+func reasonCount(reason string) dialect.Expression {
+	return psql.Raw("count(*) FILTER (WHERE reason = ?)", reason)
+}
+
+func mapFlagToDomain(m *models.Flag) *domain.Flag {
+	return &domain.Flag{
+		ID:         m.ID,
+		TargetType: m.TargetType,
+		TargetID:   m.TargetID,
+		ReporterID: m.ReporterID,
+		Reason:     m.Reason,
+		Details:    m.Details,
+		ActionID:   m.ActionID.GetOrZero(),
+		CreatedAt:  m.CreatedAt,
+		ResolvedAt: m.ResolvedAt.Ptr(),
+	}
+}
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type FollowRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewFollowRepository(db *pgxpool.Pool) *FollowRepository {
+	return &FollowRepository{db: db}
+}
+
+func (r *FollowRepository) Create(ctx context.Context, follow *domain.Follow) (bool, error) {
+	setter := &models.FollowSetter{
+		UserID:     omit.From(follow.UserID),
+		TargetType: omit.From(follow.Type),
+		TargetID:   omit.From(follow.ID),
+	}
+	created, err := models.Follows.Insert(
+		setter,
+		im.OnConflict("user_id", "target_type", "target_id").DoNothing(),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return false, fmt.Errorf("insert failed: %w", err)
+	}
+	return created > 0, nil
+}
+
+func (r *FollowRepository) Delete(ctx context.Context, userID int64, target domain.FollowTarget) (bool, error) {
+	deleted, err := models.Follows.Delete(
+		dm.Where(models.Follows.Columns.UserID.EQ(psql.Arg(userID))),
+		dm.Where(models.Follows.Columns.TargetType.EQ(psql.Arg(target.Type))),
+		dm.Where(models.Follows.Columns.TargetID.EQ(psql.Arg(target.ID))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return false, fmt.Errorf("delete failed: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+func (r *FollowRepository) List(ctx context.Context, userID int64, targetType string) ([]*domain.Follow, error) {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Follows.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.OrderBy(models.Follows.Columns.CreatedAt).Desc(),
+	}
+	if targetType != "" {
+		mods = append(mods, sm.Where(models.Follows.Columns.TargetType.EQ(psql.Arg(targetType))))
+	}
+
+	slice, err := models.Follows.Query(mods...).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	follows := make([]*domain.Follow, len(slice))
+	for i, m := range slice {
+		follows[i] = &domain.Follow{
+			UserID:       m.UserID,
+			FollowTarget: domain.FollowTarget{Type: m.TargetType, ID: m.TargetID},
+			CreatedAt:    m.CreatedAt,
+		}
+	}
+	return follows, nil
+}
+
+func (r *FollowRepository) Count(ctx context.Context, userID int64) (int, error) {
+	count, err := models.Follows.Query(
+		sm.Where(models.Follows.Columns.UserID.EQ(psql.Arg(userID))),
+	).Count(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(count), nil
+}
+
+func (r *FollowRepository) CountFollowers(ctx context.Context, target domain.FollowTarget) (int, error) {
+	count, err := models.Follows.Query(
+		sm.Where(models.Follows.Columns.TargetType.EQ(psql.Arg(target.Type))),
+		sm.Where(models.Follows.Columns.TargetID.EQ(psql.Arg(target.ID))),
+	).Count(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(count), nil
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type LoginAttemptRepository struct {
+	client *goredis.Client
+}
+
+func NewLoginAttemptRepository(client *goredis.Client) *LoginAttemptRepository {
+	return &LoginAttemptRepository{client: client}
+}
+
+func (r *LoginAttemptRepository) IncrementAccountFailures(ctx context.Context, email string, window time.Duration) (int64, error) {
+	return r.increment(ctx, fmt.Sprintf("login:fail:account:%s", email), window)
+}
+
+func (r *LoginAttemptRepository) IncrementIPFailures(ctx context.Context, ip string, window time.Duration) (int64, error) {
+	return r.increment(ctx, fmt.Sprintf("login:fail:ip:%s", ip), window)
+}
+
+func (r *LoginAttemptRepository) ResetAccountFailures(ctx context.Context, email string) error {
+	return r.client.Del(ctx,
+		fmt.Sprintf("login:fail:account:%s", email),
+		fmt.Sprintf("login:backoff:%s", email),
+	).Err()
+}
+
+func (r *LoginAttemptRepository) SetAccountBackoff(ctx context.Context, email string, ttl time.Duration) error {
+	return r.client.Set(ctx, fmt.Sprintf("login:backoff:%s", email), 1, ttl).Err()
+}
+
+func (r *LoginAttemptRepository) GetAccountBackoff(ctx context.Context, email string) (time.Duration, error) {
+	return r.remaining(ctx, fmt.Sprintf("login:backoff:%s", email))
+}
+
+func (r *LoginAttemptRepository) LockAccount(ctx context.Context, email string, ttl time.Duration) error {
+	return r.lock(ctx, fmt.Sprintf("login:lock:account:%s", email), ttl,
+		fmt.Sprintf("login:fail:account:%s", email),
+		fmt.Sprintf("login:backoff:%s", email),
+	)
+}
+
+func (r *LoginAttemptRepository) GetAccountLock(ctx context.Context, email string) (time.Duration, error) {
+	return r.remaining(ctx, fmt.Sprintf("login:lock:account:%s", email))
+}
+
+func (r *LoginAttemptRepository) UnlockAccount(ctx context.Context, email string) error {
+	return r.client.Del(ctx,
+		fmt.Sprintf("login:lock:account:%s", email),
+		fmt.Sprintf("login:fail:account:%s", email),
+		fmt.Sprintf("login:backoff:%s", email),
+	).Err()
+}
+
+func (r *LoginAttemptRepository) LockIP(ctx context.Context, ip string, ttl time.Duration) error {
+	return r.lock(ctx, fmt.Sprintf("login:lock:ip:%s", ip), ttl, fmt.Sprintf("login:fail:ip:%s", ip))
+}
+
+func (r *LoginAttemptRepository) GetIPLock(ctx context.Context, ip string) (time.Duration, error) {
+	return r.remaining(ctx, fmt.Sprintf("login:lock:ip:%s", ip))
+}
+
+func (r *LoginAttemptRepository) StoreUnlockToken(ctx context.Context, token, email string, ttl time.Duration) error {
+	return r.client.Set(ctx, fmt.Sprintf("login:unlock:%s", token), email, ttl).Err()
+}
+
+func (r *LoginAttemptRepository) GetUnlockToken(ctx context.Context, token string) (string, error) {
+	email, err := r.client.Get(ctx, fmt.Sprintf("login:unlock:%s", token)).Result()
+	if err == goredis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get unlock token: %w", err)
+	}
+	return email, nil
+}
+
+func (r *LoginAttemptRepository) DeleteUnlockToken(ctx context.Context, token string) error {
+	return r.client.Del(ctx, fmt.Sprintf("login:unlock:%s", token)).Err()
+}
+
+func (r *LoginAttemptRepository) increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := r.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to increment %s: %w", key, err)
+	}
+	return incr.Val(), nil
+}
+
+// lock sets key for ttl and deletes the failure counters that led to it in
+// the same transaction, so failures counted before the lock cannot add up
+// to a new one once it expires.
+func (r *LoginAttemptRepository) lock(ctx context.Context, key string, ttl time.Duration, counters ...string) error {
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, key, 1, ttl)
+	pipe.Del(ctx, counters...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set %s: %w", key, err)
+	}
+	return nil
+}
+
+// remaining returns the TTL left on key, or zero when the key does not exist.
+func (r *LoginAttemptRepository) remaining(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ttl for %s: %w", key, err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type ModerationActionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewModerationActionRepository(db *pgxpool.Pool) *ModerationActionRepository {
+	return &ModerationActionRepository{db: db}
+}
+
+// Create inserts the action and resolves the pending flags in one
+// transaction, so a flag is never left pending behind an action.
+func (r *ModerationActionRepository) Create(ctx context.Context, action *domain.ModerationAction) (int64, error) {
+	tx, err := bob.NewDB(stdlib.OpenDBFromPool(r.db)).BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	setter := &models.ModerationActionSetter{
+		TargetType: omit.From(action.TargetType),
+		TargetID:   omit.From(action.TargetID),
+		Action:     omit.From(action.Action),
+		Reason:     omit.From(action.Reason),
+	}
+	if action.ModeratorID != 0 {
+		setter.ModeratorID = omitnull.From(action.ModeratorID)
+	}
+	if action.UserID != 0 {
+		setter.UserID = omitnull.From(action.UserID)
+	}
+	model, err := models.ModerationActions.Insert(setter).One(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("insert failed: %w", uniqueViolation(err))
+	}
+
+	resolve := &models.FlagSetter{
+		ActionID:   omitnull.From(model.ID),
+		ResolvedAt: omitnull.From(time.Now()),
+	}
+	resolved, err := models.Flags.Update(
+		resolve.UpdateMod(),
+		um.Where(models.Flags.Columns.TargetType.EQ(psql.Arg(action.TargetType))),
+		um.Where(models.Flags.Columns.TargetID.EQ(psql.Arg(action.TargetID))),
+		um.Where(models.Flags.Columns.ResolvedAt.IsNull()),
+	).Exec(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("update failed: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	action.ID = model.ID
+	action.CreatedAt = model.CreatedAt
+	return resolved, nil
+}
+
+func (r *ModerationActionRepository) ListForTarget(ctx context.Context, targetType string, targetID int64) ([]*domain.ModerationAction, error) {
+	actions, err := models.ModerationActions.Query(
+		sm.Where(models.ModerationActions.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.ModerationActions.Columns.TargetID.EQ(psql.Arg(targetID))),
+		sm.OrderBy(models.ModerationActions.Columns.ID).Desc(),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	result := make([]*domain.ModerationAction, len(actions))
+	for i, a := range actions {
+		result[i] = mapModerationActionToDomain(a)
+	}
+	return result, nil
+}
+
+func (r *ModerationActionRepository) Exists(ctx context.Context, targetType string, targetID int64, action string) (bool, error) {
+	exists, err := models.ModerationActions.Query(
+		sm.Where(models.ModerationActions.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.ModerationActions.Columns.TargetID.EQ(psql.Arg(targetID))),
+		sm.Where(models.ModerationActions.Columns.Action.EQ(psql.Arg(action))),
+	).Exists(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return false, fmt.Errorf("database error: %w", err)
+	}
+	return exists, nil
+}
+
+func mapModerationActionToDomain(m *models.ModerationAction) *domain.ModerationAction {
+	return &domain.ModerationAction{
+		ID:          m.ID,
+		TargetType:  m.TargetType,
+		TargetID:    m.TargetID,
+		ModeratorID: m.ModeratorID.GetOrZero(),
+		Action:      m.Action,
+		Reason:      m.Reason,
+		UserID:      m.UserID.GetOrZero(),
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const notificationChannel = "notifications"
+
+// NotificationBroker publishes notifications on a single Redis channel; every
+// instance listens once and routes messages to its own connected streams.
+type NotificationBroker struct {
+	client *goredis.Client
+}
+
+func NewNotificationBroker(client *goredis.Client) *NotificationBroker {
+	return &NotificationBroker{client: client}
+}
+
+// notificationMessage carries the recipient, which is not part of the
+// notification's JSON.
+type notificationMessage struct {
+	UserID       int64                `json:"user_id"`
+	Notification *domain.Notification `json:"notification"`
+}
+
+func (b *NotificationBroker) Publish(ctx context.Context, n *domain.Notification) error {
+	data, err := json.Marshal(notificationMessage{UserID: n.UserID, Notification: n})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+	if err := b.client.Publish(ctx, notificationChannel, data).Err(); err != nil {
+		return fmt.Errorf("failed to publish notification: %w", err)
+	}
+	return nil
+}
+
+func (b *NotificationBroker) Listen(ctx context.Context, handle func(*domain.Notification)) error {
+	pubsub := b.client.Subscribe(ctx, notificationChannel)
+	defer pubsub.Close()
+
+	// Receive the subscription confirmation so a failure is reported here
+	// instead of silently delivering nothing.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to notifications: %w", err)
+	}
+
+	for {
+		msg, err := pubsub.ReceiveMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("notification subscription failed: %w", err)
+		}
+
+		var message notificationMessage
+		if err := json.Unmarshal([]byte(msg.Payload), &message); err != nil || message.Notification == nil {
+			continue
+		}
+		message.Notification.UserID = message.UserID
+		handle(message.Notification)
+	}
+}
@@ -0,0 +1,197 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type NotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNotificationRepository(db *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// Create inserts all notifications in one statement and fills in their IDs.
+func (r *NotificationRepository) Create(ctx context.Context, notifications []*domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	setters := make([]bob.Mod[*dialect.InsertQuery], len(notifications))
+	for i, n := range notifications {
+		setter := &models.NotificationSetter{
+			UserID:  omit.From(n.UserID),
+			Type:    omit.From(n.Type),
+			Excerpt: omit.From(n.Excerpt),
+		}
+		if n.ActorID != 0 {
+			setter.ActorID = omitnull.From(n.ActorID)
+		}
+		if n.TargetType != "" {
+			setter.TargetType = omitnull.From(n.TargetType)
+			setter.TargetID = omitnull.From(n.TargetID)
+		}
+		if n.Milestone != 0 {
+			setter.Milestone = omitnull.From(int32(n.Milestone))
+		}
+		setters[i] = setter
+	}
+
+	slice, err := models.Notifications.Insert(setters...).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("insert failed: %w", err)
+	}
+	for i, m := range slice {
+		notifications[i].ID = m.ID
+		notifications[i].CreatedAt = m.CreatedAt
+	}
+	return nil
+}
+
+func (r *NotificationRepository) List(ctx context.Context, userID, beforeID int64, limit int, unreadOnly bool) ([]*domain.Notification, error) {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Notifications.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.OrderBy(models.Notifications.Columns.ID).Desc(),
+		sm.Limit(limit),
+	}
+	if beforeID != 0 {
+		mods = append(mods, sm.Where(models.Notifications.Columns.ID.LT(psql.Arg(beforeID))))
+	}
+	if unreadOnly {
+		mods = append(mods, sm.Where(models.Notifications.Columns.ReadAt.IsNull()))
+	}
+
+	slice, err := models.Notifications.Query(mods...).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapNotifications(slice), nil
+}
+
+func (r *NotificationRepository) ListAfter(ctx context.Context, userID, afterID int64, limit int) ([]*domain.Notification, error) {
+	slice, err := models.Notifications.Query(
+		sm.Where(models.Notifications.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Notifications.Columns.ID.GT(psql.Arg(afterID))),
+		sm.OrderBy(models.Notifications.Columns.ID),
+		sm.Limit(limit),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapNotifications(slice), nil
+}
+
+func (r *NotificationRepository) ListUnemailed(ctx context.Context, userID int64, types []string, since time.Time, limit int) ([]*domain.Notification, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+	typeArgs := make([]bob.Expression, len(types))
+	for i, t := range types {
+		typeArgs[i] = psql.Arg(t)
+	}
+
+	slice, err := models.Notifications.Query(
+		sm.Where(models.Notifications.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Notifications.Columns.Type.In(typeArgs...)),
+		sm.Where(models.Notifications.Columns.EmailedAt.IsNull()),
+		sm.Where(models.Notifications.Columns.CreatedAt.GTE(psql.Arg(since))),
+		sm.OrderBy(models.Notifications.Columns.ID),
+		sm.Limit(limit),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapNotifications(slice), nil
+}
+
+func (r *NotificationRepository) MarkEmailed(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	setter := &models.NotificationSetter{EmailedAt: omitnull.From(time.Now())}
+	_, err := models.Notifications.Update(
+		setter.UpdateMod(),
+		um.Where(models.Notifications.Columns.ID.In(idArgs(ids)...)),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID int64) (int, error) {
+	count, err := models.Notifications.Query(
+		sm.Where(models.Notifications.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Notifications.Columns.ReadAt.IsNull()),
+	).Count(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(count), nil
+}
+
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return r.markRead(ctx, userID, um.Where(models.Notifications.Columns.ID.In(idArgs(ids)...)))
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	return r.markRead(ctx, userID)
+}
+
+func (r *NotificationRepository) markRead(ctx context.Context, userID int64, mods ...bob.Mod[*dialect.UpdateQuery]) (int64, error) {
+	setter := &models.NotificationSetter{ReadAt: omitnull.From(time.Now())}
+	mods = append(mods,
+		setter.UpdateMod(),
+		um.Where(models.Notifications.Columns.UserID.EQ(psql.Arg(userID))),
+		um.Where(models.Notifications.Columns.ReadAt.IsNull()),
+	)
+	updated, err := models.Notifications.Update(mods...).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("update failed: %w", err)
+	}
+	return updated, nil
+}
+
+func mapNotifications(slice models.NotificationSlice) []*domain.Notification {
+	notifications := make([]*domain.Notification, len(slice))
+	for i, m := range slice {
+		notifications[i] = mapNotificationToDomain(m)
+	}
+	return notifications
+}
+
+func mapNotificationToDomain(m *models.Notification) *domain.Notification {
+	n := &domain.Notification{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		Type:       m.Type,
+		ActorID:    m.ActorID.GetOrZero(),
+		TargetType: m.TargetType.GetOrZero(),
+		TargetID:   m.TargetID.GetOrZero(),
+		Excerpt:    m.Excerpt,
+		Milestone:  int(m.Milestone.GetOrZero()),
+		CreatedAt:  m.CreatedAt,
+	}
+	if readAt, ok := m.ReadAt.Get(); ok {
+		n.ReadAt = &readAt
+	}
+	return n
+}
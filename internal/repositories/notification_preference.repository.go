@@ -0,0 +1,144 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type NotificationPreferenceRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNotificationPreferenceRepository(db *pgxpool.Pool) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{db: db}
+}
+
+func (r *NotificationPreferenceRepository) Get(ctx context.Context, userID int64) (domain.NotificationPreferences, error) {
+	slice, err := models.NotificationPreferences.Query(
+		sm.Where(models.NotificationPreferences.Columns.UserID.EQ(psql.Arg(userID))),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	prefs := make(domain.NotificationPreferences, len(slice))
+	for _, m := range slice {
+		prefs[m.Type] = m.Delivery
+	}
+	return prefs, nil
+}
+
+func (r *NotificationPreferenceRepository) Set(ctx context.Context, userID int64, prefs domain.NotificationPreferences) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+
+	mods := make([]bob.Mod[*dialect.InsertQuery], 0, len(prefs)+1)
+	for notificationType, delivery := range prefs {
+		mods = append(mods, &models.NotificationPreferenceSetter{
+			UserID:   omit.From(userID),
+			Type:     omit.From(notificationType),
+			Delivery: omit.From(delivery),
+		})
+	}
+	mods = append(mods, im.OnConflict("user_id", "type").DoUpdate(im.SetExcluded("delivery")))
+
+	if _, err := models.NotificationPreferences.Insert(mods...).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db))); err != nil {
+		return fmt.Errorf("insert failed: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationPreferenceRepository) UsersWithDelivery(ctx context.Context, delivery string) ([]int64, error) {
+	slice, err := models.NotificationPreferences.Query(
+		sm.Where(models.NotificationPreferences.Columns.Delivery.EQ(psql.Arg(delivery))),
+		sm.OrderBy(models.NotificationPreferences.Columns.UserID),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	var userIDs []int64
+	for _, m := range slice {
+		if len(userIDs) == 0 || userIDs[len(userIDs)-1] != m.UserID {
+			userIDs = append(userIDs, m.UserID)
+		}
+	}
+	return userIDs, nil
+}
+
+// ClaimDigest relies on the conditional upsert touching no row when another
+// instance already recorded the digest.
+func (r *NotificationPreferenceRepository) ClaimDigest(ctx context.Context, userID int64, cadence string, since time.Time) (bool, error) {
+	claimed, err := models.NotificationDigests.Insert(
+		&models.NotificationDigestSetter{
+			UserID:  omit.From(userID),
+			Cadence: omit.From(cadence),
+			SentAt:  omit.From(time.Now()),
+		},
+		im.OnConflict("user_id", "cadence").DoUpdate(
+			im.SetExcluded("sent_at"),
+			im.Where(models.NotificationDigests.Columns.SentAt.LT(psql.Arg(since))),
+		),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return false, fmt.Errorf("insert failed: %w", err)
+	}
+	return claimed > 0, nil
+}
+
+func (r *NotificationPreferenceRepository) ReleaseDigest(ctx context.Context, userID int64, cadence string, since time.Time) error {
+	_, err := models.NotificationDigests.Delete(
+		dm.Where(models.NotificationDigests.Columns.UserID.EQ(psql.Arg(userID))),
+		dm.Where(models.NotificationDigests.Columns.Cadence.EQ(psql.Arg(cadence))),
+		dm.Where(models.NotificationDigests.Columns.SentAt.GTE(psql.Arg(since))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationPreferenceRepository) UnsubscribeVersion(ctx context.Context, userID int64) (int, error) {
+	m, err := models.UnsubscribeVersions.Query(
+		sm.Where(models.UnsubscribeVersions.Columns.UserID.EQ(psql.Arg(userID))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(m.Version), nil
+}
+
+func (r *NotificationPreferenceRepository) BumpUnsubscribeVersion(ctx context.Context, userID int64) (int, error) {
+	m, err := models.UnsubscribeVersions.Insert(
+		&models.UnsubscribeVersionSetter{
+			UserID:  omit.From(userID),
+			Version: omit.From(int32(1)),
+		},
+		im.OnConflict("user_id").DoUpdate(
+			im.SetCol("version").To(psql.Raw("unsubscribe_versions.version + 1")),
+		),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("insert failed: %w", err)
+	}
+	return int(m.Version), nil
+}
@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// PermissionCache stores each role's permissions as a JSON array, so roles
+// without permissions are cached too.
+type PermissionCache struct {
+	client *goredis.Client
+}
+
+func NewPermissionCache(client *goredis.Client) *PermissionCache {
+	return &PermissionCache{client: client}
+}
+
+func (c *PermissionCache) Get(ctx context.Context, role string) ([]string, bool, error) {
+	data, err := c.client.Get(ctx, permissionKey(role)).Result()
+	if err == goredis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get permissions: %w", err)
+	}
+
+	var permissions []string
+	if err := json.Unmarshal([]byte(data), &permissions); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal permissions: %w", err)
+	}
+	return permissions, true, nil
+}
+
+func (c *PermissionCache) Set(ctx context.Context, role string, permissions []string, ttl time.Duration) error {
+	if permissions == nil {
+		permissions = []string{}
+	}
+	data, err := json.Marshal(permissions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal permissions: %w", err)
+	}
+	return c.client.Set(ctx, permissionKey(role), data, ttl).Err()
+}
+
+func (c *PermissionCache) Delete(ctx context.Context, role string) error {
+	return c.client.Del(ctx, permissionKey(role)).Err()
+}
+
+func permissionKey(role string) string {
+	return fmt.Sprintf("permissions:role:%s", role)
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/RofaBR/Go-Usof/internal/models/enums"
+	"github.com/aarondl/opt/omit"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type PermissionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPermissionRepository(db *pgxpool.Pool) *PermissionRepository {
+	return &PermissionRepository{db: db}
+}
+
+func (r *PermissionRepository) List(ctx context.Context) ([]*domain.Permission, error) {
+	permissions, err := models.Permissions.Query(
+		sm.OrderBy(models.Permissions.Columns.Name),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	result := make([]*domain.Permission, len(permissions))
+	for i, p := range permissions {
+		result[i] = &domain.Permission{Name: p.Name, Description: p.Description}
+	}
+	return result, nil
+}
+
+func (r *PermissionRepository) ForRole(ctx context.Context, role string) ([]string, error) {
+	rows, err := models.RolePermissions.Query(
+		sm.Where(models.RolePermissions.Columns.Role.EQ(psql.Arg(enums.UserRole(role)))),
+		sm.OrderBy(models.RolePermissions.Columns.Permission),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	result := make([]string, len(rows))
+	for i, row := range rows {
+		result[i] = row.Permission
+	}
+	return result, nil
+}
+
+// SetForRole deletes and re-inserts role's permissions in one transaction.
+func (r *PermissionRepository) SetForRole(ctx context.Context, role string, permissions []string) error {
+	tx, err := bob.NewDB(stdlib.OpenDBFromPool(r.db)).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = models.RolePermissions.Delete(
+		dm.Where(models.RolePermissions.Columns.Role.EQ(psql.Arg(enums.UserRole(role)))),
+	).Exec(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+
+	if len(permissions) > 0 {
+		mods := make([]bob.Mod[*dialect.InsertQuery], len(permissions))
+		for i, permission := range permissions {
+			mods[i] = &models.RolePermissionSetter{
+				Role:       omit.From(enums.UserRole(role)),
+				Permission: omit.From(permission),
+			}
+		}
+		if _, err := models.RolePermissions.Insert(mods...).Exec(ctx, tx); err != nil {
+			return fmt.Errorf("insert failed: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type PostRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostRepository(db *pgxpool.Pool) *PostRepository {
+	return &PostRepository{db: db}
+}
+
+func (r *PostRepository) CreateQuestion(ctx context.Context, question *domain.Question) error {
+	setter := &models.QuestionSetter{}
+	if question.AuthorID != 0 {
+		setter.AuthorID = omitnull.From(question.AuthorID)
+	}
+
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	return db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		model, err := models.Questions.Insert(setter).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("insert failed: %w", err)
+		}
+
+		if len(question.CategoryIDs) > 0 {
+			mods := make([]bob.Mod[*dialect.InsertQuery], len(question.CategoryIDs))
+			for i, id := range question.CategoryIDs {
+				mods[i] = &models.QuestionCategorySetter{
+					QuestionID: omit.From(model.ID),
+					CategoryID: omit.From(int32(id)),
+				}
+			}
+			if _, err := models.QuestionCategories.Insert(mods...).Exec(ctx, exec); err != nil {
+				return fmt.Errorf("insert failed: %w", err)
+			}
+		}
+
+		question.ID = model.ID
+		question.CreatedAt = model.CreatedAt
+		return nil
+	})
+}
+
+func (r *PostRepository) GetQuestion(ctx context.Context, id int64) (*domain.Question, error) {
+	exec := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	model, err := models.Questions.Query(
+		sm.Where(models.Questions.Columns.ID.EQ(psql.Arg(id))),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	questions := []*domain.Question{mapQuestionToDomain(model)}
+	if err := r.loadCategories(ctx, exec, questions); err != nil {
+		return nil, err
+	}
+	return questions[0], nil
+}
+
+func (r *PostRepository) ListQuestions(ctx context.Context, categoryID int64, limit, offset int) ([]*domain.Question, error) {
+	exec := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	mods := append(questionFilter(categoryID),
+		sm.OrderBy(models.Questions.Columns.ID).Desc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	)
+	slice, err := models.Questions.Query(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	questions := make([]*domain.Question, len(slice))
+	for i, m := range slice {
+		questions[i] = mapQuestionToDomain(m)
+	}
+	if err := r.loadCategories(ctx, exec, questions); err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
+
+func (r *PostRepository) CountQuestions(ctx context.Context, categoryID int64) (int, error) {
+	count, err := models.Questions.Query(questionFilter(categoryID)...).Count(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(count), nil
+}
+
+// questionFilter leaves out deleted questions and limits the rest to
+// categoryID unless it is 0.
+func questionFilter(categoryID int64) []bob.Mod[*dialect.SelectQuery] {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		notDeleted(domain.PostQuestion, models.Questions.Columns.ID),
+	}
+	if categoryID == 0 {
+		return mods
+	}
+	inCategory := psql.Select(
+		sm.Columns(models.QuestionCategories.Columns.QuestionID),
+		sm.From(models.QuestionCategories.Name()),
+		sm.Where(models.QuestionCategories.Columns.CategoryID.EQ(psql.Arg(categoryID))),
+	)
+	return append(mods, sm.Where(models.Questions.Columns.ID.OP("IN", inCategory)))
+}
+
+// notDeleted leaves out the posts of targetType a moderator deleted; id is
+// the column holding the post's ID.
+func notDeleted(targetType string, id psql.Expression) bob.Mod[*dialect.SelectQuery] {
+	deleted := psql.Select(
+		sm.Columns(models.ModerationActions.Columns.TargetID),
+		sm.From(models.ModerationActions.Name()),
+		sm.Where(models.ModerationActions.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.ModerationActions.Columns.Action.EQ(psql.Arg(domain.ModerationDelete))),
+	)
+	return sm.Where(id.OP("NOT IN", deleted))
+}
+
+// loadCategories fills in the category IDs of questions with one query.
+func (r *PostRepository) loadCategories(ctx context.Context, exec bob.Executor, questions []*domain.Question) error {
+	if len(questions) == 0 {
+		return nil
+	}
+	byID := make(map[int64]*domain.Question, len(questions))
+	ids := make([]int64, len(questions))
+	for i, q := range questions {
+		byID[q.ID] = q
+		ids[i] = q.ID
+	}
+
+	links, err := models.QuestionCategories.Query(
+		sm.Where(models.QuestionCategories.Columns.QuestionID.In(idArgs(ids)...)),
+		sm.OrderBy(models.QuestionCategories.Columns.CategoryID),
+	).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	for _, link := range links {
+		q := byID[link.QuestionID]
+		q.CategoryIDs = append(q.CategoryIDs, int64(link.CategoryID))
+	}
+	return nil
+}
+
+func (r *PostRepository) SetAccepted(ctx context.Context, questionID, answerID int64) error {
+	setter := &models.QuestionSetter{AcceptedAnswerID: omitnull.From(answerID)}
+	if answerID == 0 {
+		setter.AcceptedAnswerID = omitnull.FromPtr[int64](nil)
+	}
+	rowsAffected, err := models.Questions.Update(
+		setter.UpdateMod(),
+		um.Where(models.Questions.Columns.ID.EQ(psql.Arg(questionID))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("question with ID %d not found", questionID)
+	}
+	return nil
+}
+
+func (r *PostRepository) DeleteQuestion(ctx context.Context, id int64) error {
+	rowsAffected, err := models.Questions.Delete(
+		dm.Where(models.Questions.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("question with ID %d not found", id)
+	}
+	return nil
+}
+
+func (r *PostRepository) CreateAnswer(ctx context.Context, answer *domain.Answer) error {
+	setter := &models.AnswerSetter{
+		QuestionID: omit.From(answer.QuestionID),
+	}
+	if answer.AuthorID != 0 {
+		setter.AuthorID = omitnull.From(answer.AuthorID)
+	}
+
+	model, err := models.Answers.Insert(setter).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("insert failed: %w", err)
+	}
+	answer.ID = model.ID
+	answer.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *PostRepository) GetAnswer(ctx context.Context, id int64) (*domain.Answer, error) {
+	model, err := models.Answers.Query(
+		sm.Where(models.Answers.Columns.ID.EQ(psql.Arg(id))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapAnswerToDomain(model), nil
+}
+
+func (r *PostRepository) ListAnswers(ctx context.Context, questionID int64) ([]*domain.Answer, error) {
+	slice, err := models.Answers.Query(
+		sm.Where(models.Answers.Columns.QuestionID.EQ(psql.Arg(questionID))),
+		notDeleted(domain.PostAnswer, models.Answers.Columns.ID),
+		sm.OrderBy(models.Answers.Columns.ID),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	answers := make([]*domain.Answer, len(slice))
+	for i, m := range slice {
+		answers[i] = mapAnswerToDomain(m)
+	}
+	return answers, nil
+}
+
+func (r *PostRepository) DeleteAnswer(ctx context.Context, id int64) error {
+	rowsAffected, err := models.Answers.Delete(
+		dm.Where(models.Answers.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("answer with ID %d not found", id)
+	}
+	return nil
+}
+
+func idArgs(ids []int64) []bob.Expression {
+	args := make([]bob.Expression, len(ids))
+	for i, id := range ids {
+		args[i] = psql.Arg(id)
+	}
+	return args
+}
+
+func mapQuestionToDomain(m *models.Question) *domain.Question {
+	return &domain.Question{
+		ID:               m.ID,
+		AuthorID:         m.AuthorID.GetOr(0),
+		AcceptedAnswerID: m.AcceptedAnswerID.GetOr(0),
+		CreatedAt:        m.CreatedAt,
+	}
+}
+
+func mapAnswerToDomain(m *models.Answer) *domain.Answer {
+	return &domain.Answer{
+		ID:         m.ID,
+		QuestionID: m.QuestionID,
+		AuthorID:   m.AuthorID.GetOr(0),
+		CreatedAt:  m.CreatedAt,
+	}
+}
@@ -1,21 +1,72 @@
 package repositories
 
 import (
+	"errors"
+
 	"github.com/RofaBR/Go-Usof/internal/domain"
 	"github.com/RofaBR/Go-Usof/internal/storage/postgres"
 	"github.com/RofaBR/Go-Usof/internal/storage/redis"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 type Repository struct {
-	User     domain.UserRepository
-	Token    domain.TokenRepository
-	Category domain.CategoryRepository
+	User         domain.UserRepository
+	Token        domain.TokenRepository
+	Category     domain.CategoryRepository
+	LoginAttempt domain.LoginAttemptRepository
+	Attachment   domain.AttachmentRepository
+	EmailOutbox  domain.EmailOutboxRepository
+	Notification domain.NotificationRepository
+	Follow       domain.FollowRepository
+	Activity     domain.ActivityRepository
+	Bookmark     domain.BookmarkRepository
+	Revision     domain.RevisionRepository
+	Flag         domain.FlagRepository
+	Permission   domain.PermissionRepository
+
+	NotificationPreference domain.NotificationPreferenceRepository
+	NotificationBroker     domain.NotificationBroker
+	BookmarkCollection     domain.BookmarkCollectionRepository
+	ModerationAction       domain.ModerationActionRepository
+	PermissionCache        domain.PermissionCache
+	Post                   domain.PostRepository
+	Comment                domain.CommentRepository
+	Vote                   domain.VoteRepository
 }
 
 func NewRepository(db *postgres.Postgres, rdb *redis.Redis) *Repository {
 	return &Repository{
-		User:     NewUserRepository(db.Pool),
-		Token:    NewTokenRepository(rdb.Client),
-		Category: NewCategoryRepository(db.Pool),
+		User:         NewUserRepository(db.Pool),
+		Token:        NewTokenRepository(rdb.Client),
+		Category:     NewCategoryRepository(db.Pool),
+		LoginAttempt: NewLoginAttemptRepository(rdb.Client),
+		Attachment:   NewAttachmentRepository(db.Pool),
+		EmailOutbox:  NewEmailOutboxRepository(db.Pool),
+		Notification: NewNotificationRepository(db.Pool),
+		Follow:       NewFollowRepository(db.Pool),
+		Activity:     NewActivityRepository(db.Pool),
+		Bookmark:     NewBookmarkRepository(db.Pool),
+		Revision:     NewRevisionRepository(db.Pool),
+		Flag:         NewFlagRepository(db.Pool),
+		Permission:   NewPermissionRepository(db.Pool),
+
+		NotificationPreference: NewNotificationPreferenceRepository(db.Pool),
+		NotificationBroker:     NewNotificationBroker(rdb.Client),
+		BookmarkCollection:     NewBookmarkCollectionRepository(db.Pool),
+		ModerationAction:       NewModerationActionRepository(db.Pool),
+		PermissionCache:        NewPermissionCache(rdb.Client),
+		Post:                   NewPostRepository(db.Pool),
+		Comment:                NewCommentRepository(db.Pool),
+		Vote:                   NewVoteRepository(db.Pool),
+	}
+}
+
+// uniqueViolation turns a PostgreSQL unique_violation into a
+// *domain.UniqueViolationError and returns any other error unchanged.
+func uniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return &domain.UniqueViolationError{Constraint: pgErr.ConstraintName}
 	}
+	return err
 }
@@ -0,0 +1,188 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type RevisionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRevisionRepository(db *pgxpool.Pool) *RevisionRepository {
+	return &RevisionRepository{db: db}
+}
+
+func (r *RevisionRepository) Create(ctx context.Context, revision *domain.Revision) (bool, error) {
+	created := false
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		_, err := psql.RawQuery(
+			"SELECT pg_advisory_xact_lock(hashtextextended(? || ':' || ?::text, 0))",
+			revision.TargetType, revision.TargetID,
+		).Exec(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to lock post: %w", err)
+		}
+
+		latest, err := latestRevision(ctx, exec, revision.TargetType, revision.TargetID)
+		if err != nil {
+			return err
+		}
+		if latest != nil && latest.Title == revision.Title && latest.Body == revision.Body {
+			*revision = *latest
+			return nil
+		}
+		revision.Number = 1
+		if latest != nil {
+			revision.Number = latest.Number + 1
+		}
+
+		setter := &models.PostRevisionSetter{
+			TargetType:    omit.From(revision.TargetType),
+			TargetID:      omit.From(revision.TargetID),
+			Revision:      omit.From(int32(revision.Number)),
+			Body:          omit.From(revision.Body),
+			Summary:       omit.From(revision.Summary),
+			BodyHTML:      omit.From(revision.BodyHTML),
+			RenderVersion: omit.From(int32(revision.RenderVersion)),
+		}
+		if revision.AuthorID != 0 {
+			setter.AuthorID = omitnull.From(revision.AuthorID)
+		}
+		if revision.Title != "" {
+			setter.Title = omitnull.From(revision.Title)
+		}
+		if revision.RollbackOf != 0 {
+			setter.RollbackOf = omitnull.From(int32(revision.RollbackOf))
+		}
+
+		model, err := models.PostRevisions.Insert(setter).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("insert failed: %w", uniqueViolation(err))
+		}
+		revision.ID = model.ID
+		revision.CreatedAt = model.CreatedAt
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+func (r *RevisionRepository) Get(ctx context.Context, targetType string, targetID int64, number int) (*domain.Revision, error) {
+	model, err := models.PostRevisions.Query(
+		sm.Where(models.PostRevisions.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.PostRevisions.Columns.TargetID.EQ(psql.Arg(targetID))),
+		sm.Where(models.PostRevisions.Columns.Revision.EQ(psql.Arg(number))),
+	).One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapRevisionToDomain(model), nil
+}
+
+func (r *RevisionRepository) Latest(ctx context.Context, targetType string, targetID int64) (*domain.Revision, error) {
+	return latestRevision(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)), targetType, targetID)
+}
+
+func latestRevision(ctx context.Context, exec bob.Executor, targetType string, targetID int64) (*domain.Revision, error) {
+	model, err := models.PostRevisions.Query(
+		sm.Where(models.PostRevisions.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.PostRevisions.Columns.TargetID.EQ(psql.Arg(targetID))),
+		sm.OrderBy(models.PostRevisions.Columns.Revision).Desc(),
+		sm.Limit(1),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapRevisionToDomain(model), nil
+}
+
+func (r *RevisionRepository) LatestOf(ctx context.Context, targetType string, targetIDs []int64) ([]*domain.Revision, error) {
+	if len(targetIDs) == 0 {
+		return []*domain.Revision{}, nil
+	}
+	slice, err := models.PostRevisions.Query(
+		sm.Distinct(models.PostRevisions.Columns.TargetID),
+		sm.Where(models.PostRevisions.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.PostRevisions.Columns.TargetID.In(idArgs(targetIDs)...)),
+		sm.OrderBy(models.PostRevisions.Columns.TargetID),
+		sm.OrderBy(models.PostRevisions.Columns.Revision).Desc(),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	revisions := make([]*domain.Revision, len(slice))
+	for i, m := range slice {
+		revisions[i] = mapRevisionToDomain(m)
+	}
+	return revisions, nil
+}
+
+func (r *RevisionRepository) List(ctx context.Context, targetType string, targetID int64) ([]*domain.Revision, error) {
+	slice, err := models.PostRevisions.Query(
+		sm.Where(models.PostRevisions.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.PostRevisions.Columns.TargetID.EQ(psql.Arg(targetID))),
+		sm.OrderBy(models.PostRevisions.Columns.Revision).Desc(),
+	).All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	revisions := make([]*domain.Revision, len(slice))
+	for i, m := range slice {
+		revisions[i] = mapRevisionToDomain(m)
+	}
+	return revisions, nil
+}
+
+func (r *RevisionRepository) UpdateRendered(ctx context.Context, id int64, html string, version int) error {
+	setter := &models.PostRevisionSetter{
+		BodyHTML:      omit.From(html),
+		RenderVersion: omit.From(int32(version)),
+	}
+	_, err := models.PostRevisions.Update(
+		setter.UpdateMod(),
+		um.Where(models.PostRevisions.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	return nil
+}
+
+func mapRevisionToDomain(m *models.PostRevision) *domain.Revision {
+	return &domain.Revision{
+		ID:            m.ID,
+		TargetType:    m.TargetType,
+		TargetID:      m.TargetID,
+		Number:        int(m.Revision),
+		AuthorID:      m.AuthorID.GetOr(0),
+		Title:         m.Title.GetOrZero(),
+		Body:          m.Body,
+		Summary:       m.Summary,
+		RollbackOf:    int(m.RollbackOf.GetOr(0)),
+		CreatedAt:     m.CreatedAt,
+		BodyHTML:      m.BodyHTML,
+		RenderVersion: int(m.RenderVersion),
+	}
+}
@@ -66,6 +66,28 @@ func (t *TokenRepository) DeleteRefreshToken(ctx context.Context, jti string) er
 	return nil
 }
 
+// DeleteUserRefreshTokens removes every refresh token of the user except
+// exceptJTI, which may be empty to remove them all.
+func (t *TokenRepository) DeleteUserRefreshTokens(ctx context.Context, userID, exceptJTI string) error {
+	pattern := fmt.Sprintf("refresh:%s:*", userID)
+	keys, err := t.client.Keys(ctx, pattern).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get refresh token keys: %w", err)
+	}
+
+	keep := fmt.Sprintf("refresh:%s:%s", userID, exceptJTI)
+	toDelete := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key != keep {
+			toDelete = append(toDelete, key)
+		}
+	}
+	if len(toDelete) > 0 {
+		return t.client.Del(ctx, toDelete...).Err()
+	}
+	return nil
+}
+
 func (t *TokenRepository) ExtendRefreshTokenTTL(ctx context.Context, jti string, ttl time.Duration) error {
 	pattern := fmt.Sprintf("refresh:*:%s", jti)
 	keys, err := t.client.Keys(ctx, pattern).Result()
@@ -112,3 +134,118 @@ func (t *TokenRepository) DeleteVerificationToken(ctx context.Context, token str
 	key := fmt.Sprintf("verification:%s", token)
 	return t.client.Del(ctx, key).Err()
 }
+
+func (t *TokenRepository) StorePasswordResetToken(ctx context.Context, metadata *domain.PasswordResetTokenMetadata, ttl time.Duration) error {
+	return t.storeUserToken(ctx, "password_reset", metadata.UserID, metadata.Token, metadata, ttl)
+}
+
+func (t *TokenRepository) GetPasswordResetToken(ctx context.Context, token string) (*domain.PasswordResetTokenMetadata, error) {
+	data, err := t.client.Get(ctx, fmt.Sprintf("password_reset:%s", token)).Result()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	var metadata domain.PasswordResetTokenMetadata
+	if err := json.Unmarshal([]byte(data), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal password reset token metadata: %w", err)
+	}
+	return &metadata, nil
+}
+
+func (t *TokenRepository) ConsumePasswordResetToken(ctx context.Context, token string) (*domain.PasswordResetTokenMetadata, error) {
+	var metadata domain.PasswordResetTokenMetadata
+	found, err := t.consumeUserToken(ctx, "password_reset", token, &metadata, func() int64 { return metadata.UserID })
+	if err != nil || !found {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (t *TokenRepository) StoreEmailChangeToken(ctx context.Context, metadata *domain.EmailChangeTokenMetadata, ttl time.Duration) error {
+	return t.storeUserToken(ctx, "email_change", metadata.UserID, metadata.Token, metadata, ttl)
+}
+
+func (t *TokenRepository) ConsumeEmailChangeToken(ctx context.Context, token string) (*domain.EmailChangeTokenMetadata, error) {
+	var metadata domain.EmailChangeTokenMetadata
+	found, err := t.consumeUserToken(ctx, "email_change", token, &metadata, func() int64 { return metadata.UserID })
+	if err != nil || !found {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (t *TokenRepository) StoreStreamTicket(ctx context.Context, ticket string, claims *domain.TokenClaims, ttl time.Duration) error {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return fmt.Errorf("failed to marshal stream ticket claims: %w", err)
+	}
+	return t.client.Set(ctx, fmt.Sprintf("stream_ticket:%s", ticket), data, ttl).Err()
+}
+
+func (t *TokenRepository) ConsumeStreamTicket(ctx context.Context, ticket string) (*domain.TokenClaims, error) {
+	data, err := t.client.GetDel(ctx, fmt.Sprintf("stream_ticket:%s", ticket)).Result()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume stream ticket: %w", err)
+	}
+
+	var claims domain.TokenClaims
+	if err := json.Unmarshal([]byte(data), &claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stream ticket claims: %w", err)
+	}
+	return &claims, nil
+}
+
+// storeUserToken stores metadata under <prefix>:<token> and keeps
+// <prefix>:user:<id> pointing at it, deleting the token it pointed at
+// before, so each user has at most one live token of the kind.
+func (t *TokenRepository) storeUserToken(ctx context.Context, prefix string, userID int64, token string, metadata any, ttl time.Duration) error {
+	userKey := fmt.Sprintf("%s:user:%d", prefix, userID)
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s token metadata: %w", prefix, err)
+	}
+
+	previous, err := t.client.Get(ctx, userKey).Result()
+	if err != nil && err != goredis.Nil {
+		return fmt.Errorf("failed to get previous %s token: %w", prefix, err)
+	}
+
+	_, err = t.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		if previous != "" {
+			pipe.Del(ctx, fmt.Sprintf("%s:%s", prefix, previous))
+		}
+		pipe.Set(ctx, fmt.Sprintf("%s:%s", prefix, token), data, ttl)
+		pipe.Set(ctx, userKey, token, ttl)
+		return nil
+	})
+	return err
+}
+
+// consumeUserToken reads and deletes <prefix>:<token> in one step, so a token
+// can only be used once, and unmarshals it into metadata.
+func (t *TokenRepository) consumeUserToken(ctx context.Context, prefix, token string, metadata any, userID func() int64) (bool, error) {
+	data, err := t.client.GetDel(ctx, fmt.Sprintf("%s:%s", prefix, token)).Result()
+	if err == goredis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to consume %s token: %w", prefix, err)
+	}
+	if err := json.Unmarshal([]byte(data), metadata); err != nil {
+		return false, fmt.Errorf("failed to unmarshal %s token metadata: %w", prefix, err)
+	}
+
+	// The user index may already point at a newer token; only drop it if not.
+	userKey := fmt.Sprintf("%s:user:%d", prefix, userID())
+	if current, err := t.client.Get(ctx, userKey).Result(); err == nil && current == token {
+		t.client.Del(ctx, userKey)
+	}
+	return true, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/RofaBR/Go-Usof/internal/domain"
 	"github.com/RofaBR/Go-Usof/internal/models"
@@ -39,14 +40,16 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 		Role:          omit.From(enums.UserRole(user.Role)),
 		Rating:        omit.From(int32(user.Rating)),
 		EmailVerified: omit.From(user.EmailVerified),
-		GoogleID:      omitnull.From(user.GoogleID),
+		GoogleID:      nullableString(user.GoogleID),
+		HasPassword:   omit.From(user.HasPassword),
+		Avatar:        nullableString(user.Avatar),
 	}
 
 	query := models.Users.Insert(setter)
 
 	model, err := query.One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
 	if err != nil {
-		return fmt.Errorf("insert failed: %w", err)
+		return fmt.Errorf("insert failed: %w", uniqueViolation(err))
 	}
 	user.ID = model.ID
 
@@ -84,6 +87,73 @@ func (r *UserRepository) GetByGoogleID(ctx context.Context, googleID string) (*d
 	return mapModelToDomain(model), nil
 }
 
+func (r *UserRepository) GetByLogin(ctx context.Context, login string) (*domain.User, error) {
+	query := models.Users.Query(
+		sm.Where(psql.F("lower", models.Users.Columns.Login)().EQ(psql.F("lower", psql.Arg(login))())),
+	)
+
+	model, err := query.One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return mapModelToDomain(model), nil
+}
+
+func (r *UserRepository) GetByPreviousLogin(ctx context.Context, login string, since time.Time) (*domain.User, error) {
+	query := models.LoginHistories.Query(
+		sm.Where(psql.F("lower", models.LoginHistories.Columns.Login)().EQ(psql.F("lower", psql.Arg(login))())),
+		sm.Where(models.LoginHistories.Columns.ChangedAt.GTE(psql.Arg(since))),
+		sm.OrderBy(models.LoginHistories.Columns.ChangedAt).Desc(),
+		sm.Limit(1),
+	)
+
+	entry, err := query.One(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return r.GetByID(ctx, entry.UserID)
+}
+
+func (r *UserRepository) ChangeLogin(ctx context.Context, userID int64, login string) error {
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	return db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		current, err := models.Users.Query(
+			sm.Where(models.Users.Columns.ID.EQ(psql.Arg(userID))),
+			sm.ForUpdate(),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to lock user: %w", err)
+		}
+
+		_, err = models.LoginHistories.Insert(&models.LoginHistorySetter{
+			UserID: omit.From(userID),
+			Login:  omit.From(current.Login),
+		}).Exec(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to record login history: %w", err)
+		}
+
+		setter := &models.UserSetter{
+			Login:          omit.From(login),
+			LoginChangedAt: omitnull.From(time.Now()),
+		}
+		_, err = models.Users.Update(
+			setter.UpdateMod(),
+			um.Where(models.Users.Columns.ID.EQ(psql.Arg(userID))),
+		).Exec(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("update failed: %w", uniqueViolation(err))
+		}
+		return nil
+	})
+}
+
 func (r *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
 	query := models.Users.Query(
 		sm.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
@@ -99,6 +169,25 @@ func (r *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, e
 	return mapModelToDomain(model), nil
 }
 
+func (r *UserRepository) GetByIDs(ctx context.Context, ids []int64) ([]*domain.User, error) {
+	if len(ids) == 0 {
+		return []*domain.User{}, nil
+	}
+	query := models.Users.Query(
+		sm.Where(models.Users.Columns.ID.In(idArgs(ids)...)),
+	)
+
+	userSlice, err := query.All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	users := make([]*domain.User, len(userSlice))
+	for i, model := range userSlice {
+		users[i] = mapModelToDomain(model)
+	}
+	return users, nil
+}
+
 func (r *UserRepository) GetAll(ctx context.Context) ([]*domain.User, error) {
 	query := models.Users.Query()
 
@@ -116,13 +205,41 @@ func (r *UserRepository) GetAll(ctx context.Context) ([]*domain.User, error) {
 	return users, nil
 }
 
+func (r *UserRepository) Activity(ctx context.Context, id int64) (*domain.UserActivity, error) {
+	exec := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	questions, err := models.Questions.Query(
+		sm.Where(models.Questions.Columns.AuthorID.EQ(psql.Arg(id))),
+		notDeleted(domain.PostQuestion, models.Questions.Columns.ID),
+	).Count(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	answers, err := models.Answers.Query(
+		sm.Where(models.Answers.Columns.AuthorID.EQ(psql.Arg(id))),
+		notDeleted(domain.PostAnswer, models.Answers.Columns.ID),
+	).Count(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	comments, err := models.Comments.Query(
+		sm.Where(models.Comments.Columns.AuthorID.EQ(psql.Arg(id))),
+		notDeleted(domain.FlagTargetComment, models.Comments.Columns.ID),
+	).Count(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return &domain.UserActivity{Questions: int(questions), Answers: int(answers), Comments: int(comments)}, nil
+}
+
 func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	setter := &models.UserSetter{
 		Email:         omit.From(user.Email),
 		Password:      omit.From(user.Password),
 		Fullname:      omit.From(user.FullName),
 		EmailVerified: omit.From(user.EmailVerified),
-		GoogleID:      omitnull.From(user.GoogleID),
+		GoogleID:      nullableString(user.GoogleID),
+		HasPassword:   omit.From(user.HasPassword),
+		Avatar:        nullableString(user.Avatar),
 	}
 
 	query := models.Users.Update(
@@ -131,7 +248,7 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	)
 	rowsAffected, err := query.Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
 	if err != nil {
-		return fmt.Errorf("update failed: %w", err)
+		return fmt.Errorf("update failed: %w", uniqueViolation(err))
 	}
 
 	if rowsAffected == 0 {
@@ -141,6 +258,92 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
+func (r *UserRepository) UpdatePassword(ctx context.Context, id int64, hash string, verifyEmail bool) error {
+	setter := &models.UserSetter{
+		Password:    omit.From(hash),
+		HasPassword: omit.From(true),
+	}
+	if verifyEmail {
+		setter.EmailVerified = omit.From(true)
+	}
+	return r.updateColumns(ctx, id, setter)
+}
+
+func (r *UserRepository) UpdateProfile(ctx context.Context, id int64, update domain.ProfileUpdate) error {
+	setter := &models.UserSetter{}
+	if update.FullName != nil {
+		setter.Fullname = omit.From(*update.FullName)
+	}
+	if update.Avatar != nil {
+		setter.Avatar = nullableString(*update.Avatar)
+	}
+	return r.updateColumns(ctx, id, setter)
+}
+
+// updateColumns writes only the columns set in setter.
+func (r *UserRepository) updateColumns(ctx context.Context, id int64, setter *models.UserSetter) error {
+	rowsAffected, err := models.Users.Update(
+		setter.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user with ID %d not found", id)
+	}
+	return nil
+}
+
+func (r *UserRepository) ReplacePasswordHash(ctx context.Context, id int64, oldHash, newHash string) (bool, error) {
+	setter := &models.UserSetter{
+		Password: omit.From(newHash),
+	}
+	rowsAffected, err := models.Users.Update(
+		setter.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+		um.Where(models.Users.Columns.Password.EQ(psql.Arg(oldHash))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return false, fmt.Errorf("update failed: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *UserRepository) UpdateRole(ctx context.Context, id int64, role string) error {
+	setter := &models.UserSetter{
+		Role: omit.From(enums.UserRole(role)),
+	}
+	rowsAffected, err := models.Users.Update(
+		setter.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user with ID %d not found", id)
+	}
+	return nil
+}
+
+func (r *UserRepository) SetBanned(ctx context.Context, id int64, bannedAt *time.Time) error {
+	setter := &models.UserSetter{
+		BannedAt: omitnull.FromPtr(bannedAt),
+	}
+	rowsAffected, err := models.Users.Update(
+		setter.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)))
+	if err != nil {
+		return fmt.Errorf("update failed: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user with ID %d not found", id)
+	}
+	return nil
+}
+
 func (r *UserRepository) Delete(ctx context.Context, id int64) error {
 	query := models.Users.Delete(
 		dm.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
@@ -158,14 +361,28 @@ func (r *UserRepository) Delete(ctx context.Context, id int64) error {
 
 func mapModelToDomain(m *models.User) *domain.User {
 	return &domain.User{
-		ID:            m.ID,
-		Login:         m.Login,
-		Email:         m.Email,
-		Password:      m.Password,
-		FullName:      m.Fullname,
-		Role:          string(m.Role),
-		Rating:        int(m.Rating),
-		EmailVerified: m.EmailVerified,
-		GoogleID:      m.GoogleID.GetOrZero(),
+		ID:             m.ID,
+		Login:          m.Login,
+		Email:          m.Email,
+		Password:       m.Password,
+		FullName:       m.Fullname,
+		Role:           string(m.Role),
+		Rating:         int(m.Rating),
+		EmailVerified:  m.EmailVerified,
+		GoogleID:       m.GoogleID.GetOrZero(),
+		HasPassword:    m.HasPassword,
+		Avatar:         m.Avatar.GetOrZero(),
+		CreatedAt:      m.CreatedAt,
+		LoginChangedAt: m.LoginChangedAt.GetOrZero(),
+		BannedAt:       m.BannedAt.Ptr(),
+	}
+}
+
+// nullableString stores empty strings as NULL so optional unique columns such
+// as google_id do not collide on "".
+func nullableString(s string) omitnull.Val[string] {
+	if s == "" {
+		return omitnull.FromPtr[string](nil)
 	}
+	return omitnull.From(s)
 }
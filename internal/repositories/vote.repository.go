@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type VoteRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewVoteRepository(db *pgxpool.Pool) *VoteRepository {
+	return &VoteRepository{db: db}
+}
+
+func (r *VoteRepository) Get(ctx context.Context, userID int64, targetType string, targetID int64) (int, error) {
+	return voteOf(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)), userID, targetType, targetID)
+}
+
+func voteOf(ctx context.Context, exec bob.Executor, userID int64, targetType string, targetID int64) (int, error) {
+	model, err := models.Votes.Query(
+		sm.Where(models.Votes.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Votes.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.Votes.Columns.TargetID.EQ(psql.Arg(targetID))),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return int(model.Value), nil
+}
+
+// Set locks the voter's row first so concurrent votes of the same user are
+// applied one after the other and each sees the vote it replaces.
+func (r *VoteRepository) Set(ctx context.Context, userID int64, targetType string, targetID int64, value int, authorID int64) (int, int, error) {
+	var oldRating, newRating int
+	db := bob.NewDB(stdlib.OpenDBFromPool(r.db))
+	err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		if _, err := psql.RawQuery("SELECT 1 FROM users WHERE id = ? FOR UPDATE", userID).Exec(ctx, exec); err != nil {
+			return fmt.Errorf("failed to lock voter: %w", err)
+		}
+		previous, err := voteOf(ctx, exec, userID, targetType, targetID)
+		if err != nil {
+			return err
+		}
+
+		if value == 0 {
+			_, err = models.Votes.Delete(
+				dm.Where(models.Votes.Columns.UserID.EQ(psql.Arg(userID))),
+				dm.Where(models.Votes.Columns.TargetType.EQ(psql.Arg(targetType))),
+				dm.Where(models.Votes.Columns.TargetID.EQ(psql.Arg(targetID))),
+			).Exec(ctx, exec)
+			if err != nil {
+				return fmt.Errorf("delete failed: %w", err)
+			}
+		} else {
+			_, err = models.Votes.Insert(
+				&models.VoteSetter{
+					UserID:     omit.From(userID),
+					TargetType: omit.From(targetType),
+					TargetID:   omit.From(targetID),
+					Value:      omit.From(int16(value)),
+				},
+				im.OnConflict("user_id", "target_type", "target_id").DoUpdate(im.SetExcluded("value")),
+			).Exec(ctx, exec)
+			if err != nil {
+				return fmt.Errorf("insert failed: %w", err)
+			}
+		}
+
+		if authorID == 0 {
+			return nil
+		}
+		delta := value - previous
+		author, err := models.Users.Update(
+			um.SetCol("rating").To(psql.Raw("rating + ?", delta)),
+			um.Where(models.Users.Columns.ID.EQ(psql.Arg(authorID))),
+		).One(ctx, exec)
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("update failed: %w", err)
+		}
+		newRating = int(author.Rating)
+		oldRating = newRating - delta
+		return nil
+	})
+	return oldRating, newRating, err
+}
+
+// scoreRow is a row of the scores query.
+type scoreRow struct {
+	TargetID int64 `db:"target_id"`
+	Score    int   `db:"score"`
+}
+
+func (r *VoteRepository) Scores(ctx context.Context, targetType string, targetIDs []int64) (map[int64]int, error) {
+	scores := make(map[int64]int)
+	if len(targetIDs) == 0 {
+		return scores, nil
+	}
+	rows, err := bob.All(ctx, bob.NewDB(stdlib.OpenDBFromPool(r.db)), psql.Select(
+		sm.Columns(
+			models.Votes.Columns.TargetID,
+			psql.Raw("sum(value)::int").As("score"),
+		),
+		sm.From(models.Votes.Name()),
+		sm.Where(models.Votes.Columns.TargetType.EQ(psql.Arg(targetType))),
+		sm.Where(models.Votes.Columns.TargetID.In(idArgs(targetIDs)...)),
+		sm.GroupBy(models.Votes.Columns.TargetID),
+	), scan.StructMapper[scoreRow]())
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	for _, row := range rows {
+		scores[row.TargetID] = row.Score
+	}
+	return scores, nil
+}
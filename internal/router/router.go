@@ -1,24 +1,60 @@
 package router
 
 import (
+	"github.com/RofaBR/Go-Usof/internal/domain"
 	"github.com/RofaBR/Go-Usof/internal/handler"
-	"github.com/RofaBR/Go-Usof/internal/middleware"
 	"github.com/RofaBR/Go-Usof/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
-func SetupRouter(log *logger.Logger, h *handler.Handler, authMW gin.HandlerFunc) *gin.Engine {
+// Middlewares groups the handlers built in app.New. Rate limiters are nil
+// when rate limiting is disabled.
+type Middlewares struct {
+	CORS         gin.HandlerFunc
+	Auth         gin.HandlerFunc
+	StreamAuth   gin.HandlerFunc
+	OptionalAuth gin.HandlerFunc
+	CSRF         gin.HandlerFunc
+	Locale       gin.HandlerFunc
+	AuthLimit    gin.HandlerFunc
+	APILimit     gin.HandlerFunc
+	// Permission builds middleware that requires a permission of the
+	// signed-in user's role; it runs after Auth.
+	Permission func(permission string) gin.HandlerFunc
+}
+
+// SetupRouter builds the engine. mediaDir is served under /media when the
+// local storage driver is used and empty otherwise.
+func SetupRouter(log *logger.Logger, h *handler.Handler, mw *Middlewares, mediaDir string) *gin.Engine {
 	router := gin.Default()
+	router.Use(mw.CORS)
+
+	if mediaDir != "" {
+		router.Static("/media", mediaDir)
+	}
 
 	api := router.Group("/api")
+	api.Use(mw.Locale, mw.OptionalAuth)
+	if mw.APILimit != nil {
+		api.Use(mw.APILimit)
+	}
 
 	if gin.Mode() != gin.ReleaseMode {
 		registerHealthRoutes(router, h)
 	}
 
-	registerAuthRoutes(api, h)
-	registerUserRoutes(api, h)
-	registerCategoryRoutes(api, h, authMW)
+	registerAuthRoutes(api, h, mw)
+	registerUserRoutes(api, h, mw.Auth)
+	registerPostRoutes(api, h, mw.Auth)
+	registerCategoryRoutes(api, h, mw)
+	registerAttachmentRoutes(api, h, mw.Auth)
+	registerNotificationRoutes(api, h, mw)
+	registerFollowRoutes(api, h, mw.Auth)
+	registerBookmarkRoutes(api, h, mw.Auth)
+	registerRevisionRoutes(api, h, mw.Auth)
+	registerMarkdownRoutes(api, h, mw.Auth)
+	registerModerationRoutes(api, h, mw)
+	registerAdminRoutes(api, h, mw)
 
 	return router
 }
@@ -27,30 +63,192 @@ func registerHealthRoutes(router *gin.Engine, h *handler.Handler) {
 	router.GET("/ping", h.Health.Ping)
 }
 
-func registerAuthRoutes(rg *gin.RouterGroup, h *handler.Handler) {
+func registerAuthRoutes(rg *gin.RouterGroup, h *handler.Handler, mw *Middlewares) {
 	auth := rg.Group("/auth")
+	if mw.AuthLimit != nil {
+		auth.Use(mw.AuthLimit)
+	}
 	{
 		auth.POST("/register", h.Auth.Register)
 		auth.POST("/login", h.Auth.Login)
-		auth.POST("/logout", h.Auth.Logout)
+		auth.POST("/logout", mw.CSRF, h.Auth.Logout)
 		auth.GET("/verify", h.Auth.VerifyEmail)
+		auth.POST("/verify/resend", h.Auth.ResendVerification)
+		auth.GET("/unlock", h.Auth.Unlock)
+		auth.GET("/email/confirm", h.Auth.ConfirmEmailChange)
+		auth.POST("/password/forgot", h.Auth.ForgotPassword)
+		auth.GET("/password/reset", h.Auth.CheckPasswordReset)
+		auth.POST("/password/reset", h.Auth.ResetPassword)
 		auth.GET("/google", h.OAuth2.GoogleLogin)
 		auth.GET("/google/callback", h.OAuth2.GoogleCallback)
-		auth.POST("/refresh", h.Auth.Refresh)
+		auth.POST("/refresh", mw.CSRF, h.Auth.Refresh)
 	}
 }
 
-func registerUserRoutes(rg *gin.RouterGroup, h *handler.Handler) {
+func registerUserRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
 	user := rg.Group("/user")
 	{
 		user.POST("/register", h.Auth.Register)
 	}
+
+	users := rg.Group("/users")
+	{
+		users.GET("/:user", h.User.GetProfile)
+		users.GET("/:user/avatar", h.User.GetAvatar)
+	}
+
+	me := rg.Group("/users/me")
+	me.Use(authMW)
+	{
+		me.GET("", h.User.GetMe)
+		me.PATCH("", h.User.UpdateMe)
+		me.PUT("/avatar", h.User.UpdateAvatar)
+		me.DELETE("/avatar", h.User.DeleteAvatar)
+		me.PUT("/login", h.User.ChangeLogin)
+		me.PUT("/password", h.User.ChangePassword)
+	}
 }
 
-func registerCategoryRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
+func registerPostRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
+	questions := rg.Group("/questions")
+	{
+		questions.GET("", h.Post.ListQuestions)
+		questions.GET("/:id", h.Post.GetQuestion)
+		questions.POST("", authMW, h.Post.Ask)
+		questions.PUT("/:id", authMW, h.Post.EditQuestion)
+		questions.DELETE("/:id", authMW, h.Post.DeleteQuestion)
+		questions.POST("/:id/answers", authMW, h.Post.Answer)
+		questions.PUT("/:id/accepted", authMW, h.Post.Accept)
+		questions.DELETE("/:id/accepted", authMW, h.Post.Unaccept)
+	}
+
+	answers := rg.Group("/answers")
+	answers.Use(authMW)
+	{
+		answers.PUT("/:id", h.Post.EditAnswer)
+		answers.DELETE("/:id", h.Post.DeleteAnswer)
+	}
+
+	rg.PUT("/votes/:type/:id", authMW, h.Post.Vote)
+
+	rg.GET("/comments/:type/:id", h.Comment.List)
+	rg.POST("/comments/:type/:id", authMW, h.Comment.Create)
+	rg.DELETE("/comments/:id", authMW, h.Comment.Delete)
+}
+
+func registerCategoryRoutes(rg *gin.RouterGroup, h *handler.Handler, mw *Middlewares) {
 	category := rg.Group("/category")
-	category.Use(authMW)
+	category.Use(mw.Auth)
+	{
+		category.POST("/create", mw.Permission(domain.PermissionCategoryWrite), h.Category.Create)
+	}
+}
+
+func registerAttachmentRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
+	attachments := rg.Group("/attachments")
+	attachments.Use(authMW)
+	{
+		attachments.GET("", h.Attachment.List)
+		attachments.POST("", h.Attachment.Upload)
+		attachments.DELETE("/:id", h.Attachment.Delete)
+	}
+}
+
+func registerNotificationRoutes(rg *gin.RouterGroup, h *handler.Handler, mw *Middlewares) {
+	rg.GET("/notifications/stream", mw.StreamAuth, h.Notification.Stream)
+	rg.POST("/notifications/stream/ticket", mw.Auth, h.Notification.StreamTicket)
+	rg.GET("/notifications/unsubscribe", h.Notification.ConfirmUnsubscribe)
+	rg.POST("/notifications/unsubscribe", h.Notification.Unsubscribe)
+
+	notifications := rg.Group("/notifications")
+	notifications.Use(mw.Auth)
+	{
+		notifications.GET("", h.Notification.List)
+		notifications.POST("/read", h.Notification.MarkRead)
+		notifications.POST("/read-all", h.Notification.MarkAllRead)
+	}
+
+	preferences := rg.Group("/users/me/notification-preferences")
+	preferences.Use(mw.Auth)
+	{
+		preferences.GET("", h.Notification.GetPreferences)
+		preferences.PATCH("", h.Notification.UpdatePreferences)
+		preferences.POST("/revoke-unsubscribe-links", h.Notification.RevokeUnsubscribeLinks)
+	}
+}
+
+func registerFollowRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
+	follows := rg.Group("/follows")
+	follows.Use(authMW)
+	{
+		follows.GET("", h.Follow.List)
+		follows.POST("", h.Follow.Follow)
+		follows.DELETE("/:type/:id", h.Follow.Unfollow)
+	}
+
+	rg.GET("/follows/:type/:id/followers", h.Follow.Followers)
+	rg.GET("/feed", authMW, h.Follow.Feed)
+}
+
+func registerBookmarkRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
+	bookmarks := rg.Group("/bookmarks")
+	bookmarks.Use(authMW)
+	{
+		bookmarks.GET("", h.Bookmark.List)
+		bookmarks.POST("", h.Bookmark.Add)
+		bookmarks.PUT("/order", h.Bookmark.Reorder)
+		bookmarks.DELETE("/:id", h.Bookmark.Remove)
+
+		bookmarks.GET("/collections", h.Bookmark.ListCollections)
+		bookmarks.POST("/collections", h.Bookmark.CreateCollection)
+		bookmarks.PATCH("/collections/:id", h.Bookmark.UpdateCollection)
+		bookmarks.DELETE("/collections/:id", h.Bookmark.DeleteCollection)
+	}
+
+	rg.GET("/collections/:token", h.Bookmark.GetSharedCollection)
+}
+
+func registerRevisionRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
+	revisions := rg.Group("/revisions/:type/:id")
+	{
+		revisions.GET("", h.Revision.List)
+		revisions.GET("/diff", h.Revision.Diff)
+		revisions.GET("/:revision", h.Revision.Get)
+		revisions.POST("/rollback", authMW, h.Revision.Rollback)
+	}
+}
+
+func registerMarkdownRoutes(rg *gin.RouterGroup, h *handler.Handler, authMW gin.HandlerFunc) {
+	markdown := rg.Group("/markdown")
+	{
+		markdown.POST("/preview", authMW, h.Markdown.Preview)
+		markdown.GET("/highlight.css", h.Markdown.Stylesheet)
+	}
+}
+
+// registerModerationRoutes lets only reviewers post actions and leaves the
+// check of the action's own permission to the service, since each action
+// needs a different one.
+func registerModerationRoutes(rg *gin.RouterGroup, h *handler.Handler, mw *Middlewares) {
+	rg.POST("/flags", mw.Auth, h.Moderation.Flag)
+
+	moderation := rg.Group("/moderation")
+	moderation.Use(mw.Auth)
+	{
+		moderation.GET("/queue", mw.Permission(domain.PermissionFlagReview), h.Moderation.Queue)
+		moderation.GET("/posts/:type/:id", mw.Permission(domain.PermissionFlagReview), h.Moderation.Review)
+		moderation.POST("/actions", mw.Permission(domain.PermissionFlagReview), h.Moderation.Act)
+		moderation.POST("/users/:id/ban", mw.Permission(domain.PermissionUserBan), h.Moderation.Ban)
+		moderation.DELETE("/users/:id/ban", mw.Permission(domain.PermissionUserBan), h.Moderation.Unban)
+	}
+}
+
+func registerAdminRoutes(rg *gin.RouterGroup, h *handler.Handler, mw *Middlewares) {
+	admin := rg.Group("/admin")
+	admin.Use(mw.Auth, mw.Permission(domain.PermissionRoleAssign))
 	{
-		category.POST("/create", middleware.RoleMiddleware("admin"), h.Category.Create)
+		admin.GET("/permissions", h.Permission.List)
+		admin.PUT("/roles/:role/permissions", h.Permission.SetRolePermissions)
+		admin.PUT("/users/:id/role", h.Permission.AssignRole)
 	}
 }
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+var (
+	ErrAttachmentNotFound      = errors.New("attachment not found")
+	ErrAttachmentInUse         = errors.New("attachment is used by a post")
+	ErrAttachmentQuotaExceeded = errors.New("attachment quota exceeded")
+	ErrInvalidAttachmentTarget = errors.New("attachments can only be added to questions and answers")
+)
+
+// orphanBatchSize bounds how many uploads a single garbage collection pass
+// loads at once.
+const orphanBatchSize = 100
+
+// AttachmentService manages images embedded in questions and answers. Uploads
+// start unreferenced; PostService links them with Link once the post is
+// saved, and uploads that stay unreferenced past the orphan TTL are deleted
+// by the garbage collector.
+type AttachmentService struct {
+	repo   domain.AttachmentRepository
+	images domain.ImageService
+	config config.AttachmentConfig
+	log    *logger.Logger
+}
+
+func NewAttachmentService(repo domain.AttachmentRepository, images domain.ImageService, cfg config.AttachmentConfig, log *logger.Logger) *AttachmentService {
+	return &AttachmentService{repo: repo, images: images, config: cfg, log: log}
+}
+
+func (s *AttachmentService) quota() domain.AttachmentQuota {
+	return domain.AttachmentQuota{MaxFiles: s.config.MaxFilesPerUser, MaxBytes: s.config.MaxBytesPerUser}
+}
+
+// Upload stores an image for userID. The quota is checked before processing
+// to fail fast and again atomically when the row is inserted.
+func (s *AttachmentService) Upload(ctx context.Context, userID int64, file io.Reader) (*domain.Attachment, error) {
+	usage, err := s.repo.Usage(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to load attachment usage", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if usage.Files >= s.config.MaxFilesPerUser || usage.Bytes >= s.config.MaxBytesPerUser {
+		s.log.Warn("attachment quota exceeded", "user_id", userID, "files", usage.Files, "bytes", usage.Bytes)
+		return nil, ErrAttachmentQuotaExceeded
+	}
+
+	stored, err := s.images.Upload(ctx, file, "attachments/"+strconv.FormatInt(userID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &domain.Attachment{
+		UserID:      userID,
+		Key:         stored.Key,
+		URL:         stored.URL,
+		ContentType: stored.ContentType,
+		Size:        stored.Size,
+		Width:       stored.Width,
+		Height:      stored.Height,
+	}
+	created, err := s.repo.Create(ctx, attachment, s.quota())
+	if err != nil || !created {
+		if delErr := s.images.Delete(ctx, stored.Key); delErr != nil {
+			s.log.Error("failed to delete rejected attachment", "key", stored.Key, "error", delErr)
+		}
+		if err != nil {
+			s.log.Error("failed to save attachment", "user_id", userID, "error", err)
+			return nil, fmt.Errorf("database error: %v", err)
+		}
+		s.log.Warn("attachment quota exceeded", "user_id", userID)
+		return nil, ErrAttachmentQuotaExceeded
+	}
+
+	s.log.Info("attachment uploaded", "user_id", userID, "attachment_id", attachment.ID, "size", attachment.Size)
+	return attachment, nil
+}
+
+func (s *AttachmentService) List(ctx context.Context, userID int64) ([]*domain.Attachment, domain.AttachmentUsage, error) {
+	attachments, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to list attachments", "user_id", userID, "error", err)
+		return nil, domain.AttachmentUsage{}, fmt.Errorf("database error: %v", err)
+	}
+	usage := domain.AttachmentUsage{Files: len(attachments)}
+	for _, a := range attachments {
+		usage.Bytes += a.Size
+	}
+	return attachments, usage, nil
+}
+
+func (s *AttachmentService) Quota() domain.AttachmentQuota {
+	return s.quota()
+}
+
+// Delete removes one of the user's own uploads. Uploads still referenced by a
+// post have to be removed from the post first.
+func (s *AttachmentService) Delete(ctx context.Context, userID, id int64) error {
+	attachment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.log.Error("failed to load attachment", "attachment_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if attachment == nil || attachment.UserID != userID {
+		return ErrAttachmentNotFound
+	}
+	if attachment.TargetID != 0 {
+		return ErrAttachmentInUse
+	}
+	return s.remove(ctx, attachment)
+}
+
+// Link makes ids the complete set of attachments of a saved question or
+// answer; uploads no longer in ids become orphans. Every id must be an
+// upload of userID that is unreferenced or already linked to this post.
+func (s *AttachmentService) Link(ctx context.Context, userID int64, targetType string, targetID int64, ids []int64) error {
+	if targetType != domain.AttachmentTargetQuestion && targetType != domain.AttachmentTargetAnswer {
+		return ErrInvalidAttachmentTarget
+	}
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	linked, err := s.repo.SetTarget(ctx, userID, targetType, targetID, ids)
+	if err != nil {
+		s.log.Error("failed to link attachments", "target_type", targetType, "target_id", targetID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if linked != len(ids) {
+		s.log.Warn("attachments could not be linked", "user_id", userID, "requested", len(ids), "linked", linked)
+		return ErrAttachmentNotFound
+	}
+	return nil
+}
+
+// Release orphans all attachments of a deleted question or answer.
+func (s *AttachmentService) Release(ctx context.Context, targetType string, targetID int64) error {
+	if _, err := s.repo.SetTarget(ctx, 0, targetType, targetID, nil); err != nil {
+		s.log.Error("failed to release attachments", "target_type", targetType, "target_id", targetID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	return nil
+}
+
+// CollectOrphans deletes uploads that were never referenced by a saved post,
+// or were released by one, and are older than the orphan TTL, as well as
+// unreferenced uploads of deleted users. An upload that cannot be deleted is
+// logged and skipped; it is tried again on the next run.
+func (s *AttachmentService) CollectOrphans(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-time.Duration(s.config.OrphanTTL) * time.Hour)
+	deleted, failed := 0, 0
+	var afterID int64
+	for {
+		orphans, err := s.repo.ListOrphaned(ctx, cutoff, afterID, orphanBatchSize)
+		if err != nil {
+			return deleted, fmt.Errorf("database error: %v", err)
+		}
+		for _, attachment := range orphans {
+			afterID = attachment.ID
+			if err := s.remove(ctx, attachment); err != nil {
+				failed++
+				continue
+			}
+			deleted++
+		}
+		if len(orphans) < orphanBatchSize {
+			if failed > 0 {
+				s.log.Warn("some orphaned attachments could not be deleted", "failed", failed)
+			}
+			return deleted, nil
+		}
+	}
+}
+
+// RunGarbageCollector calls CollectOrphans every GCInterval until ctx is done.
+func (s *AttachmentService) RunGarbageCollector(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.config.GCInterval) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.CollectOrphans(ctx)
+			if err != nil {
+				s.log.Error("attachment garbage collection failed", "deleted", deleted, "error", err)
+				continue
+			}
+			if deleted > 0 {
+				s.log.Info("orphaned attachments deleted", "count", deleted)
+			}
+		}
+	}
+}
+
+// remove deletes the stored object before the row so a failure never leaves
+// an object without a row pointing at it.
+func (s *AttachmentService) remove(ctx context.Context, attachment *domain.Attachment) error {
+	if err := s.images.Delete(ctx, attachment.Key); err != nil {
+		s.log.Error("failed to delete attachment object", "key", attachment.Key, "error", err)
+		return err
+	}
+	if err := s.repo.Delete(ctx, attachment.ID); err != nil {
+		s.log.Error("failed to delete attachment", "attachment_id", attachment.ID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+// fakeAttachmentRepo implements the parts of domain.AttachmentRepository the
+// garbage collector uses.
+type fakeAttachmentRepo struct {
+	domain.AttachmentRepository
+	orphans []*domain.Attachment
+}
+
+func (r *fakeAttachmentRepo) ListOrphaned(_ context.Context, _ time.Time, afterID int64, limit int) ([]*domain.Attachment, error) {
+	var page []*domain.Attachment
+	for _, a := range r.orphans {
+		if a.ID > afterID && len(page) < limit {
+			page = append(page, a)
+		}
+	}
+	return page, nil
+}
+
+func (r *fakeAttachmentRepo) Delete(_ context.Context, id int64) error {
+	r.orphans = slices.DeleteFunc(r.orphans, func(a *domain.Attachment) bool { return a.ID == id })
+	return nil
+}
+
+type fakeImageStore struct {
+	domain.ImageService
+	broken  map[string]bool
+	deleted []string
+}
+
+func (s *fakeImageStore) Upload(context.Context, io.Reader, string) (*domain.StoredImage, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *fakeImageStore) Delete(_ context.Context, key string) error {
+	if s.broken[key] {
+		return errors.New("storage unavailable")
+	}
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+func TestCollectOrphansSkipsFailures(t *testing.T) {
+	repo := &fakeAttachmentRepo{}
+	images := &fakeImageStore{broken: map[string]bool{}}
+	for id := int64(1); id <= 250; id++ {
+		key := fmt.Sprintf("attachments/%d.jpg", id)
+		repo.orphans = append(repo.orphans, &domain.Attachment{ID: id, Key: key})
+		// Fail a whole batch worth of uploads at the start.
+		if id <= orphanBatchSize {
+			images.broken[key] = true
+		}
+	}
+
+	s := NewAttachmentService(repo, images, config.AttachmentConfig{OrphanTTL: 24}, logger.New("error"))
+	deleted, err := s.CollectOrphans(context.Background())
+	if err != nil {
+		t.Fatalf("CollectOrphans: %v", err)
+	}
+	if deleted != 150 {
+		t.Errorf("deleted %d, want 150", deleted)
+	}
+	if len(repo.orphans) != orphanBatchSize {
+		t.Errorf("%d rows left, want the %d that failed", len(repo.orphans), orphanBatchSize)
+	}
+}
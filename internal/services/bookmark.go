@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	MaxBookmarks           = 5000
+	MaxBookmarkCollections = 100
+)
+
+var (
+	ErrBookmarkNotFound           = errors.New("bookmark not found")
+	ErrInvalidBookmarkTarget      = errors.New("only questions and answers can be bookmarked")
+	ErrTooManyBookmarks           = errors.New("bookmark limit reached")
+	ErrInvalidBookmarkOrder       = errors.New("order must list every bookmark of the collection exactly once")
+	ErrCollectionNotFound         = errors.New("collection not found")
+	ErrInvalidCollectionName      = errors.New("collection name must not be empty")
+	ErrCollectionNameTaken        = errors.New("a collection with this name already exists")
+	ErrTooManyBookmarkCollections = errors.New("collection limit reached")
+)
+
+// BookmarkService keeps users' saved questions and answers and the
+// collections they are sorted into.
+type BookmarkService struct {
+	bookmarks   domain.BookmarkRepository
+	collections domain.BookmarkCollectionRepository
+	log         *logger.Logger
+}
+
+func NewBookmarkService(bookmarks domain.BookmarkRepository, collections domain.BookmarkCollectionRepository, log *logger.Logger) *BookmarkService {
+	return &BookmarkService{bookmarks: bookmarks, collections: collections, log: log}
+}
+
+// Add bookmarks a question or answer at the end of collectionID (or of the
+// bookmarks outside collections when nil). An existing bookmark of the same
+// post is moved there instead; created tells the two apart.
+func (s *BookmarkService) Add(ctx context.Context, userID int64, targetType string, targetID int64, collectionID *int64) (*domain.Bookmark, bool, error) {
+	if targetType != domain.BookmarkTargetQuestion && targetType != domain.BookmarkTargetAnswer || targetID <= 0 {
+		return nil, false, ErrInvalidBookmarkTarget
+	}
+	if collectionID != nil {
+		if _, err := s.ownCollection(ctx, userID, *collectionID); err != nil {
+			return nil, false, err
+		}
+	}
+
+	existing, err := s.bookmarks.GetByTarget(ctx, userID, targetType, targetID)
+	if err != nil {
+		s.log.Error("failed to look up bookmark", "user_id", userID, "error", err)
+		return nil, false, fmt.Errorf("database error: %v", err)
+	}
+	if existing == nil {
+		count, err := s.bookmarks.Count(ctx, userID)
+		if err != nil {
+			s.log.Error("failed to count bookmarks", "user_id", userID, "error", err)
+			return nil, false, fmt.Errorf("database error: %v", err)
+		}
+		if count >= MaxBookmarks {
+			return nil, false, ErrTooManyBookmarks
+		}
+	}
+
+	position, err := s.bookmarks.NextPosition(ctx, userID, collectionID)
+	if err != nil {
+		s.log.Error("failed to find bookmark position", "user_id", userID, "error", err)
+		return nil, false, fmt.Errorf("database error: %v", err)
+	}
+
+	if existing != nil {
+		if sameCollection(existing.CollectionID, collectionID) {
+			return existing, false, nil
+		}
+		if err := s.bookmarks.Move(ctx, existing.ID, collectionID, position); err != nil {
+			s.log.Error("failed to move bookmark", "bookmark_id", existing.ID, "error", err)
+			return nil, false, fmt.Errorf("database error: %v", err)
+		}
+		existing.CollectionID = collectionID
+		existing.Position = position
+		s.log.Info("bookmark moved", "bookmark_id", existing.ID, "user_id", userID)
+		return existing, false, nil
+	}
+
+	bookmark := &domain.Bookmark{
+		UserID:       userID,
+		CollectionID: collectionID,
+		TargetType:   targetType,
+		TargetID:     targetID,
+		Position:     position,
+	}
+	if err := s.bookmarks.Create(ctx, bookmark); err != nil {
+		s.log.Error("failed to create bookmark", "user_id", userID, "error", err)
+		return nil, false, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("bookmark created", "bookmark_id", bookmark.ID, "user_id", userID)
+	return bookmark, true, nil
+}
+
+func (s *BookmarkService) Remove(ctx context.Context, userID, id int64) error {
+	deleted, err := s.bookmarks.Delete(ctx, userID, id)
+	if err != nil {
+		s.log.Error("failed to delete bookmark", "bookmark_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if !deleted {
+		return ErrBookmarkNotFound
+	}
+	s.log.Info("bookmark deleted", "bookmark_id", id, "user_id", userID)
+	return nil
+}
+
+// List returns userID's bookmarks in collectionID, or outside collections
+// when it is nil, in their saved order.
+func (s *BookmarkService) List(ctx context.Context, userID int64, collectionID *int64) ([]*domain.Bookmark, error) {
+	if collectionID != nil {
+		if _, err := s.ownCollection(ctx, userID, *collectionID); err != nil {
+			return nil, err
+		}
+	}
+	bookmarks, err := s.bookmarks.List(ctx, userID, collectionID)
+	if err != nil {
+		s.log.Error("failed to list bookmarks", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return bookmarks, nil
+}
+
+// Reorder puts the bookmarks of collectionID in the order of ids, which
+// must name each of them exactly once.
+func (s *BookmarkService) Reorder(ctx context.Context, userID int64, collectionID *int64, ids []int64) ([]*domain.Bookmark, error) {
+	bookmarks, err := s.List(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) != len(bookmarks) {
+		return nil, ErrInvalidBookmarkOrder
+	}
+	byID := make(map[int64]*domain.Bookmark, len(bookmarks))
+	for _, b := range bookmarks {
+		byID[b.ID] = b
+	}
+	ordered := make([]*domain.Bookmark, len(ids))
+	for i, id := range ids {
+		b, ok := byID[id]
+		if !ok {
+			return nil, ErrInvalidBookmarkOrder
+		}
+		delete(byID, id)
+		b.Position = i
+		ordered[i] = b
+	}
+
+	if err := s.bookmarks.Reorder(ctx, userID, ids); err != nil {
+		s.log.Error("failed to reorder bookmarks", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return ordered, nil
+}
+
+func (s *BookmarkService) Collections(ctx context.Context, userID int64) ([]*domain.BookmarkCollection, error) {
+	collections, err := s.collections.List(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to list bookmark collections", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return collections, nil
+}
+
+func (s *BookmarkService) CreateCollection(ctx context.Context, userID int64, name string, public bool) (*domain.BookmarkCollection, error) {
+	name = strings.TrimSpace(name)
+	if err := s.checkCollectionName(ctx, userID, 0, name); err != nil {
+		return nil, err
+	}
+
+	count, err := s.collections.Count(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to count bookmark collections", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if count >= MaxBookmarkCollections {
+		return nil, ErrTooManyBookmarkCollections
+	}
+
+	collection := &domain.BookmarkCollection{UserID: userID, Name: name}
+	setPublic(collection, public)
+	if err := s.collections.Create(ctx, collection); err != nil {
+		if errors.Is(err, domain.ErrUniqueViolation) {
+			return nil, ErrCollectionNameTaken
+		}
+		s.log.Error("failed to create bookmark collection", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("bookmark collection created", "collection_id", collection.ID, "user_id", userID)
+	return collection, nil
+}
+
+// UpdateCollection renames a collection and/or toggles whether it is public;
+// nil arguments are left unchanged.
+func (s *BookmarkService) UpdateCollection(ctx context.Context, userID, id int64, name *string, public *bool) (*domain.BookmarkCollection, error) {
+	collection, err := s.ownCollection(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		if err := s.checkCollectionName(ctx, userID, id, trimmed); err != nil {
+			return nil, err
+		}
+		collection.Name = trimmed
+	}
+	if public != nil {
+		setPublic(collection, *public)
+	}
+
+	if err := s.collections.Update(ctx, collection); err != nil {
+		if errors.Is(err, domain.ErrUniqueViolation) {
+			return nil, ErrCollectionNameTaken
+		}
+		s.log.Error("failed to update bookmark collection", "collection_id", id, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("bookmark collection updated", "collection_id", id, "user_id", userID)
+	return collection, nil
+}
+
+// DeleteCollection deletes a collection; its bookmarks are kept outside any
+// collection, after the ones already there.
+func (s *BookmarkService) DeleteCollection(ctx context.Context, userID, id int64) error {
+	if _, err := s.ownCollection(ctx, userID, id); err != nil {
+		return err
+	}
+	if err := s.collections.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete bookmark collection", "collection_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("bookmark collection deleted", "collection_id", id, "user_id", userID)
+	return nil
+}
+
+// SharedCollection returns the public collection with the given share token
+// and its bookmarks. Private collections have no token and look missing.
+func (s *BookmarkService) SharedCollection(ctx context.Context, token string) (*domain.BookmarkCollection, []*domain.Bookmark, error) {
+	collection, err := s.collections.GetByShareToken(ctx, token)
+	if err != nil {
+		s.log.Error("failed to load shared bookmark collection", "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+	if collection == nil || !collection.Public {
+		return nil, nil, ErrCollectionNotFound
+	}
+
+	bookmarks, err := s.bookmarks.List(ctx, collection.UserID, &collection.ID)
+	if err != nil {
+		s.log.Error("failed to list bookmarks", "collection_id", collection.ID, "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+	return collection, bookmarks, nil
+}
+
+func (s *BookmarkService) ownCollection(ctx context.Context, userID, id int64) (*domain.BookmarkCollection, error) {
+	collection, err := s.collections.GetByID(ctx, id)
+	if err != nil {
+		s.log.Error("failed to load bookmark collection", "collection_id", id, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if collection == nil || collection.UserID != userID {
+		return nil, ErrCollectionNotFound
+	}
+	return collection, nil
+}
+
+// checkCollectionName rejects an empty name, or one that another collection of
+// userID than exceptID already uses.
+func (s *BookmarkService) checkCollectionName(ctx context.Context, userID, exceptID int64, name string) error {
+	if name == "" {
+		return ErrInvalidCollectionName
+	}
+	existing, err := s.collections.GetByName(ctx, userID, name)
+	if err != nil {
+		s.log.Error("failed to look up bookmark collection", "user_id", userID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if existing != nil && existing.ID != exceptID {
+		return ErrCollectionNameTaken
+	}
+	return nil
+}
+
+// setPublic shares or unshares collection. Sharing again after making it
+// private issues a new token, so links handed out before stay dead.
+func setPublic(collection *domain.BookmarkCollection, public bool) {
+	switch {
+	case public && collection.ShareToken == "":
+		collection.ShareToken = strings.ReplaceAll(uuid.New().String(), "-", "")
+	case !public:
+		collection.ShareToken = ""
+	}
+	collection.Public = public
+}
+
+func sameCollection(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
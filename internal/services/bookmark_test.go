@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+type fakeCollectionRepo struct {
+	domain.BookmarkCollectionRepository
+	byID      map[int64]*domain.BookmarkCollection
+	createErr error
+}
+
+func (r *fakeCollectionRepo) Create(_ context.Context, collection *domain.BookmarkCollection) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	collection.ID = int64(len(r.byID) + 1)
+	stored := *collection
+	r.byID[collection.ID] = &stored
+	return nil
+}
+
+func (r *fakeCollectionRepo) GetByID(_ context.Context, id int64) (*domain.BookmarkCollection, error) {
+	if c, ok := r.byID[id]; ok {
+		copied := *c
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeCollectionRepo) GetByName(context.Context, int64, string) (*domain.BookmarkCollection, error) {
+	return nil, nil
+}
+
+func (r *fakeCollectionRepo) GetByShareToken(_ context.Context, token string) (*domain.BookmarkCollection, error) {
+	for _, c := range r.byID {
+		if c.ShareToken == token {
+			copied := *c
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeCollectionRepo) Update(_ context.Context, collection *domain.BookmarkCollection) error {
+	stored := *collection
+	r.byID[collection.ID] = &stored
+	return nil
+}
+
+func (r *fakeCollectionRepo) Count(context.Context, int64) (int, error) {
+	return len(r.byID), nil
+}
+
+type fakeBookmarkRepo struct {
+	domain.BookmarkRepository
+}
+
+func (fakeBookmarkRepo) List(context.Context, int64, *int64) ([]*domain.Bookmark, error) {
+	return nil, nil
+}
+
+func TestCreateCollectionNameRace(t *testing.T) {
+	repo := &fakeCollectionRepo{
+		byID:      map[int64]*domain.BookmarkCollection{},
+		createErr: &domain.UniqueViolationError{Constraint: "bookmark_collections_user_id_name_key"},
+	}
+	svc := NewBookmarkService(fakeBookmarkRepo{}, repo, logger.New("error"))
+
+	if _, err := svc.CreateCollection(context.Background(), 1, "Go", false); !errors.Is(err, ErrCollectionNameTaken) {
+		t.Errorf("err = %v, want ErrCollectionNameTaken", err)
+	}
+}
+
+func TestSharedCollectionByToken(t *testing.T) {
+	repo := &fakeCollectionRepo{byID: map[int64]*domain.BookmarkCollection{}}
+	svc := NewBookmarkService(fakeBookmarkRepo{}, repo, logger.New("error"))
+	ctx := context.Background()
+
+	private, err := svc.CreateCollection(ctx, 1, "Drafts", false)
+	if err != nil {
+		t.Fatalf("CreateCollection: %v", err)
+	}
+	if private.ShareToken != "" {
+		t.Error("private collection got a share token")
+	}
+
+	public := true
+	shared, err := svc.UpdateCollection(ctx, 1, private.ID, nil, &public)
+	if err != nil {
+		t.Fatalf("UpdateCollection: %v", err)
+	}
+	if len(shared.ShareToken) != 32 {
+		t.Fatalf("share token %q, want 32 characters", shared.ShareToken)
+	}
+	if got, _, err := svc.SharedCollection(ctx, shared.ShareToken); err != nil || got.ID != private.ID {
+		t.Errorf("SharedCollection = %v, %v; want the collection", got, err)
+	}
+
+	public = false
+	if _, err := svc.UpdateCollection(ctx, 1, private.ID, nil, &public); err != nil {
+		t.Fatalf("UpdateCollection: %v", err)
+	}
+	if _, _, err := svc.SharedCollection(ctx, shared.ShareToken); !errors.Is(err, ErrCollectionNotFound) {
+		t.Errorf("token of an unshared collection: err = %v, want ErrCollectionNotFound", err)
+	}
+
+	public = true
+	reshared, err := svc.UpdateCollection(ctx, 1, private.ID, nil, &public)
+	if err != nil {
+		t.Fatalf("UpdateCollection: %v", err)
+	}
+	if reshared.ShareToken == shared.ShareToken {
+		t.Error("sharing again reused the old token")
+	}
+}
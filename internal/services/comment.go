@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+// maxCommentLength matches the body column of comments.
+const maxCommentLength = 600
+
+var (
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrInvalidComment   = errors.New("comment must be between 1 and 600 characters")
+	ErrCommentForbidden = errors.New("only the author can delete this comment")
+)
+
+// CommentService manages comments under questions and answers. Comments
+// follow the post they are left under: none can be added to a locked or
+// deleted post.
+type CommentService struct {
+	comments      domain.CommentRepository
+	users         domain.UserRepository
+	posts         *PostService
+	notifications *NotificationService
+	follows       *FollowService
+	log           *logger.Logger
+}
+
+func NewCommentService(comments domain.CommentRepository, users domain.UserRepository, posts *PostService, notifications *NotificationService, follows *FollowService, log *logger.Logger) *CommentService {
+	return &CommentService{comments: comments, users: users, posts: posts, notifications: notifications, follows: follows, log: log}
+}
+
+// Create leaves a comment by authorID under a question or answer and
+// notifies the post's author and anyone mentioned. Followers of the question
+// see it in their feeds.
+func (s *CommentService) Create(ctx context.Context, authorID int64, targetType string, targetID int64, body string) (*domain.Comment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > maxCommentLength {
+		return nil, ErrInvalidComment
+	}
+	ref, err := s.posts.ref(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.posts.refOpen(ctx, ref); err != nil {
+		return nil, err
+	}
+
+	comment := &domain.Comment{TargetType: targetType, TargetID: targetID, AuthorID: authorID, Body: body}
+	if err := s.comments.Create(ctx, comment); err != nil {
+		s.log.Error("failed to create comment", "author_id", authorID, "target_type", targetType, "target_id", targetID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("comment posted", "comment_id", comment.ID, "author_id", authorID, "target_type", targetType, "target_id", targetID)
+
+	s.notifications.CommentPosted(ctx, ref.AuthorID, authorID, comment.ID, body)
+	s.notifications.Mentioned(ctx, authorID, domain.NotificationTargetComment, comment.ID, body, ref.AuthorID)
+	s.follows.CommentPosted(ctx, authorID, ref.QuestionID, comment.ID, body)
+	return comment, nil
+}
+
+// List returns the comments under a question or answer oldest first.
+func (s *CommentService) List(ctx context.Context, targetType string, targetID int64) ([]*domain.Comment, error) {
+	if _, err := s.posts.ref(ctx, targetType, targetID); err != nil {
+		return nil, err
+	}
+	comments, err := s.comments.List(ctx, targetType, targetID)
+	if err != nil {
+		s.log.Error("failed to list comments", "target_type", targetType, "target_id", targetID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return comments, nil
+}
+
+// Delete removes a comment on behalf of its author.
+func (s *CommentService) Delete(ctx context.Context, actorID, id int64) error {
+	comment, err := s.comments.GetByID(ctx, id)
+	if err != nil {
+		s.log.Error("failed to load comment", "comment_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if comment == nil {
+		return ErrCommentNotFound
+	}
+	if comment.AuthorID != actorID {
+		return ErrCommentForbidden
+	}
+	if err := s.comments.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete comment", "comment_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("comment deleted", "comment_id", id)
+	return nil
+}
+
+// Authors returns the authors of comments keyed by ID.
+func (s *CommentService) Authors(ctx context.Context, comments []*domain.Comment) (map[int64]*domain.User, error) {
+	var ids []int64
+	for _, c := range comments {
+		if c.AuthorID != 0 {
+			ids = append(ids, c.AuthorID)
+		}
+	}
+	authors, err := usersByID(ctx, s.users, ids)
+	if err != nil {
+		s.log.Error("failed to load comment authors", "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return authors, nil
+}
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+const (
+	DefaultFeedPageSize = 20
+	MaxFeedPageSize     = 100
+
+	// MaxFollows bounds how much a single user can follow, which also keeps
+	// the feed query cheap.
+	MaxFollows = 1000
+)
+
+var (
+	ErrInvalidFollowTarget  = errors.New("invalid follow target")
+	ErrFollowTargetNotFound = errors.New("follow target not found")
+	ErrCannotFollowSelf     = errors.New("you cannot follow yourself")
+	ErrTooManyFollows       = errors.New("follow limit reached")
+)
+
+// FollowService manages what users follow and builds their feeds. Like
+// NotificationService, the producer methods are called by the code that
+// creates posts, after the post is saved, and never fail it.
+type FollowService struct {
+	follows    domain.FollowRepository
+	activities domain.ActivityRepository
+	users      domain.UserRepository
+	categories domain.CategoryRepository
+	posts      domain.PostRepository
+	log        *logger.Logger
+}
+
+func NewFollowService(follows domain.FollowRepository, activities domain.ActivityRepository, users domain.UserRepository, categories domain.CategoryRepository, posts domain.PostRepository, log *logger.Logger) *FollowService {
+	return &FollowService{follows: follows, activities: activities, users: users, categories: categories, posts: posts, log: log}
+}
+
+// Follow makes userID follow target and reports whether it did not already.
+func (s *FollowService) Follow(ctx context.Context, userID int64, target domain.FollowTarget) (bool, error) {
+	if err := s.checkTarget(ctx, userID, target); err != nil {
+		return false, err
+	}
+
+	count, err := s.follows.Count(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to count follows", "user_id", userID, "error", err)
+		return false, fmt.Errorf("database error: %v", err)
+	}
+	if count >= MaxFollows {
+		return false, ErrTooManyFollows
+	}
+
+	created, err := s.follows.Create(ctx, &domain.Follow{UserID: userID, FollowTarget: target})
+	if err != nil {
+		s.log.Error("failed to follow", "user_id", userID, "target_type", target.Type, "target_id", target.ID, "error", err)
+		return false, fmt.Errorf("database error: %v", err)
+	}
+	if created {
+		s.log.Info("user followed", "user_id", userID, "target_type", target.Type, "target_id", target.ID)
+	}
+	return created, nil
+}
+
+// Unfollow reports whether userID was following target.
+func (s *FollowService) Unfollow(ctx context.Context, userID int64, target domain.FollowTarget) (bool, error) {
+	if !slices.Contains(domain.FollowTypes, target.Type) {
+		return false, ErrInvalidFollowTarget
+	}
+	deleted, err := s.follows.Delete(ctx, userID, target)
+	if err != nil {
+		s.log.Error("failed to unfollow", "user_id", userID, "target_type", target.Type, "target_id", target.ID, "error", err)
+		return false, fmt.Errorf("database error: %v", err)
+	}
+	if deleted {
+		s.log.Info("user unfollowed", "user_id", userID, "target_type", target.Type, "target_id", target.ID)
+	}
+	return deleted, nil
+}
+
+// List returns what userID follows, only targets of targetType unless it is
+// empty.
+func (s *FollowService) List(ctx context.Context, userID int64, targetType string) ([]*domain.Follow, error) {
+	if targetType != "" && !slices.Contains(domain.FollowTypes, targetType) {
+		return nil, ErrInvalidFollowTarget
+	}
+	follows, err := s.follows.List(ctx, userID, targetType)
+	if err != nil {
+		s.log.Error("failed to list follows", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return follows, nil
+}
+
+// Followers returns how many users follow target.
+func (s *FollowService) Followers(ctx context.Context, target domain.FollowTarget) (int, error) {
+	if !slices.Contains(domain.FollowTypes, target.Type) || target.ID <= 0 {
+		return 0, ErrInvalidFollowTarget
+	}
+	count, err := s.follows.CountFollowers(ctx, target)
+	if err != nil {
+		s.log.Error("failed to count followers", "target_type", target.Type, "target_id", target.ID, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	return count, nil
+}
+
+// Feed returns a page of new posts in everything userID follows, newest
+// first, together with their authors keyed by ID.
+func (s *FollowService) Feed(ctx context.Context, userID, beforeID int64, limit int) ([]*domain.Activity, map[int64]*domain.User, error) {
+	if limit <= 0 {
+		limit = DefaultFeedPageSize
+	}
+	limit = min(limit, MaxFeedPageSize)
+
+	activities, err := s.activities.Feed(ctx, userID, beforeID, limit)
+	if err != nil {
+		s.log.Error("failed to load feed", "user_id", userID, "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+
+	ids := make([]int64, 0, len(activities))
+	for _, a := range activities {
+		if a.ActorID != 0 && !slices.Contains(ids, a.ActorID) {
+			ids = append(ids, a.ActorID)
+		}
+	}
+	actors, err := usersByID(ctx, s.users, ids)
+	if err != nil {
+		s.log.Error("failed to load activity actors", "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+	return activities, actors, nil
+}
+
+// QuestionPosted shows a new question to followers of its author and
+// categories.
+func (s *FollowService) QuestionPosted(ctx context.Context, authorID, questionID int64, categoryIDs []int64, title string) {
+	targets := []domain.FollowTarget{
+		{Type: domain.FollowUser, ID: authorID},
+		{Type: domain.FollowQuestion, ID: questionID},
+	}
+	for _, id := range categoryIDs {
+		targets = append(targets, domain.FollowTarget{Type: domain.FollowCategory, ID: id})
+	}
+	s.record(ctx, &domain.Activity{
+		ActorID:    authorID,
+		Type:       domain.ActivityQuestion,
+		PostID:     questionID,
+		QuestionID: questionID,
+		Excerpt:    excerpt(title),
+		Targets:    targets,
+	})
+}
+
+// AnswerPosted shows a new answer to followers of its author and question.
+func (s *FollowService) AnswerPosted(ctx context.Context, authorID, questionID, answerID int64, body string) {
+	s.record(ctx, &domain.Activity{
+		ActorID:    authorID,
+		Type:       domain.ActivityAnswer,
+		PostID:     answerID,
+		QuestionID: questionID,
+		Excerpt:    excerpt(body),
+		Targets: []domain.FollowTarget{
+			{Type: domain.FollowUser, ID: authorID},
+			{Type: domain.FollowQuestion, ID: questionID},
+		},
+	})
+}
+
+// CommentPosted shows a new comment to followers of its author and of the
+// question it was left under.
+func (s *FollowService) CommentPosted(ctx context.Context, authorID, questionID, commentID int64, body string) {
+	s.record(ctx, &domain.Activity{
+		ActorID:    authorID,
+		Type:       domain.ActivityComment,
+		PostID:     commentID,
+		QuestionID: questionID,
+		Excerpt:    excerpt(body),
+		Targets: []domain.FollowTarget{
+			{Type: domain.FollowUser, ID: authorID},
+			{Type: domain.FollowQuestion, ID: questionID},
+		},
+	})
+}
+
+func (s *FollowService) record(ctx context.Context, activity *domain.Activity) {
+	if err := s.activities.Create(ctx, activity); err != nil {
+		s.log.Error("failed to record activity", "type", activity.Type, "post_id", activity.PostID, "error", err)
+		return
+	}
+	s.log.Debug("activity recorded", "activity_id", activity.ID, "type", activity.Type)
+}
+
+// checkTarget makes sure target exists and can be followed by userID.
+func (s *FollowService) checkTarget(ctx context.Context, userID int64, target domain.FollowTarget) error {
+	if !slices.Contains(domain.FollowTypes, target.Type) || target.ID <= 0 {
+		return ErrInvalidFollowTarget
+	}
+
+	switch target.Type {
+	case domain.FollowUser:
+		if target.ID == userID {
+			return ErrCannotFollowSelf
+		}
+		user, err := s.users.GetByID(ctx, target.ID)
+		if err != nil {
+			s.log.Error("failed to look up followed user", "user_id", target.ID, "error", err)
+			return fmt.Errorf("database error: %v", err)
+		}
+		if user == nil {
+			return ErrFollowTargetNotFound
+		}
+	case domain.FollowCategory:
+		category, err := s.categories.GetByID(ctx, target.ID)
+		if err != nil {
+			s.log.Error("failed to look up followed category", "category_id", target.ID, "error", err)
+			return fmt.Errorf("database error: %v", err)
+		}
+		if category == nil {
+			return ErrFollowTargetNotFound
+		}
+	case domain.FollowQuestion:
+		question, err := s.posts.GetQuestion(ctx, target.ID)
+		if err != nil {
+			s.log.Error("failed to look up followed question", "question_id", target.ID, "error", err)
+			return fmt.Errorf("database error: %v", err)
+		}
+		if question == nil {
+			return ErrFollowTargetNotFound
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+)
+
+const (
+	// identiconGrid is the number of cells per side; columns are mirrored
+	// around the middle one.
+	identiconGrid = 5
+
+	MinIdenticonSize = 16
+	MaxIdenticonSize = 512
+)
+
+// RenderIdenticon draws a symmetric 5x5 identicon for seed as a PNG of
+// size x size pixels. The same seed always yields the same image.
+func (s *ImageService) RenderIdenticon(seed string, size int) ([]byte, error) {
+	size = min(max(size, MinIdenticonSize), MaxIdenticonSize)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, identicon(seed, size)); err != nil {
+		return nil, fmt.Errorf("failed to encode identicon: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func identicon(seed string, size int) image.Image {
+	sum := sha256.Sum256([]byte(seed))
+
+	background := color.RGBA{0xF0, 0xF0, 0xF0, 0xFF}
+	foreground := hslColor(float64(uint16(sum[0])<<8|uint16(sum[1]))/65536*360, 0.55, 0.5)
+
+	img := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{background, foreground})
+
+	// Keep a margin of half a cell on every side.
+	cell := size / (identiconGrid + 1)
+	offset := (size - cell*identiconGrid) / 2
+
+	half := (identiconGrid + 1) / 2
+	for row := 0; row < identiconGrid; row++ {
+		for col := 0; col < half; col++ {
+			bit := row*half + col
+			if sum[2+bit/8]>>(bit%8)&1 == 0 {
+				continue
+			}
+			for _, c := range []int{col, identiconGrid - 1 - col} {
+				rect := image.Rect(offset+c*cell, offset+row*cell, offset+(c+1)*cell, offset+(row+1)*cell)
+				draw.Draw(img, rect, &image.Uniform{C: foreground}, image.Point{}, draw.Src)
+			}
+		}
+	}
+	return img
+}
+
+func hslColor(h, s, l float64) color.RGBA {
+	c := (1 - math.Abs(2*l-1)) * s
+	hp := h / 60
+	x := c * (1 - math.Abs(math.Mod(hp, 2)-1))
+
+	var r, g, b float64
+	switch {
+	case hp < 1:
+		r, g = c, x
+	case hp < 2:
+		r, g = x, c
+	case hp < 3:
+		g, b = c, x
+	case hp < 4:
+		g, b = x, c
+	case hp < 5:
+		r, b = x, c
+	default:
+		r, b = c, x
+	}
+
+	m := l - c/2
+	return color.RGBA{uint8((r + m) * 255), uint8((g + m) * 255), uint8((b + m) * 255), 0xFF}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"strings"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+)
+
+// ImageService validates uploads, re-encodes them without metadata, crops and
+// resizes where needed and hands the result to whichever storage driver is
+// configured.
+type ImageService struct {
+	storage domain.ObjectStorage
+	config  config.ImageConfig
+	log     *logger.Logger
+}
+
+func NewImageService(storage domain.ObjectStorage, cfg config.ImageConfig, log *logger.Logger) *ImageService {
+	return &ImageService{storage: storage, config: cfg, log: log}
+}
+
+// MaxUploadSize lets handlers cap the request body before it is parsed.
+func (s *ImageService) MaxUploadSize() int64 {
+	return s.config.MaxUploadSize
+}
+
+// UploadAvatar crops the image to a centered square, scales it to the
+// configured avatar size and stores it as JPEG. Every upload gets a new key so
+// caches never serve an older avatar; the caller deletes the previous one.
+func (s *ImageService) UploadAvatar(ctx context.Context, file io.Reader, userID string) (string, error) {
+	data, err := s.readUpload(file)
+	if err != nil {
+		return "", err
+	}
+
+	src, err := s.decodeUpload(data)
+	if err != nil {
+		s.log.Warn("rejected avatar upload", "user_id", userID, "size", len(data), "error", err)
+		return "", err
+	}
+
+	avatar := resizeToFill(src, s.config.AvatarSize, s.config.AvatarSize)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, avatar, &jpeg.Options{Quality: s.config.JPEGQuality}); err != nil {
+		return "", fmt.Errorf("failed to encode avatar: %w", err)
+	}
+
+	key := avatarPrefix(userID) + uuid.New().String() + ".jpg"
+	if err := s.storage.Put(ctx, key, &buf, int64(buf.Len()), "image/jpeg"); err != nil {
+		s.log.Error("failed to store avatar", "user_id", userID, "error", err)
+		return "", err
+	}
+
+	s.log.Info("avatar stored", "user_id", userID, "key", key)
+	return s.storage.URL(key), nil
+}
+
+// DeleteAvatar deletes the stored object behind avatarURL. URLs that do not
+// point at one of userID's own avatars (external links, another user's
+// avatar) are left alone.
+func (s *ImageService) DeleteAvatar(ctx context.Context, userID, avatarURL string) error {
+	key, ok := s.avatarKey(userID, avatarURL)
+	if !ok {
+		s.log.Debug("avatar is not stored by us, skipping delete", "user_id", userID, "url", avatarURL)
+		return nil
+	}
+	return s.storage.Delete(ctx, key)
+}
+
+// IsStoredAvatar reports whether avatarURL points at an avatar this service
+// stored for the user, as opposed to an address taken from elsewhere.
+func (s *ImageService) IsStoredAvatar(userID, avatarURL string) bool {
+	_, ok := s.avatarKey(userID, avatarURL)
+	return ok
+}
+
+// Upload validates and re-encodes an image without resizing it and stores it
+// under prefix with a random name.
+func (s *ImageService) Upload(ctx context.Context, file io.Reader, prefix string) (*domain.StoredImage, error) {
+	data, err := s.readUpload(file)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := s.decodeUpload(data)
+	if err != nil {
+		s.log.Warn("rejected image upload", "prefix", prefix, "size", len(data), "error", err)
+		return nil, err
+	}
+
+	processed, err := s.encodeNormalized(img)
+	if err != nil {
+		return nil, err
+	}
+
+	key := prefix + "/" + uuid.New().String() + processed.Extension
+	size := int64(len(processed.Data))
+	if err := s.storage.Put(ctx, key, bytes.NewReader(processed.Data), size, processed.ContentType); err != nil {
+		s.log.Error("failed to store image", "key", key, "error", err)
+		return nil, err
+	}
+
+	return &domain.StoredImage{
+		Key:         key,
+		URL:         s.storage.URL(key),
+		ContentType: processed.ContentType,
+		Size:        size,
+		Width:       processed.Width,
+		Height:      processed.Height,
+	}, nil
+}
+
+func (s *ImageService) Delete(ctx context.Context, key string) error {
+	return s.storage.Delete(ctx, key)
+}
+
+func avatarPrefix(userID string) string {
+	return "avatars/" + userID + "/"
+}
+
+// avatarKey recovers the storage key from an avatar URL. Besides the current
+// per-upload keys it recognises the single avatars/<id>.jpg key used before.
+func (s *ImageService) avatarKey(userID, avatarURL string) (string, bool) {
+	avatarURL, _, _ = strings.Cut(avatarURL, "?")
+	for _, marker := range []string{avatarPrefix(userID), "avatars/" + userID + ".jpg"} {
+		i := strings.LastIndex(avatarURL, marker)
+		if i < 0 {
+			continue
+		}
+		key := avatarURL[i:]
+		// Only a plain file name may follow the marker, no path segments.
+		name := key[len(marker):]
+		if strings.ContainsAny(name, "/\\") || strings.HasPrefix(name, ".") {
+			continue
+		}
+		if s.storage.URL(key) == avatarURL {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// resizeToFill crops src around its center to the target aspect ratio and
+// scales it to width x height. Transparent areas are flattened onto white
+// because the result is encoded as JPEG.
+func resizeToFill(src image.Image, width, height int) image.Image {
+	b := src.Bounds()
+	crop := b
+	if b.Dx()*height > b.Dy()*width {
+		w := b.Dy() * width / height
+		crop.Min.X = b.Min.X + (b.Dx()-w)/2
+		crop.Max.X = crop.Min.X + w
+	} else {
+		h := b.Dx() * height / width
+		crop.Min.Y = b.Min.Y + (b.Dy()-h)/2
+		crop.Max.Y = crop.Min.Y + h
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Over, nil)
+	return dst
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	_ "image/gif"
+
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	ErrImageTooLarge      = errors.New("image file is too large")
+	ErrUnsupportedImage   = errors.New("unsupported image type, use JPEG, PNG, GIF or WebP")
+	ErrImageCorrupt       = errors.New("image data is corrupt")
+	ErrImageTooSmall      = errors.New("image dimensions are too small")
+	ErrImageDimensions    = errors.New("image dimensions are too large")
+	ErrImageTooManyPixels = errors.New("image has too many pixels")
+)
+
+// imageSignatures maps the decoder name registered with the image package to
+// the magic bytes of that format. The declared Content-Type of an upload is
+// never trusted.
+var imageSignatures = []struct {
+	format string
+	match  func([]byte) bool
+}{
+	{"jpeg", func(b []byte) bool { return bytes.HasPrefix(b, []byte{0xFF, 0xD8, 0xFF}) }},
+	{"png", func(b []byte) bool { return bytes.HasPrefix(b, []byte("\x89PNG\r\n\x1a\n")) }},
+	{"gif", func(b []byte) bool {
+		return bytes.HasPrefix(b, []byte("GIF87a")) || bytes.HasPrefix(b, []byte("GIF89a"))
+	}},
+	{"webp", func(b []byte) bool {
+		return len(b) >= 12 && bytes.Equal(b[0:4], []byte("RIFF")) && bytes.Equal(b[8:12], []byte("WEBP"))
+	}},
+}
+
+// ProcessedImage is an upload after validation and re-encoding. Re-encoding
+// drops EXIF, XMP and any other metadata along with trailing data.
+type ProcessedImage struct {
+	Data        []byte
+	ContentType string
+	Extension   string
+	Width       int
+	Height      int
+}
+
+// readUpload reads at most MaxUploadSize bytes and fails if there is more.
+func (s *ImageService) readUpload(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, s.config.MaxUploadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if int64(len(data)) > s.config.MaxUploadSize {
+		return nil, ErrImageTooLarge
+	}
+	return data, nil
+}
+
+// decodeUpload validates data step by step before it is fully decoded:
+// magic bytes, header dimensions and the pixel budget. JPEG orientation from
+// EXIF is applied so stripping the metadata does not rotate photos.
+func (s *ImageService) decodeUpload(data []byte) (image.Image, error) {
+	format := sniffImageFormat(data)
+	if format == "" {
+		return nil, ErrUnsupportedImage
+	}
+
+	cfg, decoded, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageCorrupt
+	}
+	if decoded != format {
+		return nil, ErrUnsupportedImage
+	}
+
+	if cfg.Width < s.config.MinDimension || cfg.Height < s.config.MinDimension {
+		return nil, ErrImageTooSmall
+	}
+	if cfg.Width > s.config.MaxDimension || cfg.Height > s.config.MaxDimension {
+		return nil, ErrImageDimensions
+	}
+	if cfg.Width*cfg.Height > s.config.MaxPixels {
+		return nil, ErrImageTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageCorrupt
+	}
+
+	if format == "jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	return img, nil
+}
+
+// encodeNormalized stores opaque images as JPEG and images with transparency
+// as PNG, whatever format they were uploaded in.
+func (s *ImageService) encodeNormalized(img image.Image) (*ProcessedImage, error) {
+	var buf bytes.Buffer
+	processed := &ProcessedImage{
+		Width:  img.Bounds().Dx(),
+		Height: img.Bounds().Dy(),
+	}
+
+	if isOpaque(img) {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: s.config.JPEGQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		processed.ContentType, processed.Extension = "image/jpeg", ".jpg"
+	} else {
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		if err := encoder.Encode(&buf, img); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		processed.ContentType, processed.Extension = "image/png", ".png"
+	}
+
+	processed.Data = buf.Bytes()
+	return processed, nil
+}
+
+func sniffImageFormat(data []byte) string {
+	for _, sig := range imageSignatures {
+		if sig.match(data) {
+			return sig.format
+		}
+	}
+	return ""
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+func newTestImageService() *ImageService {
+	return NewImageService(nil, config.ImageConfig{
+		MaxUploadSize: 1 << 20,
+		AvatarSize:    64,
+		MinDimension:  8,
+		MaxDimension:  512,
+		MaxPixels:     100_000,
+		JPEGQuality:   85,
+	}, logger.New("error"))
+}
+
+func solidImage(width, height int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestSniffImageFormat(t *testing.T) {
+	red := solidImage(16, 16, color.NRGBA{R: 255, A: 255})
+
+	var gifBuf bytes.Buffer
+	if err := gif.Encode(&gifBuf, red, nil); err != nil {
+		t.Fatalf("gif.Encode: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"png", encodePNG(t, red), "png"},
+		{"jpeg", encodeJPEG(t, red), "jpeg"},
+		{"gif", gifBuf.Bytes(), "gif"},
+		{"webp", []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), "webp"},
+		{"html", []byte("<html><script>alert(1)</script>"), ""},
+		{"empty", nil, ""},
+	} {
+		if got := sniffImageFormat(tc.data); got != tc.want {
+			t.Errorf("%s: sniffImageFormat = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestReadUploadTooLarge(t *testing.T) {
+	s := newTestImageService()
+
+	if _, err := s.readUpload(bytes.NewReader(make([]byte, s.config.MaxUploadSize))); err != nil {
+		t.Errorf("upload at the limit rejected: %v", err)
+	}
+	if _, err := s.readUpload(bytes.NewReader(make([]byte, s.config.MaxUploadSize+1))); !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("upload over the limit: err = %v, want ErrImageTooLarge", err)
+	}
+}
+
+func TestDecodeUploadRejects(t *testing.T) {
+	s := newTestImageService()
+	pngData := encodePNG(t, solidImage(16, 16, color.White))
+
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"unknown format", []byte("GIF90a not really"), ErrUnsupportedImage},
+		{"truncated", pngData[:20], ErrImageCorrupt},
+		{"too small", encodePNG(t, solidImage(4, 4, color.White)), ErrImageTooSmall},
+		{"too wide", encodePNG(t, solidImage(600, 8, color.White)), ErrImageDimensions},
+		{"too many pixels", encodePNG(t, solidImage(400, 400, color.White)), ErrImageTooManyPixels},
+	} {
+		if _, err := s.decodeUpload(tc.data); !errors.Is(err, tc.want) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	if _, err := s.decodeUpload(pngData); err != nil {
+		t.Errorf("valid PNG rejected: %v", err)
+	}
+}
+
+func TestDecodeUploadIgnoresTrailingData(t *testing.T) {
+	s := newTestImageService()
+	data := append(encodePNG(t, solidImage(16, 16, color.White)), []byte("<?php system($_GET['c']); ?>")...)
+
+	img, err := s.decodeUpload(data)
+	if err != nil {
+		t.Fatalf("decodeUpload: %v", err)
+	}
+	processed, err := s.encodeNormalized(img)
+	if err != nil {
+		t.Fatalf("encodeNormalized: %v", err)
+	}
+	if bytes.Contains(processed.Data, []byte("<?php")) {
+		t.Error("trailing data survived re-encoding")
+	}
+}
+
+func TestEncodeNormalized(t *testing.T) {
+	s := newTestImageService()
+
+	opaque, err := s.encodeNormalized(solidImage(10, 20, color.NRGBA{B: 255, A: 255}))
+	if err != nil {
+		t.Fatalf("encodeNormalized: %v", err)
+	}
+	if opaque.ContentType != "image/jpeg" || opaque.Extension != ".jpg" {
+		t.Errorf("opaque image stored as %s (%s), want JPEG", opaque.ContentType, opaque.Extension)
+	}
+	if opaque.Width != 10 || opaque.Height != 20 {
+		t.Errorf("dimensions %dx%d, want 10x20", opaque.Width, opaque.Height)
+	}
+
+	transparent, err := s.encodeNormalized(solidImage(10, 10, color.NRGBA{A: 0}))
+	if err != nil {
+		t.Fatalf("encodeNormalized: %v", err)
+	}
+	if transparent.ContentType != "image/png" || transparent.Extension != ".png" {
+		t.Errorf("transparent image stored as %s (%s), want PNG", transparent.ContentType, transparent.Extension)
+	}
+	if sniffImageFormat(transparent.Data) != "png" {
+		t.Error("encoded data is not a PNG")
+	}
+}
+
+func TestResizeToFill(t *testing.T) {
+	wide := solidImage(200, 100, color.NRGBA{R: 255, A: 255})
+
+	got := resizeToFill(wide, 64, 64)
+	if b := got.Bounds(); b.Dx() != 64 || b.Dy() != 64 {
+		t.Errorf("resized to %dx%d, want 64x64", b.Dx(), b.Dy())
+	}
+}
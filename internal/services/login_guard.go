@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// LockoutError is returned when a login is rejected before credentials are
+// checked, either because of backoff or because the account or IP is locked.
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return "too many failed login attempts, try again later"
+}
+
+type LoginGuardService struct {
+	repo   domain.LoginAttemptRepository
+	mailer domain.EmailSender
+	config config.LoginGuardConfig
+	log    *logger.Logger
+}
+
+func NewLoginGuardService(repo domain.LoginAttemptRepository, mailer domain.EmailSender, cfg config.LoginGuardConfig, log *logger.Logger) *LoginGuardService {
+	return &LoginGuardService{
+		repo:   repo,
+		mailer: mailer,
+		config: cfg,
+		log:    log,
+	}
+}
+
+// Check rejects the attempt with a *LockoutError if the IP or account is locked
+// or the account is still inside its backoff window.
+func (s *LoginGuardService) Check(ctx context.Context, email, ip string) error {
+	email = normalizeEmail(email)
+
+	ipLock, err := s.repo.GetIPLock(ctx, ip)
+	if err != nil {
+		return fmt.Errorf("failed to check ip lock: %w", err)
+	}
+	if ipLock > 0 {
+		s.log.Warn("login rejected: ip locked", "event", "login_rejected", "reason", "ip_locked", "ip", ip, "retry_after", ipLock.String())
+		return &LockoutError{RetryAfter: ipLock}
+	}
+
+	accountLock, err := s.repo.GetAccountLock(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to check account lock: %w", err)
+	}
+	if accountLock > 0 {
+		s.log.Warn("login rejected: account locked", "event", "login_rejected", "reason", "account_locked", "email", email, "ip", ip, "retry_after", accountLock.String())
+		return &LockoutError{RetryAfter: accountLock}
+	}
+
+	backoff, err := s.repo.GetAccountBackoff(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to check backoff: %w", err)
+	}
+	if backoff > 0 {
+		s.log.Warn("login rejected: backoff active", "event", "login_rejected", "reason", "backoff", "email", email, "ip", ip, "retry_after", backoff.String())
+		return &LockoutError{RetryAfter: backoff}
+	}
+
+	return nil
+}
+
+// RegisterFailure records a failed attempt for the account and IP, applying
+// backoff and lockouts once the configured thresholds are reached. A lockout
+// starts the count over, so the failures behind it do not lock again as soon
+// as it ends. The unlock email is only sent when accountExists is true so
+// that unknown addresses never receive mail.
+func (s *LoginGuardService) RegisterFailure(ctx context.Context, email, ip string, accountExists bool) error {
+	email = normalizeEmail(email)
+	window := time.Duration(s.config.AttemptWindow) * time.Minute
+	lockout := time.Duration(s.config.LockoutDuration) * time.Minute
+
+	ipFailures, err := s.repo.IncrementIPFailures(ctx, ip, window)
+	if err != nil {
+		return fmt.Errorf("failed to record ip failure: %w", err)
+	}
+	if ipFailures >= int64(s.config.MaxIPAttempts) {
+		if err := s.repo.LockIP(ctx, ip, lockout); err != nil {
+			return fmt.Errorf("failed to lock ip: %w", err)
+		}
+		s.log.Warn("ip locked after repeated login failures", "event", "login_ip_locked", "ip", ip, "failures", ipFailures, "duration", lockout.String())
+	}
+
+	failures, err := s.repo.IncrementAccountFailures(ctx, email, window)
+	if err != nil {
+		return fmt.Errorf("failed to record account failure: %w", err)
+	}
+
+	if failures >= int64(s.config.MaxAccountAttempts) {
+		if err := s.repo.LockAccount(ctx, email, lockout); err != nil {
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+		s.log.Warn("account locked after repeated login failures", "event", "login_account_locked", "email", email, "ip", ip, "failures", failures, "duration", lockout.String())
+		if accountExists {
+			s.sendUnlockEmail(ctx, email, lockout)
+		}
+		return nil
+	}
+
+	if backoff := s.backoff(failures); backoff > 0 {
+		if err := s.repo.SetAccountBackoff(ctx, email, backoff); err != nil {
+			return fmt.Errorf("failed to set backoff: %w", err)
+		}
+		s.log.Info("login backoff applied", "event", "login_backoff", "email", email, "ip", ip, "failures", failures, "duration", backoff.String())
+	}
+
+	return nil
+}
+
+func (s *LoginGuardService) RegisterSuccess(ctx context.Context, email string) error {
+	return s.repo.ResetAccountFailures(ctx, normalizeEmail(email))
+}
+
+// Clear lifts the lockout and backoff of the account, for example after its
+// password was reset.
+func (s *LoginGuardService) Clear(ctx context.Context, email string) error {
+	return s.repo.UnlockAccount(ctx, normalizeEmail(email))
+}
+
+func (s *LoginGuardService) Unlock(ctx context.Context, token string) error {
+	email, err := s.repo.GetUnlockToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to get unlock token: %w", err)
+	}
+	if email == "" {
+		return errors.New("unlock token not found or expired")
+	}
+
+	if err := s.repo.UnlockAccount(ctx, email); err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+	if err := s.repo.DeleteUnlockToken(ctx, token); err != nil {
+		s.log.Warn("failed to delete unlock token", "email", email, "error", err)
+	}
+
+	s.log.Info("account unlocked", "event", "login_account_unlocked", "email", email)
+	return nil
+}
+
+// backoff doubles the delay for every failure past the threshold, capped at BackoffMax.
+func (s *LoginGuardService) backoff(failures int64) time.Duration {
+	over := failures - int64(s.config.BackoffThreshold)
+	if over <= 0 {
+		return 0
+	}
+	maxDelay := time.Duration(s.config.BackoffMax) * time.Second
+	delay := time.Duration(s.config.BackoffBase) * time.Second
+	for i := int64(1); i < over; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return min(delay, maxDelay)
+}
+
+func (s *LoginGuardService) sendUnlockEmail(ctx context.Context, email string, ttl time.Duration) {
+	token := uuid.New().String()
+	if err := s.repo.StoreUnlockToken(ctx, token, email, ttl); err != nil {
+		s.log.Error("failed to store unlock token", "email", email, "error", err)
+		return
+	}
+	if err := s.mailer.SendUnlockEmail(ctx, email, token); err != nil {
+		s.log.Error("failed to send unlock email", "email", email, "error", err)
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
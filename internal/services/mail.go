@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/mail"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+// Template names under internal/mail/templates.
+const (
+	MailVerification  = "verification"
+	MailUnlock        = "unlock"
+	MailPasswordReset = "password_reset"
+	MailEmailChange   = "email_change"
+	MailNotification  = "notification"
+	MailDigest        = "digest"
+)
+
+// MailService renders templated emails in the recipient's locale and hands
+// them to the configured transport.
+type MailService struct {
+	transport domain.MailTransport
+	renderer  *mail.Renderer
+	baseURL   string
+	log       *logger.Logger
+}
+
+func NewMailService(transport domain.MailTransport, renderer *mail.Renderer, baseURL string, log *logger.Logger) *MailService {
+	return &MailService{transport: transport, renderer: renderer, baseURL: baseURL, log: log}
+}
+
+func (s *MailService) Send(ctx context.Context, email *domain.Email) error {
+	return s.transport.Send(ctx, email)
+}
+
+// SendTemplate renders template name for the locale stored in ctx (see
+// mail.WithLocale) and sends it to to.
+func (s *MailService) SendTemplate(ctx context.Context, to, name string, data map[string]any) error {
+	msg, err := s.renderer.Render(name, mail.Locale(ctx), data)
+	if err != nil {
+		s.log.Error("failed to render email", "template", name, "error", err)
+		return err
+	}
+
+	unsubscribeURL, _ := data["UnsubscribeURL"].(string)
+	err = s.transport.Send(ctx, &domain.Email{
+		To:             to,
+		Subject:        msg.Subject,
+		HTML:           msg.HTML,
+		Text:           msg.Text,
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		s.log.Error("failed to queue email", "template", name, "to", to, "error", err)
+		return err
+	}
+
+	// The transport is the outbox, so the email is only queued here; the
+	// outbox worker logs the actual delivery.
+	s.log.Info("email queued", "template", name, "to", to)
+	return nil
+}
+
+func (s *MailService) SendVerificationEmail(ctx context.Context, email, token string) error {
+	return s.SendTemplate(ctx, email, MailVerification, map[string]any{
+		"URL": s.link("/api/auth/verify", token),
+	})
+}
+
+func (s *MailService) SendPasswordResetEmail(ctx context.Context, email, token string) error {
+	return s.SendTemplate(ctx, email, MailPasswordReset, map[string]any{
+		"URL": s.link("/api/auth/password/reset", token),
+	})
+}
+
+func (s *MailService) SendEmailChangeEmail(ctx context.Context, newEmail, token string) error {
+	return s.SendTemplate(ctx, newEmail, MailEmailChange, map[string]any{
+		"URL": s.link("/api/auth/email/confirm", token),
+	})
+}
+
+func (s *MailService) SendUnlockEmail(ctx context.Context, email, token string) error {
+	return s.SendTemplate(ctx, email, MailUnlock, map[string]any{
+		"URL": s.link("/api/auth/unlock", token),
+	})
+}
+
+// link builds an absolute URL to an API endpoint taking a token parameter.
+func (s *MailService) link(path, token string) string {
+	return fmt.Sprintf("%s%s?token=%s", s.baseURL, path, url.QueryEscape(token))
+}
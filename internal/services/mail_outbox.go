@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+// markTimeout bounds the database update recording a delivery attempt.
+const markTimeout = 5 * time.Second
+
+// MailOutbox queues emails in the database and delivers them in the
+// background, so requests never wait on (or fail because of) the mail
+// server. It implements domain.MailTransport for MailService; the actual
+// delivery goes through the wrapped transport.
+type MailOutbox struct {
+	repo      domain.EmailOutboxRepository
+	transport domain.MailTransport
+	config    config.MailOutboxConfig
+	log       *logger.Logger
+}
+
+func NewMailOutbox(repo domain.EmailOutboxRepository, transport domain.MailTransport, cfg config.MailOutboxConfig, log *logger.Logger) *MailOutbox {
+	return &MailOutbox{repo: repo, transport: transport, config: cfg, log: log}
+}
+
+// Send queues email for delivery.
+func (o *MailOutbox) Send(ctx context.Context, email *domain.Email) error {
+	queued := &domain.OutboxEmail{Email: *email}
+	if err := o.repo.Enqueue(ctx, queued); err != nil {
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+	o.log.Debug("email queued", "outbox_id", queued.ID, "to", email.To)
+	return nil
+}
+
+// Run delivers due emails every PollInterval and purges old sent ones once
+// an hour, until ctx is done.
+func (o *MailOutbox) Run(ctx context.Context) {
+	poll := time.NewTicker(time.Duration(o.config.PollInterval) * time.Second)
+	defer poll.Stop()
+	purge := time.NewTicker(time.Hour)
+	defer purge.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+			o.deliverDue(ctx)
+		case <-purge.C:
+			o.purgeSent(ctx)
+		}
+	}
+}
+
+// deliverDue drains due emails batch by batch so a backlog does not wait for
+// further ticks.
+func (o *MailOutbox) deliverDue(ctx context.Context) {
+	sendTimeout := time.Duration(o.config.SendTimeout) * time.Second
+	for ctx.Err() == nil {
+		batch, err := o.repo.ClaimDue(ctx, o.config.BatchSize, time.Now().Add(sendTimeout))
+		if err != nil {
+			o.log.Error("failed to claim queued emails", "error", err)
+			return
+		}
+		for _, email := range batch {
+			o.deliver(ctx, email, sendTimeout)
+		}
+		if len(batch) < o.config.BatchSize {
+			return
+		}
+	}
+}
+
+func (o *MailOutbox) deliver(ctx context.Context, email *domain.OutboxEmail, timeout time.Duration) {
+	sendCtx, cancel := context.WithTimeout(ctx, timeout)
+	err := o.transport.Send(sendCtx, &email.Email)
+	cancel()
+
+	// The bookkeeping below uses a context that survives shutdown: once the
+	// transport accepted (or refused) the email, the outcome must be stored
+	// or the email would be sent again, or retried too early, after restart.
+	markCtx, cancelMark := context.WithTimeout(context.WithoutCancel(ctx), markTimeout)
+	defer cancelMark()
+
+	if err == nil {
+		if err := o.repo.MarkSent(markCtx, email.ID); err != nil {
+			o.log.Error("email sent but not marked as sent", "outbox_id", email.ID, "error", err)
+			return
+		}
+		o.log.Info("queued email sent", "outbox_id", email.ID, "to", email.Email.To, "attempts", email.Attempts)
+		return
+	}
+
+	if email.Attempts >= o.config.MaxAttempts {
+		o.log.Error("email moved to dead letter", "outbox_id", email.ID, "to", email.Email.To, "attempts", email.Attempts, "error", err)
+		if markErr := o.repo.MarkDead(markCtx, email.ID, err.Error()); markErr != nil {
+			o.log.Error("failed to mark email as dead", "outbox_id", email.ID, "error", markErr)
+		}
+		return
+	}
+
+	retryAt := time.Now().Add(o.backoff(email.Attempts))
+	o.log.Warn("email delivery failed, will retry", "outbox_id", email.ID, "attempts", email.Attempts, "retry_at", retryAt, "error", err)
+	if markErr := o.repo.MarkFailed(markCtx, email.ID, err.Error(), retryAt); markErr != nil {
+		o.log.Error("failed to schedule email retry", "outbox_id", email.ID, "error", markErr)
+	}
+}
+
+// backoff doubles the delay with every attempt, capped at RetryMax, and adds
+// up to 20% jitter so emails that failed together do not retry together.
+func (o *MailOutbox) backoff(attempts int) time.Duration {
+	base := time.Duration(o.config.RetryBase) * time.Second
+	maxDelay := time.Duration(o.config.RetryMax) * time.Second
+
+	delay := base
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+	return delay + time.Duration(rand.Int64N(int64(delay)/5+1))
+}
+
+func (o *MailOutbox) purgeSent(ctx context.Context) {
+	before := time.Now().AddDate(0, 0, -o.config.Retention)
+	purged, err := o.repo.PurgeSent(ctx, before)
+	if err != nil {
+		o.log.Error("failed to purge sent emails", "error", err)
+		return
+	}
+	if purged > 0 {
+		o.log.Info("purged sent emails", "count", purged)
+	}
+}
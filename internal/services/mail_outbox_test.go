@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+type fakeOutboxRepo struct {
+	domain.EmailOutboxRepository
+	sent   []int64
+	failed []int64
+}
+
+func (r *fakeOutboxRepo) MarkSent(ctx context.Context, id int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.sent = append(r.sent, id)
+	return nil
+}
+
+func (r *fakeOutboxRepo) MarkFailed(ctx context.Context, id int64, _ string, _ time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.failed = append(r.failed, id)
+	return nil
+}
+
+type fakeTransport struct {
+	err error
+}
+
+func (t *fakeTransport) Send(context.Context, *domain.Email) error {
+	return t.err
+}
+
+func TestDeliverRecordsOutcomeAfterShutdown(t *testing.T) {
+	cfg := config.MailOutboxConfig{MaxAttempts: 3, RetryBase: 1, RetryMax: 10}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	repo := &fakeOutboxRepo{}
+	outbox := NewMailOutbox(repo, &fakeTransport{}, cfg, logger.New("error"))
+	outbox.deliver(ctx, &domain.OutboxEmail{ID: 1, Attempts: 1}, time.Second)
+	if len(repo.sent) != 1 {
+		t.Error("delivered email not marked as sent once the worker was stopped")
+	}
+
+	repo = &fakeOutboxRepo{}
+	outbox = NewMailOutbox(repo, &fakeTransport{err: errors.New("smtp down")}, cfg, logger.New("error"))
+	outbox.deliver(ctx, &domain.OutboxEmail{ID: 2, Attempts: 1}, time.Second)
+	if len(repo.failed) != 1 {
+		t.Error("failed delivery not scheduled for retry once the worker was stopped")
+	}
+}
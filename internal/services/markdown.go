@@ -0,0 +1,51 @@
+package services
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/markdown"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+var ErrMarkdownTooLong = errors.New("text is too long")
+
+// MarkdownService renders post bodies to sanitized HTML.
+type MarkdownService struct {
+	renderer *markdown.Renderer
+	config   config.MarkdownConfig
+	log      *logger.Logger
+}
+
+func NewMarkdownService(renderer *markdown.Renderer, cfg config.MarkdownConfig, log *logger.Logger) *MarkdownService {
+	return &MarkdownService{renderer: renderer, config: cfg, log: log}
+}
+
+func (s *MarkdownService) Render(source string) (string, error) {
+	html, err := s.renderer.Render(source)
+	if err != nil {
+		s.log.Error("failed to render markdown", "error", err)
+		return "", err
+	}
+	return html, nil
+}
+
+// Preview renders text as it would appear once posted. Unlike Render it
+// rejects texts longer than MaxLength: previews are requested on every
+// keystroke pause and are never stored, so rendering huge drafts would
+// only burn CPU.
+func (s *MarkdownService) Preview(text string) (string, error) {
+	if utf8.RuneCountInString(text) > s.config.MaxLength {
+		return "", ErrMarkdownTooLong
+	}
+	return s.Render(text)
+}
+
+func (s *MarkdownService) MaxLength() int {
+	return s.config.MaxLength
+}
+
+func (s *MarkdownService) Stylesheet() string {
+	return s.renderer.Stylesheet()
+}
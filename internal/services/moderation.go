@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+const (
+	DefaultQueuePageSize = 20
+	MaxQueuePageSize     = 100
+)
+
+var (
+	ErrInvalidFlagTarget       = errors.New("invalid flag target")
+	ErrFlagTargetNotFound      = errors.New("post not found")
+	ErrInvalidFlagReason       = errors.New("invalid flag reason")
+	ErrAlreadyFlagged          = errors.New("you have already flagged this post")
+	ErrPostDeleted             = errors.New("post has been deleted")
+	ErrPostLocked              = errors.New("post is locked")
+	ErrInvalidModerationAction = errors.New("invalid moderation action")
+	ErrWarnedUserNotFound      = errors.New("post author to warn not found")
+	ErrNoPendingFlags          = errors.New("post has no pending flags")
+	ErrAlreadyModerated        = errors.New("action was already taken on this post")
+	ErrCannotBanSelf           = errors.New("you cannot ban yourself")
+	ErrBannedUserNotFound      = errors.New("user to ban not found")
+)
+
+// actionPermissions maps each moderation action to the permission it needs.
+var actionPermissions = map[string]string{
+	domain.ModerationDismiss: domain.PermissionFlagReview,
+	domain.ModerationDelete:  domain.PermissionPostDelete,
+	domain.ModerationLock:    domain.PermissionPostLock,
+	domain.ModerationWarn:    domain.PermissionUserWarn,
+}
+
+// ModerationService takes flags from users and lets moderators act on the
+// flagged posts. Deleting and locking are recorded here rather than on the
+// post, and PostService asks Deleted and Locked before showing or changing
+// a post. A post is deleted or locked at most once.
+type ModerationService struct {
+	flags         domain.FlagRepository
+	actions       domain.ModerationActionRepository
+	posts         domain.PostRepository
+	comments      domain.CommentRepository
+	users         domain.UserRepository
+	notifications *NotificationService
+	permissions   *PermissionService
+	tokens        *TokenService
+	log           *logger.Logger
+}
+
+func NewModerationService(flags domain.FlagRepository, actions domain.ModerationActionRepository, posts domain.PostRepository, comments domain.CommentRepository, users domain.UserRepository, notifications *NotificationService, permissions *PermissionService, tokens *TokenService, log *logger.Logger) *ModerationService {
+	return &ModerationService{flags: flags, actions: actions, posts: posts, comments: comments, users: users, notifications: notifications, permissions: permissions, tokens: tokens, log: log}
+}
+
+// Flag reports a post on behalf of flag.ReporterID.
+func (s *ModerationService) Flag(ctx context.Context, flag *domain.Flag) error {
+	if !slices.Contains(domain.FlagTargetTypes, flag.TargetType) || flag.TargetID <= 0 {
+		return ErrInvalidFlagTarget
+	}
+	if !slices.Contains(domain.FlagReasons, flag.Reason) {
+		return ErrInvalidFlagReason
+	}
+	if _, err := s.author(ctx, flag.TargetType, flag.TargetID); err != nil {
+		return err
+	}
+
+	deleted, err := s.Deleted(ctx, flag.TargetType, flag.TargetID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return ErrPostDeleted
+	}
+
+	created, err := s.flags.Create(ctx, flag)
+	if err != nil {
+		s.log.Error("failed to flag post", "reporter_id", flag.ReporterID, "target_type", flag.TargetType, "target_id", flag.TargetID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if !created {
+		return ErrAlreadyFlagged
+	}
+	s.log.Info("post flagged", "flag_id", flag.ID, "reporter_id", flag.ReporterID, "target_type", flag.TargetType, "target_id", flag.TargetID, "reason", flag.Reason)
+	return nil
+}
+
+// Queue returns a page of posts with pending flags, most flagged first, and
+// how many such posts there are. reason limits it to posts flagged for that
+// reason unless it is empty.
+func (s *ModerationService) Queue(ctx context.Context, reason string, limit, offset int) ([]*domain.FlaggedPost, int, error) {
+	if reason != "" && !slices.Contains(domain.FlagReasons, reason) {
+		return nil, 0, ErrInvalidFlagReason
+	}
+	if limit <= 0 {
+		limit = DefaultQueuePageSize
+	}
+	limit = min(limit, MaxQueuePageSize)
+	offset = max(offset, 0)
+
+	posts, err := s.flags.Queue(ctx, reason, limit, offset)
+	if err != nil {
+		s.log.Error("failed to load moderation queue", "error", err)
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	total, err := s.flags.CountQueue(ctx, reason)
+	if err != nil {
+		s.log.Error("failed to count moderation queue", "error", err)
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	return posts, total, nil
+}
+
+// Review returns every flag of a post and every action taken on it, newest
+// first.
+func (s *ModerationService) Review(ctx context.Context, targetType string, targetID int64) ([]*domain.Flag, []*domain.ModerationAction, error) {
+	if !slices.Contains(domain.FlagTargetTypes, targetType) {
+		return nil, nil, ErrInvalidFlagTarget
+	}
+
+	flags, err := s.flags.ListForTarget(ctx, targetType, targetID)
+	if err != nil {
+		s.log.Error("failed to list flags", "target_type", targetType, "target_id", targetID, "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+	actions, err := s.actions.ListForTarget(ctx, targetType, targetID)
+	if err != nil {
+		s.log.Error("failed to list moderation actions", "target_type", targetType, "target_id", targetID, "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+	return flags, actions, nil
+}
+
+// Act records action, resolving the post's pending flags, and returns how
+// many flags it resolved. action.ModeratorID needs the permission of the
+// action. A warning goes to the post's author, who is recorded in
+// action.UserID and notified; any UserID the caller set is ignored.
+func (s *ModerationService) Act(ctx context.Context, action *domain.ModerationAction) (int64, error) {
+	if !slices.Contains(domain.FlagTargetTypes, action.TargetType) || action.TargetID <= 0 {
+		return 0, ErrInvalidFlagTarget
+	}
+	permission, ok := actionPermissions[action.Action]
+	if !ok {
+		return 0, ErrInvalidModerationAction
+	}
+	allowed, err := s.permissions.UserHas(ctx, action.ModeratorID, permission)
+	if err != nil {
+		return 0, err
+	}
+	if !allowed {
+		return 0, ErrPermissionDenied
+	}
+	authorID, err := s.author(ctx, action.TargetType, action.TargetID)
+	if err != nil {
+		return 0, err
+	}
+	action.UserID = 0
+
+	switch action.Action {
+	case domain.ModerationDismiss:
+		flags, err := s.flags.ListForTarget(ctx, action.TargetType, action.TargetID)
+		if err != nil {
+			s.log.Error("failed to list flags", "target_type", action.TargetType, "target_id", action.TargetID, "error", err)
+			return 0, fmt.Errorf("database error: %v", err)
+		}
+		if !slices.ContainsFunc(flags, func(f *domain.Flag) bool { return f.ResolvedAt == nil }) {
+			return 0, ErrNoPendingFlags
+		}
+	case domain.ModerationDelete, domain.ModerationLock:
+		done, err := s.taken(ctx, action.TargetType, action.TargetID, action.Action)
+		if err != nil {
+			return 0, err
+		}
+		if done {
+			return 0, ErrAlreadyModerated
+		}
+	case domain.ModerationWarn:
+		if authorID == 0 {
+			return 0, ErrWarnedUserNotFound
+		}
+		action.UserID = authorID
+	}
+
+	resolved, err := s.actions.Create(ctx, action)
+	if errors.Is(err, domain.ErrUniqueViolation) {
+		// Another moderator deleted or locked the post in the meantime.
+		return 0, ErrAlreadyModerated
+	}
+	if err != nil {
+		s.log.Error("failed to record moderation action", "moderator_id", action.ModeratorID, "action", action.Action, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("moderation action taken",
+		"action_id", action.ID, "moderator_id", action.ModeratorID, "action", action.Action,
+		"target_type", action.TargetType, "target_id", action.TargetID, "resolved_flags", resolved)
+
+	if action.Action == domain.ModerationWarn {
+		s.notifications.Warned(ctx, action.UserID, action.TargetType, action.TargetID, action.Reason)
+	}
+	return resolved, nil
+}
+
+// Ban bans userID on behalf of actorID and signs them out everywhere. A
+// banned user cannot sign in, and permission checks treat them as having
+// none; access tokens already issued still authenticate until they expire.
+// Banning a banned user changes nothing.
+func (s *ModerationService) Ban(ctx context.Context, actorID, userID int64) (*domain.User, error) {
+	if actorID == userID {
+		return nil, ErrCannotBanSelf
+	}
+	user, err := s.bannedUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.BannedAt != nil {
+		return user, nil
+	}
+
+	now := time.Now()
+	if err := s.users.SetBanned(ctx, userID, &now); err != nil {
+		s.log.Error("failed to ban user", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if err := s.tokens.RevokeOtherSessions(ctx, strconv.FormatInt(userID, 10), ""); err != nil {
+		s.log.Error("failed to revoke sessions after ban", "user_id", userID, "error", err)
+	}
+	s.log.Info("user banned", "actor_id", actorID, "user_id", userID)
+
+	user.BannedAt = &now
+	return user, nil
+}
+
+// Unban lifts the ban of userID on behalf of actorID.
+func (s *ModerationService) Unban(ctx context.Context, actorID, userID int64) (*domain.User, error) {
+	user, err := s.bannedUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.BannedAt == nil {
+		return user, nil
+	}
+
+	if err := s.users.SetBanned(ctx, userID, nil); err != nil {
+		s.log.Error("failed to unban user", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("user unbanned", "actor_id", actorID, "user_id", userID)
+
+	user.BannedAt = nil
+	return user, nil
+}
+
+func (s *ModerationService) bannedUser(ctx context.Context, userID int64) (*domain.User, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to look up user", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil {
+		return nil, ErrBannedUserNotFound
+	}
+	return user, nil
+}
+
+// Deleted reports whether a moderator deleted the post.
+func (s *ModerationService) Deleted(ctx context.Context, targetType string, targetID int64) (bool, error) {
+	return s.taken(ctx, targetType, targetID, domain.ModerationDelete)
+}
+
+// Locked reports whether a moderator locked the post against edits, answers
+// and comments.
+func (s *ModerationService) Locked(ctx context.Context, targetType string, targetID int64) (bool, error) {
+	return s.taken(ctx, targetType, targetID, domain.ModerationLock)
+}
+
+// open returns ErrPostDeleted or ErrPostLocked unless the post can still be
+// changed.
+func (s *ModerationService) open(ctx context.Context, targetType string, targetID int64) error {
+	deleted, err := s.Deleted(ctx, targetType, targetID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return ErrPostDeleted
+	}
+	locked, err := s.Locked(ctx, targetType, targetID)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return ErrPostLocked
+	}
+	return nil
+}
+
+// author returns the ID of the post's author, 0 once the author's account
+// has been deleted, or ErrFlagTargetNotFound unless the post is stored.
+func (s *ModerationService) author(ctx context.Context, targetType string, targetID int64) (int64, error) {
+	var authorID int64
+	var found bool
+	var err error
+	switch targetType {
+	case domain.FlagTargetQuestion:
+		var question *domain.Question
+		question, err = s.posts.GetQuestion(ctx, targetID)
+		if question != nil {
+			authorID, found = question.AuthorID, true
+		}
+	case domain.FlagTargetAnswer:
+		var answer *domain.Answer
+		answer, err = s.posts.GetAnswer(ctx, targetID)
+		if answer != nil {
+			authorID, found = answer.AuthorID, true
+		}
+	case domain.FlagTargetComment:
+		var comment *domain.Comment
+		comment, err = s.comments.GetByID(ctx, targetID)
+		if comment != nil {
+			authorID, found = comment.AuthorID, true
+		}
+	}
+	if err != nil {
+		s.log.Error("failed to look up moderated post", "target_type", targetType, "target_id", targetID, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	if !found {
+		return 0, ErrFlagTargetNotFound
+	}
+	return authorID, nil
+}
+
+func (s *ModerationService) taken(ctx context.Context, targetType string, targetID int64, action string) (bool, error) {
+	exists, err := s.actions.Exists(ctx, targetType, targetID, action)
+	if err != nil {
+		s.log.Error("failed to look up moderation action", "target_type", targetType, "target_id", targetID, "action", action, "error", err)
+		return false, fmt.Errorf("database error: %v", err)
+	}
+	return exists, nil
+}
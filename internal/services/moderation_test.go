@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+func TestBanRevokesSessionsAndPermissions(t *testing.T) {
+	permissions, _, _ := newTestPermissionService()
+	users := &fakeRoleUserRepo{users: map[int64]*domain.User{
+		1: {ID: 1, Role: domain.RoleAdmin},
+		2: {ID: 2, Role: domain.RoleModerator},
+	}}
+	permissions.users = users
+	tokenRepo := &fakeTokenRepo{}
+	svc := NewModerationService(nil, nil, nil, nil, users, nil, permissions, NewTokenService(tokenRepo, config.JWTConfig{}), logger.New("error"))
+	ctx := context.Background()
+
+	if _, err := svc.Ban(ctx, 1, 1); !errors.Is(err, ErrCannotBanSelf) {
+		t.Errorf("banning yourself: err = %v, want ErrCannotBanSelf", err)
+	}
+	if _, err := svc.Ban(ctx, 1, 9); !errors.Is(err, ErrBannedUserNotFound) {
+		t.Errorf("banning an unknown user: err = %v, want ErrBannedUserNotFound", err)
+	}
+
+	user, err := svc.Ban(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("Ban: %v", err)
+	}
+	if user.BannedAt == nil {
+		t.Error("banned user has no ban time")
+	}
+	if !slices.Equal(tokenRepo.revoked, []string{"2"}) {
+		t.Errorf("revoked sessions of %v, want [2]", tokenRepo.revoked)
+	}
+	if allowed, _ := permissions.UserHas(ctx, 2, domain.PermissionFlagReview); allowed {
+		t.Error("banned moderator kept their permissions")
+	}
+
+	if _, err := svc.Unban(ctx, 1, 2); err != nil {
+		t.Fatalf("Unban: %v", err)
+	}
+	if allowed, _ := permissions.UserHas(ctx, 2, domain.PermissionFlagReview); !allowed {
+		t.Error("unbanned moderator lost their permissions")
+	}
+}
+
+func TestActNeedsAnExistingPostAndDeletesOnce(t *testing.T) {
+	permissions, repo, _ := newTestPermissionService()
+	repo.roles[domain.RoleModerator] = append(repo.roles[domain.RoleModerator], domain.PermissionPostDelete)
+	users := &fakeRoleUserRepo{users: map[int64]*domain.User{2: {ID: 2, Role: domain.RoleModerator}}}
+	permissions.users = users
+	posts := &fakePostRepo{questions: map[int64]*domain.Question{1: {ID: 1, AuthorID: 1}}}
+	actions := &fakeActionRepo{taken: map[string]bool{}}
+	svc := NewModerationService(nil, actions, posts, nil, users, nil, permissions, nil, logger.New("error"))
+	ctx := context.Background()
+
+	err := svc.Flag(ctx, &domain.Flag{TargetType: domain.FlagTargetQuestion, TargetID: 9, ReporterID: 3, Reason: domain.FlagSpam})
+	if !errors.Is(err, ErrFlagTargetNotFound) {
+		t.Errorf("flag of a missing question: err = %v, want ErrFlagTargetNotFound", err)
+	}
+	remove := func(id int64) *domain.ModerationAction {
+		return &domain.ModerationAction{TargetType: domain.FlagTargetQuestion, TargetID: id, ModeratorID: 2, Action: domain.ModerationDelete, Reason: "spam"}
+	}
+	if _, err := svc.Act(ctx, remove(9)); !errors.Is(err, ErrFlagTargetNotFound) {
+		t.Errorf("delete of a missing question: err = %v, want ErrFlagTargetNotFound", err)
+	}
+
+	actions.raced = true
+	if _, err := svc.Act(ctx, remove(1)); !errors.Is(err, ErrAlreadyModerated) {
+		t.Errorf("delete racing another moderator: err = %v, want ErrAlreadyModerated", err)
+	}
+	actions.raced = false
+	if _, err := svc.Act(ctx, remove(1)); err != nil {
+		t.Fatalf("Act: %v", err)
+	}
+	if _, err := svc.Act(ctx, remove(1)); !errors.Is(err, ErrAlreadyModerated) {
+		t.Errorf("second delete: err = %v, want ErrAlreadyModerated", err)
+	}
+}
+
+func TestWarnGoesToThePostAuthor(t *testing.T) {
+	permissions, repo, _ := newTestPermissionService()
+	repo.roles[domain.RoleModerator] = append(repo.roles[domain.RoleModerator], domain.PermissionUserWarn)
+	users := &fakeRoleUserRepo{users: map[int64]*domain.User{2: {ID: 2, Role: domain.RoleModerator}}}
+	permissions.users = users
+	posts := &fakePostRepo{
+		questions: map[int64]*domain.Question{1: {ID: 1, AuthorID: 1}},
+		answers:   map[int64]*domain.Answer{2: {ID: 2, QuestionID: 1}},
+	}
+	log := logger.New("error")
+	notifications := &fakeNotificationRepo{}
+	email := NewNotificationEmailService(notifications, &fakePreferenceRepo{}, fakeUserRepo{}, nil, config.NotificationConfig{}, "", log)
+	notificationSvc := NewNotificationService(notifications, fakeUserRepo{}, fakeBroker{}, email, log)
+	svc := NewModerationService(nil, &fakeActionRepo{taken: map[string]bool{}}, posts, nil, users, notificationSvc, permissions, nil, log)
+	ctx := context.Background()
+
+	action := &domain.ModerationAction{TargetType: domain.FlagTargetQuestion, TargetID: 1, ModeratorID: 2, Action: domain.ModerationWarn, Reason: "spam", UserID: 5}
+	if _, err := svc.Act(ctx, action); err != nil {
+		t.Fatalf("Act: %v", err)
+	}
+	if action.UserID != 1 {
+		t.Errorf("warning recorded for user %d, want the author 1", action.UserID)
+	}
+	if len(notifications.created) != 1 || notifications.created[0].UserID != 1 {
+		t.Errorf("warning notified %+v, want the author 1", notifications.created)
+	}
+
+	orphaned := &domain.ModerationAction{TargetType: domain.FlagTargetAnswer, TargetID: 2, ModeratorID: 2, Action: domain.ModerationWarn, Reason: "spam", UserID: 5}
+	if _, err := svc.Act(ctx, orphaned); !errors.Is(err, ErrWarnedUserNotFound) {
+		t.Errorf("warn on a post whose author is gone: err = %v, want ErrWarnedUserNotFound", err)
+	}
+}
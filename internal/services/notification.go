@@ -0,0 +1,294 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+const (
+	DefaultNotificationPageSize = 20
+	MaxNotificationPageSize     = 100
+
+	// excerptLength is the number of characters of a post kept as preview.
+	excerptLength = 140
+	// maxMentions bounds how many users a single post can notify.
+	maxMentions = 10
+)
+
+// reputationMilestones are the ratings users are congratulated on reaching.
+var reputationMilestones = []int{10, 50, 100, 500, 1000, 5000, 10000}
+
+// mentionPattern matches @login where login follows the registration rules;
+// the prefix keeps email addresses from counting as mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z0-9]{3,20})\b`)
+
+// NotificationService records in-app notifications. The producer methods are
+// called by the code that changes content, after the change is saved; a
+// failed notification is logged and never fails the change itself.
+type NotificationService struct {
+	repo   domain.NotificationRepository
+	users  domain.UserRepository
+	broker domain.NotificationBroker
+	email  *NotificationEmailService
+	log    *logger.Logger
+}
+
+func NewNotificationService(repo domain.NotificationRepository, users domain.UserRepository, broker domain.NotificationBroker, email *NotificationEmailService, log *logger.Logger) *NotificationService {
+	return &NotificationService{repo: repo, users: users, broker: broker, email: email, log: log}
+}
+
+// AnswerPosted notifies the author of a question about a new answer.
+func (s *NotificationService) AnswerPosted(ctx context.Context, questionAuthorID, answererID, answerID int64, body string) {
+	s.notify(ctx, &domain.Notification{
+		UserID:     questionAuthorID,
+		Type:       domain.NotificationAnswer,
+		ActorID:    answererID,
+		TargetType: domain.NotificationTargetAnswer,
+		TargetID:   answerID,
+		Excerpt:    excerpt(body),
+	})
+}
+
+// CommentPosted notifies the author of a question or answer about a comment.
+func (s *NotificationService) CommentPosted(ctx context.Context, postAuthorID, commenterID, commentID int64, body string) {
+	s.notify(ctx, &domain.Notification{
+		UserID:     postAuthorID,
+		Type:       domain.NotificationComment,
+		ActorID:    commenterID,
+		TargetType: domain.NotificationTargetComment,
+		TargetID:   commentID,
+		Excerpt:    excerpt(body),
+	})
+}
+
+// Mentioned notifies every user mentioned as @login in text. Users in skip,
+// typically those already notified about the same post, are left out.
+func (s *NotificationService) Mentioned(ctx context.Context, actorID int64, targetType string, targetID int64, text string, skip ...int64) {
+	var notifications []*domain.Notification
+	for _, login := range mentions(text) {
+		user, err := s.users.GetByLogin(ctx, login)
+		if err != nil {
+			s.log.Error("failed to look up mentioned user", "login", login, "error", err)
+			continue
+		}
+		if user == nil || slices.Contains(skip, user.ID) {
+			continue
+		}
+		notifications = append(notifications, &domain.Notification{
+			UserID:     user.ID,
+			Type:       domain.NotificationMention,
+			ActorID:    actorID,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Excerpt:    excerpt(text),
+		})
+	}
+	s.notify(ctx, notifications...)
+}
+
+// AnswerAccepted notifies the author of an answer that it was accepted.
+func (s *NotificationService) AnswerAccepted(ctx context.Context, answerAuthorID, accepterID, answerID int64) {
+	s.notify(ctx, &domain.Notification{
+		UserID:     answerAuthorID,
+		Type:       domain.NotificationAcceptedAnswer,
+		ActorID:    accepterID,
+		TargetType: domain.NotificationTargetAnswer,
+		TargetID:   answerID,
+	})
+}
+
+// RatingChanged congratulates userID on the highest milestone passed when
+// their rating goes from oldRating to newRating.
+func (s *NotificationService) RatingChanged(ctx context.Context, userID int64, oldRating, newRating int) {
+	reached := 0
+	for _, milestone := range reputationMilestones {
+		if oldRating < milestone && newRating >= milestone {
+			reached = milestone
+		}
+	}
+	if reached == 0 {
+		return
+	}
+	s.notify(ctx, &domain.Notification{
+		UserID:    userID,
+		Type:      domain.NotificationReputationMilestone,
+		Milestone: reached,
+	})
+}
+
+// Warned tells userID that a moderator warned them about a post. The
+// moderator stays anonymous.
+func (s *NotificationService) Warned(ctx context.Context, userID int64, targetType string, targetID int64, reason string) {
+	s.notify(ctx, &domain.Notification{
+		UserID:     userID,
+		Type:       domain.NotificationModeratorWarning,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Excerpt:    excerpt(reason),
+	})
+}
+
+// notify stores notifications, dropping those users would get about their
+// own actions, and publishes them to connected streams.
+func (s *NotificationService) notify(ctx context.Context, notifications ...*domain.Notification) {
+	notifications = slices.DeleteFunc(notifications, func(n *domain.Notification) bool {
+		return n.UserID == 0 || n.UserID == n.ActorID
+	})
+	deliveries := s.deliveries(ctx, notifications)
+	notifications = slices.DeleteFunc(notifications, func(n *domain.Notification) bool {
+		return deliveries[n] == domain.DeliveryOff
+	})
+	if len(notifications) == 0 {
+		return
+	}
+	if err := s.repo.Create(ctx, notifications); err != nil {
+		s.log.Error("failed to create notifications", "type", notifications[0].Type, "count", len(notifications), "error", err)
+		return
+	}
+	s.log.Debug("notifications created", "type", notifications[0].Type, "count", len(notifications))
+
+	for _, n := range notifications {
+		if err := s.broker.Publish(ctx, n); err != nil {
+			// Streams pick the notification up from the database when the
+			// client reconnects.
+			s.log.Error("failed to publish notification", "notification_id", n.ID, "error", err)
+		}
+		if deliveries[n] == domain.DeliveryEmail {
+			s.email.SendInstant(ctx, n)
+		}
+	}
+}
+
+// deliveries looks up how each recipient wants to receive the notification
+// meant for them. Preferences that cannot be loaded count as in-app.
+func (s *NotificationService) deliveries(ctx context.Context, notifications []*domain.Notification) map[*domain.Notification]string {
+	prefs := make(map[int64]domain.NotificationPreferences)
+	deliveries := make(map[*domain.Notification]string, len(notifications))
+	for _, n := range notifications {
+		userPrefs, ok := prefs[n.UserID]
+		if !ok {
+			userPrefs, _ = s.email.Preferences(ctx, n.UserID)
+			prefs[n.UserID] = userPrefs
+		}
+		deliveries[n] = domain.DeliveryInApp
+		if delivery, ok := userPrefs[n.Type]; ok {
+			deliveries[n] = delivery
+		}
+	}
+	return deliveries
+}
+
+// List returns a page of userID's notifications, newest first, together with
+// the users who caused them keyed by ID.
+func (s *NotificationService) List(ctx context.Context, userID, beforeID int64, limit int, unreadOnly bool) ([]*domain.Notification, map[int64]*domain.User, error) {
+	if limit <= 0 {
+		limit = DefaultNotificationPageSize
+	}
+	limit = min(limit, MaxNotificationPageSize)
+
+	notifications, err := s.repo.List(ctx, userID, beforeID, limit, unreadOnly)
+	if err != nil {
+		s.log.Error("failed to list notifications", "user_id", userID, "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+	actors, err := s.Actors(ctx, notifications)
+	if err != nil {
+		return nil, nil, err
+	}
+	return notifications, actors, nil
+}
+
+// Since returns up to limit notifications of userID newer than afterID,
+// oldest first. Streams use it to replay what a client missed.
+func (s *NotificationService) Since(ctx context.Context, userID, afterID int64, limit int) ([]*domain.Notification, error) {
+	notifications, err := s.repo.ListAfter(ctx, userID, afterID, limit)
+	if err != nil {
+		s.log.Error("failed to list notifications", "user_id", userID, "after_id", afterID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return notifications, nil
+}
+
+// Actors loads the users who caused notifications, keyed by ID.
+func (s *NotificationService) Actors(ctx context.Context, notifications []*domain.Notification) (map[int64]*domain.User, error) {
+	actors, err := usersByID(ctx, s.users, actorIDs(notifications))
+	if err != nil {
+		s.log.Error("failed to load notification actors", "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return actors, nil
+}
+
+// actorIDs returns the distinct actors of notifications.
+func actorIDs(notifications []*domain.Notification) []int64 {
+	ids := make([]int64, 0, len(notifications))
+	for _, n := range notifications {
+		if n.ActorID != 0 && !slices.Contains(ids, n.ActorID) {
+			ids = append(ids, n.ActorID)
+		}
+	}
+	return ids
+}
+
+func (s *NotificationService) UnreadCount(ctx context.Context, userID int64) (int, error) {
+	count, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to count unread notifications", "user_id", userID, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks the given notifications of userID as read; IDs of other
+// users' notifications are ignored. It returns how many changed.
+func (s *NotificationService) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	marked, err := s.repo.MarkRead(ctx, userID, ids)
+	if err != nil {
+		s.log.Error("failed to mark notifications as read", "user_id", userID, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	return marked, nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	marked, err := s.repo.MarkAllRead(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to mark all notifications as read", "user_id", userID, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	return marked, nil
+}
+
+// mentions returns the distinct logins mentioned in text, lowercased, in
+// order of appearance and at most maxMentions of them.
+func mentions(text string) []string {
+	var logins []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		login := strings.ToLower(match[1])
+		if slices.Contains(logins, login) {
+			continue
+		}
+		logins = append(logins, login)
+		if len(logins) == maxMentions {
+			break
+		}
+	}
+	return logins
+}
+
+// excerpt collapses whitespace in text and shortens it to excerptLength
+// characters.
+func excerpt(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= excerptLength {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:excerptLength-1])) + "…"
+}
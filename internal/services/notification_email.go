@@ -0,0 +1,364 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/mail"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+var ErrInvalidNotificationPreference = errors.New("invalid notification preference")
+
+// digestCheckInterval is how often the digest worker looks for due digests.
+const digestCheckInterval = 10 * time.Minute
+
+// NotificationEmailService keeps notification preferences and emails
+// notifications, right away or bundled in daily and weekly digests.
+type NotificationEmailService struct {
+	notifications domain.NotificationRepository
+	prefs         domain.NotificationPreferenceRepository
+	users         domain.UserRepository
+	mailer        domain.EmailSender
+	signer        unsubscribeSigner
+	config        config.NotificationConfig
+	baseURL       string
+	log           *logger.Logger
+}
+
+func NewNotificationEmailService(notifications domain.NotificationRepository, prefs domain.NotificationPreferenceRepository, users domain.UserRepository, mailer domain.EmailSender, cfg config.NotificationConfig, baseURL string, log *logger.Logger) *NotificationEmailService {
+	return &NotificationEmailService{
+		notifications: notifications,
+		prefs:         prefs,
+		users:         users,
+		mailer:        mailer,
+		signer:        newUnsubscribeSigner(cfg.UnsubscribeSecret),
+		config:        cfg,
+		baseURL:       baseURL,
+		log:           log,
+	}
+}
+
+// Preferences returns the delivery of every notification type for userID.
+func (s *NotificationEmailService) Preferences(ctx context.Context, userID int64) (domain.NotificationPreferences, error) {
+	stored, err := s.prefs.Get(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to load notification preferences", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	prefs := make(domain.NotificationPreferences, len(domain.NotificationTypes))
+	for _, t := range domain.NotificationTypes {
+		prefs[t] = domain.DeliveryInApp
+		if delivery, ok := stored[t]; ok {
+			prefs[t] = delivery
+		}
+	}
+	return prefs, nil
+}
+
+// UpdatePreferences changes the given types and returns all preferences.
+func (s *NotificationEmailService) UpdatePreferences(ctx context.Context, userID int64, changes domain.NotificationPreferences) (domain.NotificationPreferences, error) {
+	for t, delivery := range changes {
+		if !slices.Contains(domain.NotificationTypes, t) || !validDelivery(delivery) {
+			return nil, ErrInvalidNotificationPreference
+		}
+	}
+	if err := s.prefs.Set(ctx, userID, changes); err != nil {
+		s.log.Error("failed to save notification preferences", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("notification preferences updated", "user_id", userID)
+	return s.Preferences(ctx, userID)
+}
+
+// CheckUnsubscribe validates an unsubscribe token without acting on it and
+// returns the notification type it is for, "" for all of them.
+func (s *NotificationEmailService) CheckUnsubscribe(ctx context.Context, token string) (string, error) {
+	parsed, err := s.checkUnsubscribeToken(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Type, nil
+}
+
+// Unsubscribe stops emails for the user and type in a signed unsubscribe
+// token; the notifications stay visible in the app.
+func (s *NotificationEmailService) Unsubscribe(ctx context.Context, token string) error {
+	parsed, err := s.checkUnsubscribeToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	userID, notificationType := parsed.UserID, parsed.Type
+
+	prefs, err := s.Preferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	changes := make(domain.NotificationPreferences)
+	for t, delivery := range prefs {
+		if (notificationType == "" || t == notificationType) && sendsEmail(delivery) {
+			changes[t] = domain.DeliveryInApp
+		}
+	}
+	if err := s.prefs.Set(ctx, userID, changes); err != nil {
+		s.log.Error("failed to unsubscribe", "user_id", userID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("user unsubscribed from notification emails", "user_id", userID, "type", notificationType)
+	return nil
+}
+
+// RevokeUnsubscribeLinks invalidates the unsubscribe links in every email
+// sent to userID so far; later emails carry working links again.
+func (s *NotificationEmailService) RevokeUnsubscribeLinks(ctx context.Context, userID int64) error {
+	version, err := s.prefs.BumpUnsubscribeVersion(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to revoke unsubscribe links", "user_id", userID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("unsubscribe links revoked", "user_id", userID, "version", version)
+	return nil
+}
+
+func (s *NotificationEmailService) checkUnsubscribeToken(ctx context.Context, token string) (unsubscribeToken, error) {
+	parsed, err := s.signer.parse(token)
+	if err != nil {
+		return unsubscribeToken{}, err
+	}
+	version, err := s.prefs.UnsubscribeVersion(ctx, parsed.UserID)
+	if err != nil {
+		s.log.Error("failed to load unsubscribe version", "user_id", parsed.UserID, "error", err)
+		return unsubscribeToken{}, fmt.Errorf("database error: %v", err)
+	}
+	if parsed.Version != version {
+		return unsubscribeToken{}, ErrInvalidUnsubscribeToken
+	}
+	return parsed, nil
+}
+
+// SendInstant emails a stored notification to its recipient.
+func (s *NotificationEmailService) SendInstant(ctx context.Context, n *domain.Notification) {
+	recipient, err := s.users.GetByID(ctx, n.UserID)
+	if err != nil || recipient == nil || !recipient.EmailVerified {
+		return
+	}
+	actors, err := s.actors(ctx, []*domain.Notification{n})
+	if err != nil {
+		return
+	}
+
+	unsubscribeURL, err := s.unsubscribeURL(ctx, n.UserID, n.Type)
+	if err != nil {
+		return
+	}
+
+	data := s.templateData(n, actors)
+	data["UnsubscribeURL"] = unsubscribeURL
+	// Render in the default locale rather than the one of the user whose
+	// request caused the notification.
+	if err := s.mailer.SendTemplate(mail.WithLocale(ctx, ""), recipient.Email, MailNotification, data); err != nil {
+		s.log.Error("failed to email notification", "notification_id", n.ID, "error", err)
+		return
+	}
+	if err := s.notifications.MarkEmailed(ctx, []int64{n.ID}); err != nil {
+		s.log.Error("failed to mark notification as emailed", "notification_id", n.ID, "error", err)
+	}
+}
+
+// RunDigests sends due digests every digestCheckInterval until ctx is done.
+func (s *NotificationEmailService) RunDigests(ctx context.Context) {
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, cadence := range []string{domain.DeliveryDaily, domain.DeliveryWeekly} {
+				sent, err := s.SendDigests(ctx, cadence, now)
+				if err != nil {
+					s.log.Error("failed to send digests", "cadence", cadence, "sent", sent, "error", err)
+					continue
+				}
+				if sent > 0 {
+					s.log.Info("notification digests sent", "cadence", cadence, "count", sent)
+				}
+			}
+		}
+	}
+}
+
+// SendDigests sends the digest of cadence to every user who chose it for some
+// type and has not received the digest of the current period yet.
+func (s *NotificationEmailService) SendDigests(ctx context.Context, cadence string, now time.Time) (int, error) {
+	period, scheduled := s.digestPeriod(cadence, now)
+	userIDs, err := s.prefs.UsersWithDelivery(ctx, cadence)
+	if err != nil {
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+
+	sent := 0
+	for _, userID := range userIDs {
+		claimed, err := s.prefs.ClaimDigest(ctx, userID, cadence, scheduled)
+		if err != nil {
+			return sent, fmt.Errorf("database error: %v", err)
+		}
+		if !claimed {
+			continue
+		}
+		ok, err := s.sendDigest(ctx, userID, cadence, scheduled.Add(-period))
+		if err != nil {
+			s.log.Error("failed to send digest", "user_id", userID, "cadence", cadence, "error", err)
+			// Nothing went out, so give the claim back for the next check
+			// to retry instead of skipping the user until the next period.
+			if !ok {
+				if err := s.prefs.ReleaseDigest(ctx, userID, cadence, scheduled); err != nil {
+					s.log.Error("failed to release digest claim", "user_id", userID, "cadence", cadence, "error", err)
+				}
+			}
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+func (s *NotificationEmailService) sendDigest(ctx context.Context, userID int64, cadence string, since time.Time) (bool, error) {
+	prefs, err := s.Preferences(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	var types []string
+	for t, delivery := range prefs {
+		if delivery == cadence {
+			types = append(types, t)
+		}
+	}
+
+	notifications, err := s.notifications.ListUnemailed(ctx, userID, types, since, s.config.DigestMaxItems)
+	if err != nil {
+		return false, fmt.Errorf("database error: %v", err)
+	}
+	if len(notifications) == 0 {
+		return false, nil
+	}
+
+	recipient, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("database error: %v", err)
+	}
+	if recipient == nil || !recipient.EmailVerified {
+		return false, nil
+	}
+	actors, err := s.actors(ctx, notifications)
+	if err != nil {
+		return false, err
+	}
+
+	unsubscribeURL, err := s.unsubscribeURL(ctx, userID, "")
+	if err != nil {
+		return false, err
+	}
+
+	items := make([]map[string]any, len(notifications))
+	ids := make([]int64, len(notifications))
+	for i, n := range notifications {
+		items[i] = s.templateData(n, actors)
+		ids[i] = n.ID
+	}
+	err = s.mailer.SendTemplate(mail.WithLocale(ctx, ""), recipient.Email, MailDigest, map[string]any{
+		"Items":          items,
+		"UnsubscribeURL": unsubscribeURL,
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := s.notifications.MarkEmailed(ctx, ids); err != nil {
+		return true, fmt.Errorf("database error: %v", err)
+	}
+	return true, nil
+}
+
+// digestPeriod returns the length of a cadence's period and the start of
+// the current one: the last DigestHour (UTC), on DigestWeekday for weekly
+// digests.
+func (s *NotificationEmailService) digestPeriod(cadence string, now time.Time) (time.Duration, time.Time) {
+	now = now.UTC()
+	scheduled := time.Date(now.Year(), now.Month(), now.Day(), s.config.DigestHour, 0, 0, 0, time.UTC)
+	if now.Before(scheduled) {
+		scheduled = scheduled.AddDate(0, 0, -1)
+	}
+	if cadence == domain.DeliveryDaily {
+		return 24 * time.Hour, scheduled
+	}
+	for scheduled.Weekday() != time.Weekday(s.config.DigestWeekday) {
+		scheduled = scheduled.AddDate(0, 0, -1)
+	}
+	return 7 * 24 * time.Hour, scheduled
+}
+
+func (s *NotificationEmailService) actors(ctx context.Context, notifications []*domain.Notification) (map[int64]*domain.User, error) {
+	actors, err := usersByID(ctx, s.users, actorIDs(notifications))
+	if err != nil {
+		s.log.Error("failed to load notification actors", "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return actors, nil
+}
+
+// templateData holds what the notification_title template and the
+// notification email need about n.
+func (s *NotificationEmailService) templateData(n *domain.Notification, actors map[int64]*domain.User) map[string]any {
+	actor := ""
+	if a, ok := actors[n.ActorID]; ok {
+		actor = a.Login
+	}
+	return map[string]any{
+		"Type":      n.Type,
+		"Actor":     actor,
+		"Milestone": n.Milestone,
+		"Excerpt":   n.Excerpt,
+		"URL":       s.notificationURL(n),
+	}
+}
+
+// notificationURL links to the post a notification is about, or to the
+// recipient's profile for milestones.
+func (s *NotificationEmailService) notificationURL(n *domain.Notification) string {
+	if n.TargetType == "" {
+		return s.baseURL + "/users/" + strconv.FormatInt(n.UserID, 10)
+	}
+	return s.baseURL + "/" + n.TargetType + "s/" + strconv.FormatInt(n.TargetID, 10)
+}
+
+func (s *NotificationEmailService) unsubscribeURL(ctx context.Context, userID int64, notificationType string) (string, error) {
+	version, err := s.prefs.UnsubscribeVersion(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to load unsubscribe version", "user_id", userID, "error", err)
+		return "", fmt.Errorf("database error: %v", err)
+	}
+	token := s.signer.token(userID, version, notificationType)
+	return s.baseURL + "/api/notifications/unsubscribe?token=" + url.QueryEscape(token), nil
+}
+
+func validDelivery(delivery string) bool {
+	switch delivery {
+	case domain.DeliveryInApp, domain.DeliveryEmail, domain.DeliveryDaily, domain.DeliveryWeekly, domain.DeliveryOff:
+		return true
+	}
+	return false
+}
+
+func sendsEmail(delivery string) bool {
+	return delivery == domain.DeliveryEmail || delivery == domain.DeliveryDaily || delivery == domain.DeliveryWeekly
+}
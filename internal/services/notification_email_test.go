@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+type fakePreferenceRepo struct {
+	domain.NotificationPreferenceRepository
+	prefs    domain.NotificationPreferences
+	version  int
+	claimed  int
+	released int
+}
+
+func (r *fakePreferenceRepo) Get(context.Context, int64) (domain.NotificationPreferences, error) {
+	return r.prefs, nil
+}
+
+func (r *fakePreferenceRepo) Set(_ context.Context, _ int64, prefs domain.NotificationPreferences) error {
+	for t, delivery := range prefs {
+		r.prefs[t] = delivery
+	}
+	return nil
+}
+
+func (r *fakePreferenceRepo) UsersWithDelivery(context.Context, string) ([]int64, error) {
+	return []int64{1}, nil
+}
+
+func (r *fakePreferenceRepo) ClaimDigest(context.Context, int64, string, time.Time) (bool, error) {
+	if r.claimed > r.released {
+		return false, nil
+	}
+	r.claimed++
+	return true, nil
+}
+
+func (r *fakePreferenceRepo) ReleaseDigest(context.Context, int64, string, time.Time) error {
+	r.released++
+	return nil
+}
+
+func (r *fakePreferenceRepo) UnsubscribeVersion(context.Context, int64) (int, error) {
+	return r.version, nil
+}
+
+func (r *fakePreferenceRepo) BumpUnsubscribeVersion(context.Context, int64) (int, error) {
+	r.version++
+	return r.version, nil
+}
+
+type fakeNotificationRepo struct {
+	domain.NotificationRepository
+	created []*domain.Notification
+	emailed []int64
+}
+
+func (r *fakeNotificationRepo) Create(_ context.Context, notifications []*domain.Notification) error {
+	r.created = append(r.created, notifications...)
+	return nil
+}
+
+func (r *fakeNotificationRepo) ListUnemailed(context.Context, int64, []string, time.Time, int) ([]*domain.Notification, error) {
+	return []*domain.Notification{{ID: 10, UserID: 1, Type: domain.NotificationAnswer, TargetType: "question", TargetID: 3}}, nil
+}
+
+func (r *fakeNotificationRepo) MarkEmailed(_ context.Context, ids []int64) error {
+	r.emailed = append(r.emailed, ids...)
+	return nil
+}
+
+type fakeUserRepo struct {
+	domain.UserRepository
+}
+
+func (fakeUserRepo) GetByID(_ context.Context, id int64) (*domain.User, error) {
+	return &domain.User{ID: id, Login: "member", Email: "user@example.com", EmailVerified: true}, nil
+}
+
+func (r fakeUserRepo) GetByIDs(ctx context.Context, ids []int64) ([]*domain.User, error) {
+	users := make([]*domain.User, len(ids))
+	for i, id := range ids {
+		users[i], _ = r.GetByID(ctx, id)
+	}
+	return users, nil
+}
+
+type fakeEmailSender struct {
+	domain.EmailSender
+	err  error
+	sent []map[string]any
+}
+
+func (m *fakeEmailSender) SendTemplate(_ context.Context, _, _ string, data map[string]any) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, data)
+	return nil
+}
+
+func newTestNotificationEmailService(mailer *fakeEmailSender) (*NotificationEmailService, *fakePreferenceRepo, *fakeNotificationRepo) {
+	prefs := &fakePreferenceRepo{prefs: domain.NotificationPreferences{domain.NotificationAnswer: domain.DeliveryDaily}}
+	notifications := &fakeNotificationRepo{}
+	svc := NewNotificationEmailService(notifications, prefs, fakeUserRepo{}, mailer, config.NotificationConfig{
+		DigestHour:        8,
+		DigestMaxItems:    10,
+		UnsubscribeSecret: "secret",
+	}, "https://usof.example", logger.New("error"))
+	return svc, prefs, notifications
+}
+
+func TestUnsubscribeToken(t *testing.T) {
+	signer := newUnsubscribeSigner("secret")
+
+	parsed, err := signer.parse(signer.token(42, 3, domain.NotificationMention))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if want := (unsubscribeToken{UserID: 42, Version: 3, Type: domain.NotificationMention}); parsed != want {
+		t.Errorf("parsed %+v, want %+v", parsed, want)
+	}
+
+	all, err := signer.parse(signer.token(42, 0, ""))
+	if err != nil || all.Type != "" {
+		t.Errorf("token for all types parsed as %+v, %v", all, err)
+	}
+
+	if _, err := newUnsubscribeSigner("other").parse(signer.token(42, 0, "")); !errors.Is(err, ErrInvalidUnsubscribeToken) {
+		t.Errorf("token signed with another secret: err = %v", err)
+	}
+}
+
+func TestUnsubscribeTokenWithoutVersion(t *testing.T) {
+	signer := newUnsubscribeSigner("secret")
+	payload := "42:" + domain.NotificationAnswer
+	legacy := base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(signer.sign(payload))
+
+	parsed, err := signer.parse(legacy)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if parsed.Version != 0 || parsed.Type != domain.NotificationAnswer {
+		t.Errorf("parsed %+v, want version 0 for answers", parsed)
+	}
+}
+
+func TestRevokeUnsubscribeLinks(t *testing.T) {
+	svc, _, _ := newTestNotificationEmailService(&fakeEmailSender{})
+	ctx := context.Background()
+	token := svc.signer.token(1, 0, domain.NotificationAnswer)
+
+	if _, err := svc.CheckUnsubscribe(ctx, token); err != nil {
+		t.Fatalf("CheckUnsubscribe before revoking: %v", err)
+	}
+	if err := svc.RevokeUnsubscribeLinks(ctx, 1); err != nil {
+		t.Fatalf("RevokeUnsubscribeLinks: %v", err)
+	}
+	if err := svc.Unsubscribe(ctx, token); !errors.Is(err, ErrInvalidUnsubscribeToken) {
+		t.Errorf("revoked link: err = %v, want ErrInvalidUnsubscribeToken", err)
+	}
+
+	fresh, err := svc.unsubscribeURL(ctx, 1, domain.NotificationAnswer)
+	if err != nil {
+		t.Fatalf("unsubscribeURL: %v", err)
+	}
+	_, token, _ = strings.Cut(fresh, "token=")
+	if err := svc.Unsubscribe(ctx, token); err != nil {
+		t.Errorf("link issued after revoking: %v", err)
+	}
+}
+
+func TestSendDigestsReleasesClaimOnFailure(t *testing.T) {
+	mailer := &fakeEmailSender{err: errors.New("outbox down")}
+	svc, prefs, notifications := newTestNotificationEmailService(mailer)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	if sent, _ := svc.SendDigests(ctx, domain.DeliveryDaily, now); sent != 0 {
+		t.Fatalf("sent %d digests with the mailer down", sent)
+	}
+	if prefs.released != 1 {
+		t.Fatalf("claim released %d times, want 1", prefs.released)
+	}
+
+	mailer.err = nil
+	if sent, err := svc.SendDigests(ctx, domain.DeliveryDaily, now.Add(10*time.Minute)); err != nil || sent != 1 {
+		t.Fatalf("retry: sent %d, err %v; want 1 digest", sent, err)
+	}
+	if len(notifications.emailed) != 1 {
+		t.Errorf("emailed %v, want the one notification", notifications.emailed)
+	}
+}
+
+type countingUserRepo struct {
+	fakeUserRepo
+	queries int
+}
+
+func (r *countingUserRepo) GetByIDs(ctx context.Context, ids []int64) ([]*domain.User, error) {
+	r.queries++
+	return r.fakeUserRepo.GetByIDs(ctx, ids)
+}
+
+func TestActorsLoadedInOneQuery(t *testing.T) {
+	users := &countingUserRepo{}
+	svc := NewNotificationService(nil, users, nil, nil, logger.New("error"))
+
+	actors, err := svc.Actors(context.Background(), []*domain.Notification{
+		{ActorID: 3}, {ActorID: 4}, {ActorID: 3}, {ActorID: 0},
+	})
+	if err != nil {
+		t.Fatalf("Actors: %v", err)
+	}
+	if users.queries != 1 {
+		t.Errorf("loaded actors in %d queries, want 1", users.queries)
+	}
+	if len(actors) != 2 || actors[3] == nil || actors[4] == nil {
+		t.Errorf("actors = %v, want users 3 and 4", actors)
+	}
+}
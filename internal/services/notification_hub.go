@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+const (
+	// subscriberBuffer is how many notifications a slow stream may fall
+	// behind before it is closed; the client then resumes from the database.
+	subscriberBuffer = 32
+
+	hubRetryMin = time.Second
+	hubRetryMax = 30 * time.Second
+)
+
+// NotificationHub routes notifications from the broker to the streams
+// connected to this instance.
+type NotificationHub struct {
+	broker domain.NotificationBroker
+	log    *logger.Logger
+
+	mu          sync.Mutex
+	subscribers map[int64]map[*NotificationSubscription]struct{}
+}
+
+// NotificationSubscription receives the notifications of one user. C is
+// closed when the subscriber fell too far behind.
+type NotificationSubscription struct {
+	C      <-chan *domain.Notification
+	c      chan *domain.Notification
+	userID int64
+}
+
+func NewNotificationHub(broker domain.NotificationBroker, log *logger.Logger) *NotificationHub {
+	return &NotificationHub{
+		broker:      broker,
+		log:         log,
+		subscribers: make(map[int64]map[*NotificationSubscription]struct{}),
+	}
+}
+
+// Run listens to the broker until ctx is done, resubscribing with backoff
+// when the subscription fails.
+func (h *NotificationHub) Run(ctx context.Context) {
+	delay := hubRetryMin
+	for {
+		started := time.Now()
+		err := h.broker.Listen(ctx, h.dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) > hubRetryMax {
+			delay = hubRetryMin
+		}
+		h.log.Error("notification listener stopped, retrying", "retry_in", delay, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, hubRetryMax)
+	}
+}
+
+func (h *NotificationHub) Subscribe(userID int64) *NotificationSubscription {
+	c := make(chan *domain.Notification, subscriberBuffer)
+	sub := &NotificationSubscription{C: c, c: c, userID: userID}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[*NotificationSubscription]struct{})
+	}
+	h.subscribers[userID][sub] = struct{}{}
+	return sub
+}
+
+func (h *NotificationHub) Unsubscribe(sub *NotificationSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+func (h *NotificationHub) dispatch(n *domain.Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[n.UserID] {
+		select {
+		case sub.c <- n:
+		default:
+			h.log.Warn("notification stream too slow, closing", "user_id", n.UserID)
+			h.remove(sub)
+		}
+	}
+}
+
+// remove must be called with mu held.
+func (h *NotificationHub) remove(sub *NotificationSubscription) {
+	subs := h.subscribers[sub.userID]
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	close(sub.c)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.userID)
+	}
+}
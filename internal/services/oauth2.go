@@ -26,10 +26,12 @@ type GoogleUserInfo struct {
 type OAuth2Service struct {
 	oauthConfig *oauth2.Config
 	userRepo    domain.UserRepository
+	hasher      *PasswordHashService
+	usernames   *UsernameService
 	logger      *logger.Logger
 }
 
-func NewOAuth2Service(cfg *config.OAuth2Config, userRepo domain.UserRepository, log *logger.Logger) *OAuth2Service {
+func NewOAuth2Service(cfg *config.OAuth2Config, userRepo domain.UserRepository, hasher *PasswordHashService, usernames *UsernameService, log *logger.Logger) *OAuth2Service {
 	return &OAuth2Service{
 		oauthConfig: &oauth2.Config{
 			ClientID:     cfg.ClientID,
@@ -38,8 +40,10 @@ func NewOAuth2Service(cfg *config.OAuth2Config, userRepo domain.UserRepository,
 			Scopes:       []string{"email", "profile"},
 			Endpoint:     google.Endpoint,
 		},
-		userRepo: userRepo,
-		logger:   log,
+		userRepo:  userRepo,
+		hasher:    hasher,
+		usernames: usernames,
+		logger:    log,
 	}
 }
 
@@ -81,11 +85,19 @@ func (s *OAuth2Service) HandleCallback(ctx context.Context, code string) (*domai
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to generate password: %w", err)
 	}
+	hashedPass, err := s.hasher.Hash(randomPass)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to hash password: %w", err)
+	}
+	login, err := s.usernames.Generate(ctx, userInfo.Email)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to generate login: %w", err)
+	}
 	newUser := &domain.User{
-		Login:         userInfo.Email,
+		Login:         login,
 		Email:         userInfo.Email,
 		GoogleID:      userInfo.ID,
-		Password:      randomPass,
+		Password:      hashedPass,
 		FullName:      userInfo.Name,
 		Role:          "user",
 		Avatar:        userInfo.Picture,
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+type PasswordViolation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// PasswordPolicyError lists every rule the password failed, not just the first.
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Message
+	}
+	return "password does not meet policy: " + strings.Join(messages, "; ")
+}
+
+// PasswordRule checks a candidate password for the given user and returns a
+// human readable message when the rule is violated, or "" when it passes.
+type PasswordRule interface {
+	Name() string
+	Check(ctx context.Context, password string, user *domain.User) (string, error)
+}
+
+type PasswordPolicyService struct {
+	rules []PasswordRule
+	log   *logger.Logger
+}
+
+func NewPasswordPolicyService(log *logger.Logger, rules ...PasswordRule) *PasswordPolicyService {
+	return &PasswordPolicyService{rules: rules, log: log}
+}
+
+// NewDefaultPasswordPolicy builds the rule set described by cfg. The breached
+// password rule is only added when a dataset path is configured.
+func NewDefaultPasswordPolicy(cfg config.PasswordPolicyConfig, log *logger.Logger) *PasswordPolicyService {
+	rules := []PasswordRule{
+		&LengthRule{Min: cfg.MinLength, Max: cfg.MaxLength},
+		&CharacterClassRule{MinClasses: cfg.MinCharClasses},
+		&IdentitySimilarityRule{},
+	}
+	if cfg.BreachedDatasetPath != "" {
+		rules = append(rules, &BreachedPasswordRule{Dataset: NewBreachedPasswordDataset(cfg.BreachedDatasetPath)})
+	} else {
+		log.Warn("breached password dataset not configured, skipping breach checks")
+	}
+	return NewPasswordPolicyService(log, rules...)
+}
+
+// Validate runs every rule and returns a *PasswordPolicyError if any failed.
+func (s *PasswordPolicyService) Validate(ctx context.Context, password string, user *domain.User) error {
+	var violations []PasswordViolation
+	for _, rule := range s.rules {
+		msg, err := rule.Check(ctx, password, user)
+		if err != nil {
+			s.log.Error("password rule failed", "rule", rule.Name(), "error", err)
+			return fmt.Errorf("failed to check password rule %s: %w", rule.Name(), err)
+		}
+		if msg != "" {
+			violations = append(violations, PasswordViolation{Rule: rule.Name(), Message: msg})
+		}
+	}
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+type LengthRule struct {
+	Min int
+	Max int
+}
+
+func (r *LengthRule) Name() string { return "length" }
+
+func (r *LengthRule) Check(_ context.Context, password string, _ *domain.User) (string, error) {
+	n := utf8.RuneCountInString(password)
+	if n < r.Min {
+		return fmt.Sprintf("password must be at least %d characters long", r.Min), nil
+	}
+	if r.Max > 0 && len(password) > r.Max {
+		return fmt.Sprintf("password must be at most %d bytes long", r.Max), nil
+	}
+	return "", nil
+}
+
+// CharacterClassRule requires a mix of lowercase, uppercase, digits and symbols.
+type CharacterClassRule struct {
+	MinClasses int
+}
+
+func (r *CharacterClassRule) Name() string { return "character_classes" }
+
+func (r *CharacterClassRule) Check(_ context.Context, password string, _ *domain.User) (string, error) {
+	var lower, upper, digit, symbol bool
+	for _, ch := range password {
+		switch {
+		case unicode.IsLower(ch):
+			lower = true
+		case unicode.IsUpper(ch):
+			upper = true
+		case unicode.IsDigit(ch):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, ok := range []bool{lower, upper, digit, symbol} {
+		if ok {
+			classes++
+		}
+	}
+	if classes < r.MinClasses {
+		return fmt.Sprintf("password must contain at least %d of: lowercase letters, uppercase letters, digits, symbols", r.MinClasses), nil
+	}
+	return "", nil
+}
+
+// IdentitySimilarityRule rejects passwords built from the user's login or email.
+type IdentitySimilarityRule struct{}
+
+func (r *IdentitySimilarityRule) Name() string { return "identity_similarity" }
+
+func (r *IdentitySimilarityRule) Check(_ context.Context, password string, user *domain.User) (string, error) {
+	if user == nil {
+		return "", nil
+	}
+	candidate := strings.ToLower(password)
+
+	identities := []string{strings.ToLower(user.Login)}
+	if local, _, ok := strings.Cut(strings.ToLower(user.Email), "@"); ok {
+		identities = append(identities, local, strings.ToLower(user.Email))
+	}
+
+	for _, identity := range identities {
+		if len(identity) < 3 {
+			continue
+		}
+		if strings.Contains(candidate, identity) || strings.Contains(candidate, reverse(identity)) {
+			return "password must not contain your login or email", nil
+		}
+	}
+	return "", nil
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+type BreachedPasswordRule struct {
+	Dataset *BreachedPasswordDataset
+}
+
+func (r *BreachedPasswordRule) Name() string { return "breached" }
+
+func (r *BreachedPasswordRule) Check(ctx context.Context, password string, _ *domain.User) (string, error) {
+	count, err := r.Dataset.Count(ctx, password)
+	if err != nil {
+		return "", err
+	}
+	if count > 0 {
+		return "password has appeared in a known data breach, choose a different one", nil
+	}
+	return "", nil
+}
+
+// BreachedPasswordDataset is an offline copy of the Have I Been Pwned range
+// files: one file per 5 character SHA-1 prefix (e.g. "21BD1.txt") containing
+// "SUFFIX:COUNT" lines. Only the prefix of the hash is used to pick a file,
+// mirroring the k-anonymity range API.
+type BreachedPasswordDataset struct {
+	dir string
+}
+
+func NewBreachedPasswordDataset(dir string) *BreachedPasswordDataset {
+	return &BreachedPasswordDataset{dir: dir}
+}
+
+// Count returns how many times the password was seen in breaches, 0 if never.
+func (d *BreachedPasswordDataset) Count(_ context.Context, password string) (int, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	f, err := os.Open(filepath.Join(d.dir, prefix+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open breach range %s: %w", prefix, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lineSuffix, countStr, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+		count, err := strconv.Atoi(countStr)
+		if err != nil || count < 1 {
+			return 1, nil
+		}
+		return count, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read breach range %s: %w", prefix, err)
+	}
+	return 0, nil
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+var (
+	ErrInvalidRole         = errors.New("invalid role")
+	ErrUnknownPermission   = errors.New("unknown permission")
+	ErrAdminLockout        = errors.New("admin must keep the role:assign permission")
+	ErrCannotChangeOwnRole = errors.New("you cannot change your own role")
+	ErrRoleUserNotFound    = errors.New("user not found")
+	ErrPermissionDenied    = errors.New("insufficient permissions")
+)
+
+// PermissionService answers which roles may do what. Role permissions live
+// in PostgreSQL and are cached in Redis for CacheTTL; changes clear the
+// cache of the changed role.
+type PermissionService struct {
+	repo   domain.PermissionRepository
+	cache  domain.PermissionCache
+	users  domain.UserRepository
+	tokens *TokenService
+	config config.PermissionConfig
+	log    *logger.Logger
+}
+
+func NewPermissionService(repo domain.PermissionRepository, cache domain.PermissionCache, users domain.UserRepository, tokens *TokenService, cfg config.PermissionConfig, log *logger.Logger) *PermissionService {
+	return &PermissionService{repo: repo, cache: cache, users: users, tokens: tokens, config: cfg, log: log}
+}
+
+// Permissions returns the names of role's permissions. A cache that cannot
+// be read falls back to the database.
+func (s *PermissionService) Permissions(ctx context.Context, role string) ([]string, error) {
+	permissions, ok, err := s.cache.Get(ctx, role)
+	if err != nil {
+		s.log.Warn("failed to read cached permissions", "role", role, "error", err)
+	}
+	if ok {
+		return permissions, nil
+	}
+
+	permissions, err = s.repo.ForRole(ctx, role)
+	if err != nil {
+		s.log.Error("failed to load permissions", "role", role, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if err := s.cache.Set(ctx, role, permissions, time.Duration(s.config.CacheTTL)*time.Second); err != nil {
+		s.log.Warn("failed to cache permissions", "role", role, "error", err)
+	}
+	return permissions, nil
+}
+
+// Has reports whether role has permission.
+func (s *PermissionService) Has(ctx context.Context, role, permission string) (bool, error) {
+	permissions, err := s.Permissions(ctx, role)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions, permission), nil
+}
+
+// UserHas reports whether the user with userID has permission. The role is
+// read from the database rather than the access token, so role changes and
+// bans apply to tokens that were issued before them. Unknown and banned
+// users have no permissions.
+func (s *PermissionService) UserHas(ctx context.Context, userID int64, permission string) (bool, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to look up user", "user_id", userID, "error", err)
+		return false, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil || user.BannedAt != nil {
+		return false, nil
+	}
+	return s.Has(ctx, user.Role, permission)
+}
+
+// List returns every permission and the permissions of each role.
+func (s *PermissionService) List(ctx context.Context) ([]*domain.Permission, map[string][]string, error) {
+	permissions, err := s.repo.List(ctx)
+	if err != nil {
+		s.log.Error("failed to list permissions", "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+
+	roles := make(map[string][]string, len(domain.Roles))
+	for _, role := range domain.Roles {
+		if roles[role], err = s.Permissions(ctx, role); err != nil {
+			return nil, nil, err
+		}
+	}
+	return permissions, roles, nil
+}
+
+// SetRolePermissions replaces role's permissions. The admin role always
+// keeps role:assign, so there is no way to lose access to this endpoint.
+func (s *PermissionService) SetRolePermissions(ctx context.Context, role string, permissions []string) ([]string, error) {
+	if !slices.Contains(domain.Roles, role) {
+		return nil, ErrInvalidRole
+	}
+
+	known, err := s.repo.List(ctx)
+	if err != nil {
+		s.log.Error("failed to list permissions", "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	for _, permission := range permissions {
+		if !slices.ContainsFunc(known, func(p *domain.Permission) bool { return p.Name == permission }) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownPermission, permission)
+		}
+	}
+	permissions = slices.Compact(slices.Sorted(slices.Values(permissions)))
+	if role == domain.RoleAdmin && !slices.Contains(permissions, domain.PermissionRoleAssign) {
+		return nil, ErrAdminLockout
+	}
+
+	if err := s.repo.SetForRole(ctx, role, permissions); err != nil {
+		s.log.Error("failed to set role permissions", "role", role, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if err := s.cache.Delete(ctx, role); err != nil {
+		// The stale entry expires after CacheTTL.
+		s.log.Error("failed to clear cached permissions", "role", role, "error", err)
+	}
+	s.log.Info("role permissions changed", "role", role, "permissions", permissions)
+	return permissions, nil
+}
+
+// AssignRole gives userID role on behalf of actorID. The user's sessions
+// are revoked, so the next sign-in issues tokens with the new role.
+// Permission checks read the role from the database and see the change at
+// once.
+func (s *PermissionService) AssignRole(ctx context.Context, actorID, userID int64, role string) (*domain.User, error) {
+	if !slices.Contains(domain.Roles, role) {
+		return nil, ErrInvalidRole
+	}
+	if actorID == userID {
+		return nil, ErrCannotChangeOwnRole
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to look up user", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil {
+		return nil, ErrRoleUserNotFound
+	}
+	if user.Role == role {
+		return user, nil
+	}
+
+	if err := s.users.UpdateRole(ctx, userID, role); err != nil {
+		s.log.Error("failed to update role", "user_id", userID, "role", role, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if err := s.tokens.RevokeOtherSessions(ctx, strconv.FormatInt(userID, 10), ""); err != nil {
+		s.log.Error("failed to revoke sessions after role change", "user_id", userID, "error", err)
+	}
+	s.log.Info("role assigned", "actor_id", actorID, "user_id", userID, "old_role", user.Role, "role", role)
+
+	user.Role = role
+	return user, nil
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+type fakePermissionRepo struct {
+	all    []*domain.Permission
+	roles  map[string][]string
+	reads  int
+	setErr error
+}
+
+func (r *fakePermissionRepo) List(context.Context) ([]*domain.Permission, error) {
+	return r.all, nil
+}
+
+func (r *fakePermissionRepo) ForRole(_ context.Context, role string) ([]string, error) {
+	r.reads++
+	return r.roles[role], nil
+}
+
+func (r *fakePermissionRepo) SetForRole(_ context.Context, role string, permissions []string) error {
+	if r.setErr != nil {
+		return r.setErr
+	}
+	r.roles[role] = permissions
+	return nil
+}
+
+type fakePermissionCache struct {
+	entries map[string][]string
+	err     error
+}
+
+func (c *fakePermissionCache) Get(_ context.Context, role string) ([]string, bool, error) {
+	if c.err != nil {
+		return nil, false, c.err
+	}
+	permissions, ok := c.entries[role]
+	return permissions, ok, nil
+}
+
+func (c *fakePermissionCache) Set(_ context.Context, role string, permissions []string, _ time.Duration) error {
+	c.entries[role] = permissions
+	return nil
+}
+
+func (c *fakePermissionCache) Delete(_ context.Context, role string) error {
+	delete(c.entries, role)
+	return nil
+}
+
+func newTestPermissionService() (*PermissionService, *fakePermissionRepo, *fakePermissionCache) {
+	repo := &fakePermissionRepo{
+		all: []*domain.Permission{
+			{Name: domain.PermissionCategoryWrite},
+			{Name: domain.PermissionFlagReview},
+			{Name: domain.PermissionPostDelete},
+			{Name: domain.PermissionRoleAssign},
+		},
+		roles: map[string][]string{
+			domain.RoleAdmin:     {domain.PermissionCategoryWrite, domain.PermissionFlagReview, domain.PermissionRoleAssign},
+			domain.RoleModerator: {domain.PermissionFlagReview},
+		},
+	}
+	cache := &fakePermissionCache{entries: map[string][]string{}}
+	svc := NewPermissionService(repo, cache, nil, nil, config.PermissionConfig{CacheTTL: 60}, logger.New("error"))
+	return svc, repo, cache
+}
+
+func TestPermissionServiceHas(t *testing.T) {
+	svc, _, _ := newTestPermissionService()
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		role, permission string
+		want             bool
+	}{
+		{domain.RoleAdmin, domain.PermissionCategoryWrite, true},
+		{domain.RoleModerator, domain.PermissionFlagReview, true},
+		{domain.RoleModerator, domain.PermissionCategoryWrite, false},
+		{domain.RoleUser, domain.PermissionFlagReview, false},
+		{"unknown", domain.PermissionFlagReview, false},
+	} {
+		got, err := svc.Has(ctx, tc.role, tc.permission)
+		if err != nil {
+			t.Fatalf("Has(%s, %s): %v", tc.role, tc.permission, err)
+		}
+		if got != tc.want {
+			t.Errorf("Has(%s, %s) = %v, want %v", tc.role, tc.permission, got, tc.want)
+		}
+	}
+}
+
+func TestPermissionServiceCaches(t *testing.T) {
+	svc, repo, cache := newTestPermissionService()
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := svc.Has(ctx, domain.RoleModerator, domain.PermissionFlagReview); err != nil {
+			t.Fatalf("Has: %v", err)
+		}
+	}
+	if repo.reads != 1 {
+		t.Errorf("database read %d times, want 1", repo.reads)
+	}
+
+	cache.err = errors.New("redis down")
+	allowed, err := svc.Has(ctx, domain.RoleModerator, domain.PermissionFlagReview)
+	if err != nil || !allowed {
+		t.Errorf("with the cache down: Has = %v, %v; want true, nil", allowed, err)
+	}
+}
+
+type fakeRoleUserRepo struct {
+	domain.UserRepository
+	users map[int64]*domain.User
+}
+
+func (r *fakeRoleUserRepo) GetByID(_ context.Context, id int64) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeRoleUserRepo) SetBanned(_ context.Context, id int64, bannedAt *time.Time) error {
+	r.users[id].BannedAt = bannedAt
+	return nil
+}
+
+func TestPermissionServiceUserHasReadsCurrentRole(t *testing.T) {
+	svc, _, _ := newTestPermissionService()
+	users := &fakeRoleUserRepo{users: map[int64]*domain.User{
+		1: {ID: 1, Role: domain.RoleAdmin},
+		2: {ID: 2, Role: domain.RoleUser},
+		3: {ID: 3, Role: domain.RoleAdmin, BannedAt: new(time.Time)},
+	}}
+	svc.users = users
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		userID int64
+		want   bool
+	}{
+		{1, true},
+		{2, false},
+		{3, false},
+		{4, false},
+	} {
+		got, err := svc.UserHas(ctx, tc.userID, domain.PermissionCategoryWrite)
+		if err != nil {
+			t.Fatalf("UserHas(%d): %v", tc.userID, err)
+		}
+		if got != tc.want {
+			t.Errorf("UserHas(%d) = %v, want %v", tc.userID, got, tc.want)
+		}
+	}
+}
+
+func TestSetRolePermissions(t *testing.T) {
+	svc, _, cache := newTestPermissionService()
+	ctx := context.Background()
+
+	if _, err := svc.Has(ctx, domain.RoleModerator, domain.PermissionPostDelete); err != nil {
+		t.Fatalf("Has: %v", err)
+	}
+
+	got, err := svc.SetRolePermissions(ctx, domain.RoleModerator, []string{domain.PermissionPostDelete, domain.PermissionFlagReview, domain.PermissionPostDelete})
+	if err != nil {
+		t.Fatalf("SetRolePermissions: %v", err)
+	}
+	if want := []string{domain.PermissionFlagReview, domain.PermissionPostDelete}; !slices.Equal(got, want) {
+		t.Errorf("permissions = %v, want %v", got, want)
+	}
+	if _, ok := cache.entries[domain.RoleModerator]; ok {
+		t.Error("cached permissions not cleared after a change")
+	}
+
+	allowed, err := svc.Has(ctx, domain.RoleModerator, domain.PermissionPostDelete)
+	if err != nil || !allowed {
+		t.Errorf("after the change: Has = %v, %v; want true, nil", allowed, err)
+	}
+}
+
+func TestSetRolePermissionsRejects(t *testing.T) {
+	svc, _, _ := newTestPermissionService()
+	ctx := context.Background()
+
+	if _, err := svc.SetRolePermissions(ctx, "owner", nil); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("unknown role: err = %v, want ErrInvalidRole", err)
+	}
+	if _, err := svc.SetRolePermissions(ctx, domain.RoleUser, []string{"post:launch"}); !errors.Is(err, ErrUnknownPermission) {
+		t.Errorf("unknown permission: err = %v, want ErrUnknownPermission", err)
+	}
+	if _, err := svc.SetRolePermissions(ctx, domain.RoleAdmin, []string{domain.PermissionCategoryWrite}); !errors.Is(err, ErrAdminLockout) {
+		t.Errorf("admin without role:assign: err = %v, want ErrAdminLockout", err)
+	}
+}
@@ -0,0 +1,635 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+const (
+	DefaultQuestionPageSize = 20
+	MaxQuestionPageSize     = 100
+
+	// MaxQuestionCategories bounds how many categories a question is filed
+	// under.
+	MaxQuestionCategories = 5
+	// maxTitleLength matches the title column of post_revisions.
+	maxTitleLength = 255
+)
+
+var (
+	ErrQuestionNotFound     = errors.New("question not found")
+	ErrAnswerNotFound       = errors.New("answer not found")
+	ErrInvalidTitle         = errors.New("title must be between 1 and 255 characters")
+	ErrInvalidBody          = errors.New("body must not be empty")
+	ErrInvalidCategories    = errors.New("a question needs between 1 and 5 distinct categories")
+	ErrPostCategoryNotFound = errors.New("category not found")
+	ErrPostForbidden        = errors.New("only the author can change this post")
+	ErrInvalidPostTarget    = errors.New("target must be a question or an answer")
+	ErrInvalidVote          = errors.New("vote must be 1, -1 or 0")
+	ErrCannotVoteOwnPost    = errors.New("you cannot vote on your own post")
+	ErrAcceptForbidden      = errors.New("only the author of the question can accept an answer")
+	ErrAnswerNotOfQuestion  = errors.New("answer does not belong to this question")
+)
+
+// postRef identifies a question or answer together with its author and the
+// question it belongs to, which is itself for a question.
+type postRef struct {
+	Type       string
+	ID         int64
+	AuthorID   int64
+	QuestionID int64
+}
+
+// PostService manages questions and answers. A post row only records who
+// posted it and when; its title and body are its latest revision, so every
+// create and edit goes through RevisionService.Record. Posts a moderator
+// deleted are hidden, and deleted or locked posts cannot be changed.
+// Uploads listed with a post are linked to it and released again when the
+// author deletes it. Votes move the rating of the post's author. New posts
+// notify the users involved and reach the feeds of their followers.
+type PostService struct {
+	posts         domain.PostRepository
+	votes         domain.VoteRepository
+	categories    domain.CategoryRepository
+	users         domain.UserRepository
+	revisions     *RevisionService
+	moderation    *ModerationService
+	attachments   *AttachmentService
+	notifications *NotificationService
+	follows       *FollowService
+	markdown      *MarkdownService
+	log           *logger.Logger
+}
+
+func NewPostService(posts domain.PostRepository, votes domain.VoteRepository, categories domain.CategoryRepository, users domain.UserRepository, revisions *RevisionService, moderation *ModerationService, attachments *AttachmentService, notifications *NotificationService, follows *FollowService, markdown *MarkdownService, log *logger.Logger) *PostService {
+	return &PostService{posts: posts, votes: votes, categories: categories, users: users, revisions: revisions, moderation: moderation, attachments: attachments, notifications: notifications, follows: follows, markdown: markdown, log: log}
+}
+
+// Ask posts a question by authorID filed under categoryIDs with the uploads
+// attachmentIDs.
+func (s *PostService) Ask(ctx context.Context, authorID int64, categoryIDs []int64, title, body string, attachmentIDs []int64) (*domain.Question, error) {
+	title = strings.TrimSpace(title)
+	if err := s.checkContent(title, body, true); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategories(ctx, categoryIDs); err != nil {
+		return nil, err
+	}
+
+	question := &domain.Question{AuthorID: authorID, CategoryIDs: categoryIDs}
+	if err := s.posts.CreateQuestion(ctx, question); err != nil {
+		s.log.Error("failed to create question", "author_id", authorID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	revision, err := s.revisions.Record(ctx, domain.PostQuestion, question.ID, authorID, title, body, "")
+	if err == nil && len(attachmentIDs) > 0 {
+		err = s.attachments.Link(ctx, authorID, domain.AttachmentTargetQuestion, question.ID, attachmentIDs)
+	}
+	if err != nil {
+		s.discard(ctx, domain.PostQuestion, question.ID)
+		return nil, err
+	}
+	setQuestionContent(question, revision)
+	s.log.Info("question posted", "question_id", question.ID, "author_id", authorID)
+
+	s.notifications.Mentioned(ctx, authorID, domain.NotificationTargetQuestion, question.ID, body)
+	s.follows.QuestionPosted(ctx, authorID, question.ID, categoryIDs, title)
+	return question, nil
+}
+
+// Question returns a question with its current content.
+func (s *PostService) Question(ctx context.Context, id int64) (*domain.Question, error) {
+	question, err := s.question(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.fillQuestions(ctx, []*domain.Question{question}); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// Questions returns a page of questions newest first and how many there
+// are, only those in categoryID unless it is 0.
+func (s *PostService) Questions(ctx context.Context, categoryID int64, limit, offset int) ([]*domain.Question, int, error) {
+	if limit <= 0 {
+		limit = DefaultQuestionPageSize
+	}
+	limit = min(limit, MaxQuestionPageSize)
+	offset = max(offset, 0)
+
+	questions, err := s.posts.ListQuestions(ctx, categoryID, limit, offset)
+	if err != nil {
+		s.log.Error("failed to list questions", "category_id", categoryID, "error", err)
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	total, err := s.posts.CountQuestions(ctx, categoryID)
+	if err != nil {
+		s.log.Error("failed to count questions", "category_id", categoryID, "error", err)
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	if err := s.fillQuestions(ctx, questions); err != nil {
+		return nil, 0, err
+	}
+	return questions, total, nil
+}
+
+// EditQuestion records a new revision of the question on behalf of its
+// author. Unless attachmentIDs is nil it replaces the question's uploads.
+func (s *PostService) EditQuestion(ctx context.Context, actorID, id int64, title, body, summary string, attachmentIDs []int64) (*domain.Question, error) {
+	title = strings.TrimSpace(title)
+	if err := s.checkContent(title, body, true); err != nil {
+		return nil, err
+	}
+	question, err := s.question(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if question.AuthorID != actorID {
+		return nil, ErrPostForbidden
+	}
+	if err := s.moderation.open(ctx, domain.PostQuestion, id); err != nil {
+		return nil, err
+	}
+	if attachmentIDs != nil {
+		if err := s.attachments.Link(ctx, actorID, domain.AttachmentTargetQuestion, id, attachmentIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	revision, err := s.revisions.Record(ctx, domain.PostQuestion, id, actorID, title, body, summary)
+	if err != nil {
+		return nil, err
+	}
+	setQuestionContent(question, revision)
+	s.log.Info("question edited", "question_id", id, "revision", revision.Number)
+	return question, nil
+}
+
+// DeleteQuestion deletes the question with its answers on behalf of its
+// author. Moderators delete posts through ModerationService.Act instead.
+func (s *PostService) DeleteQuestion(ctx context.Context, actorID, id int64) error {
+	question, err := s.question(ctx, id)
+	if err != nil {
+		return err
+	}
+	if question.AuthorID != actorID {
+		return ErrPostForbidden
+	}
+	answers, err := s.posts.ListAnswers(ctx, id)
+	if err != nil {
+		s.log.Error("failed to list answers", "question_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if err := s.posts.DeleteQuestion(ctx, id); err != nil {
+		s.log.Error("failed to delete question", "question_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.release(ctx, domain.AttachmentTargetQuestion, id)
+	for _, answer := range answers {
+		s.release(ctx, domain.AttachmentTargetAnswer, answer.ID)
+	}
+	s.log.Info("question deleted", "question_id", id)
+	return nil
+}
+
+// Answer posts an answer by authorID to questionID with the uploads
+// attachmentIDs.
+func (s *PostService) Answer(ctx context.Context, authorID, questionID int64, body string, attachmentIDs []int64) (*domain.Answer, error) {
+	if err := s.checkContent("", body, false); err != nil {
+		return nil, err
+	}
+	question, err := s.question(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.moderation.open(ctx, domain.PostQuestion, questionID); err != nil {
+		return nil, err
+	}
+
+	answer := &domain.Answer{QuestionID: questionID, AuthorID: authorID}
+	if err := s.posts.CreateAnswer(ctx, answer); err != nil {
+		s.log.Error("failed to create answer", "question_id", questionID, "author_id", authorID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	revision, err := s.revisions.Record(ctx, domain.PostAnswer, answer.ID, authorID, "", body, "")
+	if err == nil && len(attachmentIDs) > 0 {
+		err = s.attachments.Link(ctx, authorID, domain.AttachmentTargetAnswer, answer.ID, attachmentIDs)
+	}
+	if err != nil {
+		s.discard(ctx, domain.PostAnswer, answer.ID)
+		return nil, err
+	}
+	setAnswerContent(answer, revision)
+	s.log.Info("answer posted", "answer_id", answer.ID, "question_id", questionID, "author_id", authorID)
+
+	s.notifications.AnswerPosted(ctx, question.AuthorID, authorID, answer.ID, body)
+	s.notifications.Mentioned(ctx, authorID, domain.NotificationTargetAnswer, answer.ID, body, question.AuthorID)
+	s.follows.AnswerPosted(ctx, authorID, questionID, answer.ID, body)
+	return answer, nil
+}
+
+// Answers returns the answers of a question oldest first with their current
+// content.
+func (s *PostService) Answers(ctx context.Context, questionID int64) ([]*domain.Answer, error) {
+	if _, err := s.question(ctx, questionID); err != nil {
+		return nil, err
+	}
+	answers, err := s.posts.ListAnswers(ctx, questionID)
+	if err != nil {
+		s.log.Error("failed to list answers", "question_id", questionID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if err := s.fillAnswers(ctx, answers); err != nil {
+		return nil, err
+	}
+	return answers, nil
+}
+
+// EditAnswer records a new revision of the answer on behalf of its author.
+// Answers of a locked or deleted question cannot be edited either. Unless
+// attachmentIDs is nil it replaces the answer's uploads.
+func (s *PostService) EditAnswer(ctx context.Context, actorID, id int64, body, summary string, attachmentIDs []int64) (*domain.Answer, error) {
+	if err := s.checkContent("", body, false); err != nil {
+		return nil, err
+	}
+	answer, err := s.answer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if answer.AuthorID != actorID {
+		return nil, ErrPostForbidden
+	}
+	if err := s.refOpen(ctx, &postRef{Type: domain.PostAnswer, ID: id, QuestionID: answer.QuestionID}); err != nil {
+		return nil, err
+	}
+	if attachmentIDs != nil {
+		if err := s.attachments.Link(ctx, actorID, domain.AttachmentTargetAnswer, id, attachmentIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	revision, err := s.revisions.Record(ctx, domain.PostAnswer, id, actorID, "", body, summary)
+	if err != nil {
+		return nil, err
+	}
+	setAnswerContent(answer, revision)
+	s.log.Info("answer edited", "answer_id", id, "revision", revision.Number)
+	return answer, nil
+}
+
+// DeleteAnswer deletes the answer on behalf of its author.
+func (s *PostService) DeleteAnswer(ctx context.Context, actorID, id int64) error {
+	answer, err := s.answer(ctx, id)
+	if err != nil {
+		return err
+	}
+	if answer.AuthorID != actorID {
+		return ErrPostForbidden
+	}
+	if err := s.posts.DeleteAnswer(ctx, id); err != nil {
+		s.log.Error("failed to delete answer", "answer_id", id, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.release(ctx, domain.AttachmentTargetAnswer, id)
+	s.log.Info("answer deleted", "answer_id", id)
+	return nil
+}
+
+// Rollback restores revision number of a post through
+// RevisionService.Rollback once the post, and for an answer its question,
+// is known to be neither deleted nor locked.
+func (s *PostService) Rollback(ctx context.Context, actorID int64, targetType string, targetID int64, number int, summary string) (*domain.Revision, error) {
+	ref, err := s.ref(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refOpen(ctx, ref); err != nil {
+		return nil, err
+	}
+	return s.revisions.Rollback(ctx, actorID, targetType, targetID, number, summary)
+}
+
+// Vote sets userID's vote on a question or answer to value, 1 up, -1 down or
+// 0 to take it back, and returns the post's new score. The author's rating
+// moves with the vote.
+func (s *PostService) Vote(ctx context.Context, userID int64, targetType string, targetID int64, value int) (int, error) {
+	if value < domain.VoteDown || value > domain.VoteUp {
+		return 0, ErrInvalidVote
+	}
+	ref, err := s.ref(ctx, targetType, targetID)
+	if err != nil {
+		return 0, err
+	}
+	if ref.AuthorID == userID {
+		return 0, ErrCannotVoteOwnPost
+	}
+
+	oldRating, newRating, err := s.votes.Set(ctx, userID, targetType, targetID, value, ref.AuthorID)
+	if err != nil {
+		s.log.Error("failed to save vote", "user_id", userID, "target_type", targetType, "target_id", targetID, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	scores, err := s.votes.Scores(ctx, targetType, []int64{targetID})
+	if err != nil {
+		s.log.Error("failed to load score", "target_type", targetType, "target_id", targetID, "error", err)
+		return 0, fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("vote saved", "user_id", userID, "target_type", targetType, "target_id", targetID, "value", value)
+
+	if ref.AuthorID != 0 && newRating != oldRating {
+		s.notifications.RatingChanged(ctx, ref.AuthorID, oldRating, newRating)
+	}
+	return scores[targetID], nil
+}
+
+// Accept marks answerID as the accepted answer of questionID on behalf of
+// the question's author, replacing any earlier choice.
+func (s *PostService) Accept(ctx context.Context, actorID, questionID, answerID int64) error {
+	question, err := s.question(ctx, questionID)
+	if err != nil {
+		return err
+	}
+	if question.AuthorID != actorID {
+		return ErrAcceptForbidden
+	}
+	if err := s.moderation.open(ctx, domain.PostQuestion, questionID); err != nil {
+		return err
+	}
+	answer, err := s.answer(ctx, answerID)
+	if err != nil {
+		return err
+	}
+	if answer.QuestionID != questionID {
+		return ErrAnswerNotOfQuestion
+	}
+	if question.AcceptedAnswerID == answerID {
+		return nil
+	}
+
+	if err := s.posts.SetAccepted(ctx, questionID, answerID); err != nil {
+		s.log.Error("failed to accept answer", "question_id", questionID, "answer_id", answerID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("answer accepted", "question_id", questionID, "answer_id", answerID)
+
+	s.notifications.AnswerAccepted(ctx, answer.AuthorID, actorID, answerID)
+	return nil
+}
+
+// Unaccept clears the accepted answer of questionID on behalf of the
+// question's author.
+func (s *PostService) Unaccept(ctx context.Context, actorID, questionID int64) error {
+	question, err := s.question(ctx, questionID)
+	if err != nil {
+		return err
+	}
+	if question.AuthorID != actorID {
+		return ErrAcceptForbidden
+	}
+	if err := s.moderation.open(ctx, domain.PostQuestion, questionID); err != nil {
+		return err
+	}
+	if question.AcceptedAnswerID == 0 {
+		return nil
+	}
+
+	if err := s.posts.SetAccepted(ctx, questionID, 0); err != nil {
+		s.log.Error("failed to clear accepted answer", "question_id", questionID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	s.log.Info("accepted answer cleared", "question_id", questionID)
+	return nil
+}
+
+// Authors returns the authors of questions and answers keyed by ID.
+func (s *PostService) Authors(ctx context.Context, questions []*domain.Question, answers []*domain.Answer) (map[int64]*domain.User, error) {
+	var ids []int64
+	for _, q := range questions {
+		if q.AuthorID != 0 && !slices.Contains(ids, q.AuthorID) {
+			ids = append(ids, q.AuthorID)
+		}
+	}
+	for _, a := range answers {
+		if a.AuthorID != 0 && !slices.Contains(ids, a.AuthorID) {
+			ids = append(ids, a.AuthorID)
+		}
+	}
+	authors, err := usersByID(ctx, s.users, ids)
+	if err != nil {
+		s.log.Error("failed to load post authors", "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return authors, nil
+}
+
+// question loads a question, treating one a moderator deleted as missing.
+func (s *PostService) question(ctx context.Context, id int64) (*domain.Question, error) {
+	question, err := s.posts.GetQuestion(ctx, id)
+	if err != nil {
+		s.log.Error("failed to load question", "question_id", id, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if question == nil {
+		return nil, ErrQuestionNotFound
+	}
+	deleted, err := s.moderation.Deleted(ctx, domain.PostQuestion, id)
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		return nil, ErrQuestionNotFound
+	}
+	return question, nil
+}
+
+// answer loads an answer, treating one a moderator deleted, or one to a
+// question a moderator deleted, as missing.
+func (s *PostService) answer(ctx context.Context, id int64) (*domain.Answer, error) {
+	answer, err := s.posts.GetAnswer(ctx, id)
+	if err != nil {
+		s.log.Error("failed to load answer", "answer_id", id, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if answer == nil {
+		return nil, ErrAnswerNotFound
+	}
+	deleted, err := s.moderation.Deleted(ctx, domain.PostAnswer, id)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		deleted, err = s.moderation.Deleted(ctx, domain.PostQuestion, answer.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if deleted {
+		return nil, ErrAnswerNotFound
+	}
+	return answer, nil
+}
+
+// ref loads the question or answer targetType and id name, treating one a
+// moderator deleted, or an answer to a deleted question, as missing.
+func (s *PostService) ref(ctx context.Context, targetType string, id int64) (*postRef, error) {
+	switch targetType {
+	case domain.PostQuestion:
+		question, err := s.question(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &postRef{Type: targetType, ID: id, AuthorID: question.AuthorID, QuestionID: id}, nil
+	case domain.PostAnswer:
+		answer, err := s.answer(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &postRef{Type: targetType, ID: id, AuthorID: answer.AuthorID, QuestionID: answer.QuestionID}, nil
+	default:
+		return nil, ErrInvalidPostTarget
+	}
+}
+
+// refOpen returns an error unless the post and the question it belongs to
+// can still be changed.
+func (s *PostService) refOpen(ctx context.Context, ref *postRef) error {
+	if err := s.moderation.open(ctx, ref.Type, ref.ID); err != nil {
+		return err
+	}
+	if ref.Type == domain.PostAnswer {
+		return s.moderation.open(ctx, domain.PostQuestion, ref.QuestionID)
+	}
+	return nil
+}
+
+func (s *PostService) checkContent(title, body string, needsTitle bool) error {
+	if needsTitle && (title == "" || utf8.RuneCountInString(title) > maxTitleLength) {
+		return ErrInvalidTitle
+	}
+	if strings.TrimSpace(body) == "" {
+		return ErrInvalidBody
+	}
+	if utf8.RuneCountInString(body) > s.markdown.MaxLength() {
+		return ErrMarkdownTooLong
+	}
+	return nil
+}
+
+func (s *PostService) checkCategories(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 || len(ids) > MaxQuestionCategories {
+		return ErrInvalidCategories
+	}
+	for i, id := range ids {
+		if slices.Contains(ids[:i], id) {
+			return ErrInvalidCategories
+		}
+		category, err := s.categories.GetByID(ctx, id)
+		if err != nil {
+			s.log.Error("failed to look up category", "category_id", id, "error", err)
+			return fmt.Errorf("database error: %v", err)
+		}
+		if category == nil {
+			return ErrPostCategoryNotFound
+		}
+	}
+	return nil
+}
+
+// discard deletes a post whose first revision or attachments could not be
+// saved, so no post is left half created. Whatever was saved of it, such as
+// the revision, goes with the post.
+func (s *PostService) discard(ctx context.Context, targetType string, id int64) {
+	var err error
+	if targetType == domain.PostQuestion {
+		err = s.posts.DeleteQuestion(ctx, id)
+	} else {
+		err = s.posts.DeleteAnswer(ctx, id)
+	}
+	if err != nil {
+		s.log.Error("failed to discard post", "target_type", targetType, "target_id", id, "error", err)
+	}
+}
+
+// release orphans the uploads of a deleted post so the garbage collector
+// removes them. A failure only delays that, so it does not fail the delete.
+func (s *PostService) release(ctx context.Context, targetType string, id int64) {
+	if err := s.attachments.Release(ctx, targetType, id); err != nil {
+		s.log.Warn("attachments of deleted post stay linked", "target_type", targetType, "target_id", id)
+	}
+}
+
+func (s *PostService) fillQuestions(ctx context.Context, questions []*domain.Question) error {
+	ids := make([]int64, len(questions))
+	for i, q := range questions {
+		ids[i] = q.ID
+	}
+	latest, err := s.revisions.Latest(ctx, domain.PostQuestion, ids)
+	if err != nil {
+		return err
+	}
+	scores, err := s.scores(ctx, domain.PostQuestion, ids)
+	if err != nil {
+		return err
+	}
+	for _, q := range questions {
+		if revision, ok := latest[q.ID]; ok {
+			setQuestionContent(q, revision)
+		}
+		q.Score = scores[q.ID]
+	}
+	return nil
+}
+
+func (s *PostService) fillAnswers(ctx context.Context, answers []*domain.Answer) error {
+	ids := make([]int64, len(answers))
+	for i, a := range answers {
+		ids[i] = a.ID
+	}
+	latest, err := s.revisions.Latest(ctx, domain.PostAnswer, ids)
+	if err != nil {
+		return err
+	}
+	scores, err := s.scores(ctx, domain.PostAnswer, ids)
+	if err != nil {
+		return err
+	}
+	for _, a := range answers {
+		if revision, ok := latest[a.ID]; ok {
+			setAnswerContent(a, revision)
+		}
+		a.Score = scores[a.ID]
+	}
+	return nil
+}
+
+func (s *PostService) scores(ctx context.Context, targetType string, ids []int64) (map[int64]int, error) {
+	scores, err := s.votes.Scores(ctx, targetType, ids)
+	if err != nil {
+		s.log.Error("failed to load scores", "target_type", targetType, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return scores, nil
+}
+
+func setQuestionContent(q *domain.Question, r *domain.Revision) {
+	q.Title = r.Title
+	q.Body = r.Body
+	q.BodyHTML = r.BodyHTML
+	q.Revision = r.Number
+	q.UpdatedAt = r.CreatedAt
+}
+
+func setAnswerContent(a *domain.Answer, r *domain.Revision) {
+	a.Body = r.Body
+	a.BodyHTML = r.BodyHTML
+	a.Revision = r.Number
+	a.UpdatedAt = r.CreatedAt
+}
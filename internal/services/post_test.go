@@ -0,0 +1,549 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/markdown"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+type fakePostRepo struct {
+	domain.PostRepository
+	questions map[int64]*domain.Question
+	answers   map[int64]*domain.Answer
+}
+
+func (r *fakePostRepo) CreateQuestion(_ context.Context, question *domain.Question) error {
+	question.ID = int64(len(r.questions) + 1)
+	stored := *question
+	r.questions[question.ID] = &stored
+	return nil
+}
+
+func (r *fakePostRepo) GetQuestion(_ context.Context, id int64) (*domain.Question, error) {
+	question, ok := r.questions[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *question
+	return &copied, nil
+}
+
+func (r *fakePostRepo) SetAccepted(_ context.Context, questionID, answerID int64) error {
+	r.questions[questionID].AcceptedAnswerID = answerID
+	return nil
+}
+
+func (r *fakePostRepo) GetAnswer(_ context.Context, id int64) (*domain.Answer, error) {
+	answer, ok := r.answers[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *answer
+	return &copied, nil
+}
+
+func (r *fakePostRepo) CreateAnswer(_ context.Context, answer *domain.Answer) error {
+	answer.ID = int64(len(r.answers) + 10)
+	stored := *answer
+	r.answers[answer.ID] = &stored
+	return nil
+}
+
+func (r *fakePostRepo) DeleteAnswer(_ context.Context, id int64) error {
+	delete(r.answers, id)
+	return nil
+}
+
+// fakeLinkRepo links uploads of user 1 to posts, all or nothing like the
+// real repository.
+type fakeLinkRepo struct {
+	domain.AttachmentRepository
+	uploads map[int64]int64
+	targets map[int64]string
+}
+
+func (r *fakeLinkRepo) SetTarget(_ context.Context, userID int64, targetType string, targetID int64, ids []int64) (int, error) {
+	target := targetType + ":" + strconv.FormatInt(targetID, 10)
+	for _, id := range ids {
+		if r.uploads[id] != userID || r.targets[id] != "" && r.targets[id] != target {
+			return 0, nil
+		}
+	}
+	for id, t := range r.targets {
+		if t == target && !slices.Contains(ids, id) {
+			delete(r.targets, id)
+		}
+	}
+	for _, id := range ids {
+		r.targets[id] = target
+	}
+	return len(ids), nil
+}
+
+// fakeRevisionRepo numbers and deduplicates revisions like the real
+// repository; conflict makes Create fail as if a concurrent edit had taken
+// the number.
+type fakeRevisionRepo struct {
+	domain.RevisionRepository
+	revisions []*domain.Revision
+	conflict  bool
+}
+
+func (r *fakeRevisionRepo) latest(targetType string, targetID int64) *domain.Revision {
+	var latest *domain.Revision
+	for _, rev := range r.revisions {
+		if rev.TargetType == targetType && rev.TargetID == targetID {
+			latest = rev
+		}
+	}
+	return latest
+}
+
+func (r *fakeRevisionRepo) Create(_ context.Context, revision *domain.Revision) (bool, error) {
+	if r.conflict {
+		return false, &domain.UniqueViolationError{Constraint: "post_revisions_target_type_target_id_revision_key"}
+	}
+	latest := r.latest(revision.TargetType, revision.TargetID)
+	if latest != nil && latest.Title == revision.Title && latest.Body == revision.Body {
+		*revision = *latest
+		return false, nil
+	}
+	revision.Number = 1
+	if latest != nil {
+		revision.Number = latest.Number + 1
+	}
+	revision.ID = int64(len(r.revisions) + 1)
+	stored := *revision
+	r.revisions = append(r.revisions, &stored)
+	return true, nil
+}
+
+func (r *fakeRevisionRepo) Get(_ context.Context, targetType string, targetID int64, number int) (*domain.Revision, error) {
+	for _, rev := range r.revisions {
+		if rev.TargetType == targetType && rev.TargetID == targetID && rev.Number == number {
+			copied := *rev
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeRevisionRepo) Latest(_ context.Context, targetType string, targetID int64) (*domain.Revision, error) {
+	return r.latest(targetType, targetID), nil
+}
+
+// fakeVoteRepo keeps votes and a single rating for every author.
+type fakeVoteRepo struct {
+	domain.VoteRepository
+	votes  map[string]int
+	rating int
+}
+
+func (r *fakeVoteRepo) Set(_ context.Context, userID int64, targetType string, targetID int64, value int, _ int64) (int, int, error) {
+	key := strconv.FormatInt(userID, 10) + ":" + targetType + ":" + strconv.FormatInt(targetID, 10)
+	old := r.rating
+	r.rating += value - r.votes[key]
+	r.votes[key] = value
+	return old, r.rating, nil
+}
+
+func (r *fakeVoteRepo) Scores(_ context.Context, targetType string, targetIDs []int64) (map[int64]int, error) {
+	scores := make(map[int64]int)
+	for key, value := range r.votes {
+		for _, id := range targetIDs {
+			if strings.HasSuffix(key, ":"+targetType+":"+strconv.FormatInt(id, 10)) {
+				scores[id] += value
+			}
+		}
+	}
+	return scores, nil
+}
+
+type fakeCategoryRepo struct {
+	domain.CategoryRepository
+	categories map[int64]*domain.Category
+}
+
+func (r *fakeCategoryRepo) GetByID(_ context.Context, id int64) (*domain.Category, error) {
+	return r.categories[id], nil
+}
+
+type fakeBroker struct {
+	domain.NotificationBroker
+}
+
+func (fakeBroker) Publish(context.Context, *domain.Notification) error {
+	return nil
+}
+
+type fakeActivityRepo struct {
+	domain.ActivityRepository
+	activities []*domain.Activity
+}
+
+func (r *fakeActivityRepo) Create(_ context.Context, activity *domain.Activity) error {
+	r.activities = append(r.activities, activity)
+	return nil
+}
+
+// fakeActionRepo records actions in taken; raced makes Create fail as if
+// another moderator had taken the same action first.
+type fakeActionRepo struct {
+	domain.ModerationActionRepository
+	taken map[string]bool
+	raced bool
+}
+
+func (r *fakeActionRepo) Create(_ context.Context, action *domain.ModerationAction) (int64, error) {
+	if r.raced {
+		return 0, &domain.UniqueViolationError{Constraint: "idx_moderation_actions_once"}
+	}
+	r.taken[action.TargetType+":"+action.Action+":"+strconv.FormatInt(action.TargetID, 10)] = true
+	return 0, nil
+}
+
+func (r *fakeActionRepo) Exists(_ context.Context, targetType string, targetID int64, action string) (bool, error) {
+	return r.taken[targetType+":"+action+":"+strconv.FormatInt(targetID, 10)], nil
+}
+
+type testPostService struct {
+	*PostService
+	posts         *fakePostRepo
+	votes         *fakeVoteRepo
+	revisions     *fakeRevisionRepo
+	actions       *fakeActionRepo
+	links         *fakeLinkRepo
+	notifications *fakeNotificationRepo
+	activities    *fakeActivityRepo
+	follows       *FollowService
+}
+
+func newTestPostService(t *testing.T) *testPostService {
+	t.Helper()
+	renderer, err := markdown.New("github")
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := logger.New("error")
+	permissions, _, _ := newTestPermissionService()
+	permissions.users = &fakeRoleUserRepo{users: map[int64]*domain.User{
+		1: {ID: 1, Role: domain.RoleUser},
+	}}
+	md := NewMarkdownService(renderer, config.MarkdownConfig{MaxLength: 1000}, log)
+	revisions := &fakeRevisionRepo{}
+	actions := &fakeActionRepo{taken: map[string]bool{}}
+	posts := &fakePostRepo{
+		questions: map[int64]*domain.Question{1: {ID: 1, AuthorID: 1}},
+		answers: map[int64]*domain.Answer{
+			2: {ID: 2, QuestionID: 1, AuthorID: 1},
+			3: {ID: 3, QuestionID: 4, AuthorID: 2},
+		},
+	}
+	votes := &fakeVoteRepo{votes: map[string]int{}}
+	categories := &fakeCategoryRepo{categories: map[int64]*domain.Category{
+		1: {ID: 1, Title: "Go"},
+		2: {ID: 2, Title: "SQL"},
+	}}
+	links := &fakeLinkRepo{uploads: map[int64]int64{5: 1, 6: 1, 7: 2}, targets: map[int64]string{}}
+	moderation := NewModerationService(nil, actions, posts, nil, nil, nil, permissions, nil, log)
+	revisionSvc := NewRevisionService(revisions, nil, md, permissions, log)
+	attachments := NewAttachmentService(links, nil, config.AttachmentConfig{}, log)
+	notifications := &fakeNotificationRepo{}
+	email := NewNotificationEmailService(notifications, &fakePreferenceRepo{}, fakeUserRepo{}, nil, config.NotificationConfig{}, "", log)
+	notificationSvc := NewNotificationService(notifications, fakeUserRepo{}, fakeBroker{}, email, log)
+	activities := &fakeActivityRepo{}
+	follows := NewFollowService(nil, activities, nil, nil, posts, log)
+	return &testPostService{
+		PostService:   NewPostService(posts, votes, categories, nil, revisionSvc, moderation, attachments, notificationSvc, follows, md, log),
+		posts:         posts,
+		votes:         votes,
+		revisions:     revisions,
+		actions:       actions,
+		links:         links,
+		notifications: notifications,
+		activities:    activities,
+		follows:       follows,
+	}
+}
+
+func TestAskChecksCategories(t *testing.T) {
+	svc := newTestPostService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		categoryIDs []int64
+		want        error
+	}{
+		{"none", nil, ErrInvalidCategories},
+		{"duplicate", []int64{1, 1}, ErrInvalidCategories},
+		{"too many", []int64{1, 2, 3, 4, 5, 6}, ErrInvalidCategories},
+		{"unknown", []int64{1, 3}, ErrPostCategoryNotFound},
+		{"valid", []int64{1, 2}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Ask(ctx, 1, tt.categoryIDs, "Title", "Body", nil)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Ask() err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOnlyAuthorsChangePosts(t *testing.T) {
+	svc := newTestPostService(t)
+	ctx := context.Background()
+
+	if _, err := svc.EditQuestion(ctx, 2, 1, "Other", "Other", "", nil); !errors.Is(err, ErrPostForbidden) {
+		t.Errorf("edit by another user: err = %v, want ErrPostForbidden", err)
+	}
+	if err := svc.DeleteAnswer(ctx, 2, 2); !errors.Is(err, ErrPostForbidden) {
+		t.Errorf("delete by another user: err = %v, want ErrPostForbidden", err)
+	}
+
+	if _, err := svc.EditQuestion(ctx, 1, 1, "  New title  ", "New body", "", nil); err != nil {
+		t.Fatalf("EditQuestion: %v", err)
+	}
+	if r := svc.revisions.latest(domain.PostQuestion, 1); r == nil || r.Title != "New title" || r.Body != "New body" {
+		t.Errorf("latest revision %+v, want New title/New body", r)
+	}
+	if err := svc.DeleteAnswer(ctx, 1, 2); err != nil {
+		t.Fatalf("DeleteAnswer: %v", err)
+	}
+	if _, err := svc.EditAnswer(ctx, 1, 2, "Body", "", nil); !errors.Is(err, ErrAnswerNotFound) {
+		t.Errorf("edit of a deleted answer: err = %v, want ErrAnswerNotFound", err)
+	}
+}
+
+func TestVoteMovesScoreAndRating(t *testing.T) {
+	svc := newTestPostService(t)
+	votes := svc.votes
+	ctx := context.Background()
+
+	if _, err := svc.Vote(ctx, 1, domain.PostQuestion, 1, domain.VoteUp); !errors.Is(err, ErrCannotVoteOwnPost) {
+		t.Errorf("vote on own post: err = %v, want ErrCannotVoteOwnPost", err)
+	}
+	if _, err := svc.Vote(ctx, 2, "comment", 1, domain.VoteUp); !errors.Is(err, ErrInvalidPostTarget) {
+		t.Errorf("vote on a comment: err = %v, want ErrInvalidPostTarget", err)
+	}
+
+	for _, tt := range []struct {
+		value, score, rating int
+	}{
+		{domain.VoteUp, 1, 1},
+		{domain.VoteDown, -1, -1},
+		{0, 0, 0},
+	} {
+		score, err := svc.Vote(ctx, 2, domain.PostAnswer, 2, tt.value)
+		if err != nil {
+			t.Fatalf("Vote(%d): %v", tt.value, err)
+		}
+		if score != tt.score || votes.rating != tt.rating {
+			t.Errorf("Vote(%d): score %d, rating %d, want %d, %d", tt.value, score, votes.rating, tt.score, tt.rating)
+		}
+	}
+}
+
+func TestAcceptChecksQuestionAndAnswer(t *testing.T) {
+	svc := newTestPostService(t)
+	posts := svc.posts
+	ctx := context.Background()
+
+	if err := svc.Accept(ctx, 2, 1, 2); !errors.Is(err, ErrAcceptForbidden) {
+		t.Errorf("accept by another user: err = %v, want ErrAcceptForbidden", err)
+	}
+	if err := svc.Accept(ctx, 1, 1, 3); !errors.Is(err, ErrAnswerNotOfQuestion) {
+		t.Errorf("accept an answer to another question: err = %v, want ErrAnswerNotOfQuestion", err)
+	}
+	if err := svc.Accept(ctx, 1, 1, 2); err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	if id := posts.questions[1].AcceptedAnswerID; id != 2 {
+		t.Errorf("accepted answer %d, want 2", id)
+	}
+	if err := svc.Unaccept(ctx, 1, 1); err != nil {
+		t.Fatalf("Unaccept: %v", err)
+	}
+	if id := posts.questions[1].AcceptedAnswerID; id != 0 {
+		t.Errorf("accepted answer %d after Unaccept, want 0", id)
+	}
+}
+
+func TestEditRecordsRevisionsAndSkipsUnchangedContent(t *testing.T) {
+	svc := newTestPostService(t)
+	revisions := svc.revisions
+	ctx := context.Background()
+
+	for _, body := range []string{"first", "second", "second"} {
+		if _, err := svc.EditQuestion(ctx, 1, 1, "Title", body, "", nil); err != nil {
+			t.Fatalf("EditQuestion(%q): %v", body, err)
+		}
+	}
+	if len(revisions.revisions) != 2 {
+		t.Fatalf("stored %d revisions, want 2", len(revisions.revisions))
+	}
+	if n := revisions.revisions[1].Number; n != 2 {
+		t.Errorf("second revision numbered %d, want 2", n)
+	}
+
+	revisions.conflict = true
+	if _, err := svc.EditQuestion(ctx, 1, 1, "Title", "third", "", nil); !errors.Is(err, ErrRevisionConflict) {
+		t.Errorf("concurrent edit: err = %v, want ErrRevisionConflict", err)
+	}
+}
+
+func TestRollbackRespectsLockAndDelete(t *testing.T) {
+	svc := newTestPostService(t)
+	actions := svc.actions
+	ctx := context.Background()
+
+	for _, body := range []string{"first", "second"} {
+		if _, err := svc.EditAnswer(ctx, 1, 2, body, "", nil); err != nil {
+			t.Fatalf("EditAnswer(%q): %v", body, err)
+		}
+	}
+
+	actions.taken["question:lock:1"] = true
+	if _, err := svc.Rollback(ctx, 1, domain.PostAnswer, 2, 1, ""); !errors.Is(err, ErrPostLocked) {
+		t.Errorf("rollback of an answer to a locked question: err = %v, want ErrPostLocked", err)
+	}
+	if _, err := svc.EditAnswer(ctx, 1, 2, "third", "", nil); !errors.Is(err, ErrPostLocked) {
+		t.Errorf("edit of an answer to a locked question: err = %v, want ErrPostLocked", err)
+	}
+
+	actions.taken["question:lock:1"] = false
+	actions.taken["answer:delete:2"] = true
+	if _, err := svc.Rollback(ctx, 1, domain.PostAnswer, 2, 1, ""); !errors.Is(err, ErrAnswerNotFound) {
+		t.Errorf("rollback of a deleted answer: err = %v, want ErrAnswerNotFound", err)
+	}
+
+	actions.taken["answer:delete:2"] = false
+	actions.taken["question:delete:1"] = true
+	if _, err := svc.Rollback(ctx, 1, domain.PostAnswer, 2, 1, ""); !errors.Is(err, ErrAnswerNotFound) {
+		t.Errorf("rollback of an answer to a deleted question: err = %v, want ErrAnswerNotFound", err)
+	}
+	if _, err := svc.Vote(ctx, 2, domain.PostAnswer, 2, domain.VoteUp); !errors.Is(err, ErrAnswerNotFound) {
+		t.Errorf("vote on an answer to a deleted question: err = %v, want ErrAnswerNotFound", err)
+	}
+
+	actions.taken["question:delete:1"] = false
+	revision, err := svc.Rollback(ctx, 1, domain.PostAnswer, 2, 1, "")
+	if err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+	if revision.Number != 3 || revision.Body != "first" || revision.RollbackOf != 1 {
+		t.Errorf("rollback recorded revision %d with body %q rolling back %d, want 3, first, 1", revision.Number, revision.Body, revision.RollbackOf)
+	}
+}
+
+func TestAnswerAttachmentsLinkedAndReleased(t *testing.T) {
+	svc := newTestPostService(t)
+	links := svc.links
+	ctx := context.Background()
+
+	if _, err := svc.Answer(ctx, 1, 1, "Look at this", []int64{5, 7}); !errors.Is(err, ErrAttachmentNotFound) {
+		t.Fatalf("answer with another user's upload: err = %v, want ErrAttachmentNotFound", err)
+	}
+	if len(links.targets) != 0 {
+		t.Errorf("rejected answer linked %v", links.targets)
+	}
+
+	answer, err := svc.Answer(ctx, 1, 1, "Look at this", []int64{5, 6})
+	if err != nil {
+		t.Fatalf("Answer: %v", err)
+	}
+	if _, err := svc.EditAnswer(ctx, 1, answer.ID, "Look at this one", "", []int64{6}); err != nil {
+		t.Fatalf("EditAnswer: %v", err)
+	}
+	if _, ok := links.targets[5]; ok || links.targets[6] == "" {
+		t.Errorf("after edit links are %v, want only 6", links.targets)
+	}
+
+	if err := svc.DeleteAnswer(ctx, 1, answer.ID); err != nil {
+		t.Fatalf("DeleteAnswer: %v", err)
+	}
+	if len(links.targets) != 0 {
+		t.Errorf("deleted answer kept attachments %v", links.targets)
+	}
+}
+
+func TestAnswerAndVoteNotifyAuthors(t *testing.T) {
+	svc := newTestPostService(t)
+	ctx := context.Background()
+
+	answer, err := svc.Answer(ctx, 3, 1, "Try this", nil)
+	if err != nil {
+		t.Fatalf("Answer: %v", err)
+	}
+	if len(svc.notifications.created) != 1 || svc.notifications.created[0].Type != domain.NotificationAnswer || svc.notifications.created[0].UserID != 1 {
+		t.Fatalf("answer created notifications %+v, want one answer notification for user 1", svc.notifications.created)
+	}
+	if _, err := svc.Answer(ctx, 1, 1, "Answering myself", nil); err != nil {
+		t.Fatalf("Answer: %v", err)
+	}
+	if len(svc.notifications.created) != 1 {
+		t.Errorf("own answer created %d notifications, want none", len(svc.notifications.created)-1)
+	}
+
+	if _, err := svc.Vote(ctx, 3, domain.PostAnswer, answer.ID, domain.VoteUp); !errors.Is(err, ErrCannotVoteOwnPost) {
+		t.Errorf("vote on own answer: err = %v, want ErrCannotVoteOwnPost", err)
+	}
+	if _, err := svc.Vote(ctx, 2, domain.PostQuestion, 1, 2); !errors.Is(err, ErrInvalidVote) {
+		t.Errorf("vote of 2: err = %v, want ErrInvalidVote", err)
+	}
+
+	svc.votes.rating = 9
+	score, err := svc.Vote(ctx, 2, domain.PostQuestion, 1, domain.VoteUp)
+	if err != nil {
+		t.Fatalf("Vote: %v", err)
+	}
+	if score != 1 {
+		t.Errorf("score = %d, want 1", score)
+	}
+	last := svc.notifications.created[len(svc.notifications.created)-1]
+	if last.Type != domain.NotificationReputationMilestone || last.UserID != 1 || last.Milestone != 10 {
+		t.Errorf("last notification %+v, want milestone 10 for user 1", last)
+	}
+
+	created := len(svc.notifications.created)
+	if _, err := svc.Vote(ctx, 2, domain.PostQuestion, 1, domain.VoteUp); err != nil {
+		t.Fatalf("repeated Vote: %v", err)
+	}
+	if len(svc.notifications.created) != created {
+		t.Errorf("repeated vote created another notification")
+	}
+}
+
+func TestAnswerReachesFollowersAndQuestionsMustExist(t *testing.T) {
+	svc := newTestPostService(t)
+	ctx := context.Background()
+
+	answer, err := svc.Answer(ctx, 3, 1, "Try this", nil)
+	if err != nil {
+		t.Fatalf("Answer: %v", err)
+	}
+	if len(svc.activities.activities) != 1 {
+		t.Fatalf("answer recorded %d activities, want 1", len(svc.activities.activities))
+	}
+	activity := svc.activities.activities[0]
+	if activity.Type != domain.ActivityAnswer || activity.PostID != answer.ID || activity.QuestionID != 1 {
+		t.Errorf("activity %+v, want answer %d to question 1", activity, answer.ID)
+	}
+
+	err = svc.follows.checkTarget(ctx, 3, domain.FollowTarget{Type: domain.FollowQuestion, ID: 99})
+	if !errors.Is(err, ErrFollowTargetNotFound) {
+		t.Errorf("follow of a missing question: err = %v, want ErrFollowTargetNotFound", err)
+	}
+	if err := svc.follows.checkTarget(ctx, 3, domain.FollowTarget{Type: domain.FollowQuestion, ID: 1}); err != nil {
+		t.Errorf("follow of question 1: %v", err)
+	}
+}
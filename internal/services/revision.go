@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/markdown"
+	"github.com/RofaBR/Go-Usof/pkg/diff"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+// maxSummaryLength matches the summary column.
+const maxSummaryLength = 300
+
+var (
+	ErrRevisionNotFound      = errors.New("revision not found")
+	ErrInvalidRevisionTarget = errors.New("only questions and answers have revisions")
+	ErrRollbackToCurrent     = errors.New("revision is already the current one")
+	ErrRollbackForbidden     = errors.New("only the author or a moderator can roll back")
+	ErrRevisionConflict      = errors.New("post was edited at the same time, try again")
+)
+
+// RevisionDiff compares two revisions of the same post.
+type RevisionDiff struct {
+	From         *domain.Revision
+	To           *domain.Revision
+	TitleChanged bool
+	Lines        []diff.Line
+}
+
+// RevisionService keeps every version of questions and answers.
+// PostService calls Record whenever a post is created or edited; the latest
+// revision is the post's current content, so a rollback takes effect by
+// recording the old content as a new revision. Each revision carries its
+// body rendered to HTML.
+type RevisionService struct {
+	repo        domain.RevisionRepository
+	users       domain.UserRepository
+	markdown    *MarkdownService
+	permissions *PermissionService
+	log         *logger.Logger
+}
+
+func NewRevisionService(repo domain.RevisionRepository, users domain.UserRepository, markdown *MarkdownService, permissions *PermissionService, log *logger.Logger) *RevisionService {
+	return &RevisionService{repo: repo, users: users, markdown: markdown, permissions: permissions, log: log}
+}
+
+// Record saves title and body as the next revision of the post unless they
+// equal the current one, which is then returned instead.
+func (s *RevisionService) Record(ctx context.Context, targetType string, targetID, authorID int64, title, body, summary string) (*domain.Revision, error) {
+	if !validRevisionTarget(targetType) {
+		return nil, ErrInvalidRevisionTarget
+	}
+	return s.record(ctx, &domain.Revision{
+		TargetType: targetType,
+		TargetID:   targetID,
+		AuthorID:   authorID,
+		Title:      title,
+		Body:       body,
+		Summary:    summary,
+	})
+}
+
+func (s *RevisionService) record(ctx context.Context, revision *domain.Revision) (*domain.Revision, error) {
+	if runes := []rune(revision.Summary); len(runes) > maxSummaryLength {
+		revision.Summary = string(runes[:maxSummaryLength])
+	}
+	html, err := s.markdown.Render(revision.Body)
+	if err != nil {
+		return nil, err
+	}
+	revision.BodyHTML = html
+	revision.RenderVersion = markdown.Version
+
+	created, err := s.repo.Create(ctx, revision)
+	if errors.Is(err, domain.ErrUniqueViolation) {
+		return nil, ErrRevisionConflict
+	}
+	if err != nil {
+		s.log.Error("failed to save revision", "target_type", revision.TargetType, "target_id", revision.TargetID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if !created {
+		s.refreshHTML(ctx, revision)
+		return revision, nil
+	}
+	s.log.Info("revision saved", "target_type", revision.TargetType, "target_id", revision.TargetID, "revision", revision.Number)
+	return revision, nil
+}
+
+// Latest returns the current revision of each of the posts keyed by post ID.
+func (s *RevisionService) Latest(ctx context.Context, targetType string, targetIDs []int64) (map[int64]*domain.Revision, error) {
+	if !validRevisionTarget(targetType) {
+		return nil, ErrInvalidRevisionTarget
+	}
+	revisions, err := s.repo.LatestOf(ctx, targetType, targetIDs)
+	if err != nil {
+		s.log.Error("failed to load latest revisions", "target_type", targetType, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	latest := make(map[int64]*domain.Revision, len(revisions))
+	for _, r := range revisions {
+		s.refreshHTML(ctx, r)
+		latest[r.TargetID] = r
+	}
+	return latest, nil
+}
+
+// List returns the revisions of a post newest first, together with their
+// authors keyed by ID.
+func (s *RevisionService) List(ctx context.Context, targetType string, targetID int64) ([]*domain.Revision, map[int64]*domain.User, error) {
+	if !validRevisionTarget(targetType) {
+		return nil, nil, ErrInvalidRevisionTarget
+	}
+	revisions, err := s.repo.List(ctx, targetType, targetID)
+	if err != nil {
+		s.log.Error("failed to list revisions", "target_type", targetType, "target_id", targetID, "error", err)
+		return nil, nil, fmt.Errorf("database error: %v", err)
+	}
+	if len(revisions) == 0 {
+		return nil, nil, ErrRevisionNotFound
+	}
+	authors, err := s.Authors(ctx, revisions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return revisions, authors, nil
+}
+
+func (s *RevisionService) Get(ctx context.Context, targetType string, targetID int64, number int) (*domain.Revision, error) {
+	if !validRevisionTarget(targetType) {
+		return nil, ErrInvalidRevisionTarget
+	}
+	revision, err := s.repo.Get(ctx, targetType, targetID, number)
+	if err != nil {
+		s.log.Error("failed to load revision", "target_type", targetType, "target_id", targetID, "revision", number, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if revision == nil {
+		return nil, ErrRevisionNotFound
+	}
+	s.refreshHTML(ctx, revision)
+	return revision, nil
+}
+
+// refreshHTML renders revision again when its cached HTML comes from an
+// older renderer version. Failures keep the stale HTML.
+func (s *RevisionService) refreshHTML(ctx context.Context, revision *domain.Revision) {
+	if revision.RenderVersion == markdown.Version {
+		return
+	}
+	html, err := s.markdown.Render(revision.Body)
+	if err != nil {
+		return
+	}
+	revision.BodyHTML = html
+	revision.RenderVersion = markdown.Version
+	if err := s.repo.UpdateRendered(ctx, revision.ID, html, markdown.Version); err != nil {
+		s.log.Error("failed to cache rendered revision", "revision_id", revision.ID, "error", err)
+	}
+}
+
+// Diff compares revision from with revision to. A to of 0 means the
+// current revision and a from of 0 the one before to.
+func (s *RevisionService) Diff(ctx context.Context, targetType string, targetID int64, from, to int) (*RevisionDiff, error) {
+	if to == 0 {
+		if !validRevisionTarget(targetType) {
+			return nil, ErrInvalidRevisionTarget
+		}
+		latest, err := s.repo.Latest(ctx, targetType, targetID)
+		if err != nil {
+			s.log.Error("failed to load latest revision", "target_type", targetType, "target_id", targetID, "error", err)
+			return nil, fmt.Errorf("database error: %v", err)
+		}
+		if latest == nil {
+			return nil, ErrRevisionNotFound
+		}
+		to = latest.Number
+	}
+	if from == 0 {
+		from = max(to-1, 1)
+	}
+
+	fromRevision, err := s.Get(ctx, targetType, targetID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := s.Get(ctx, targetType, targetID, to)
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionDiff{
+		From:         fromRevision,
+		To:           toRevision,
+		TitleChanged: fromRevision.Title != toRevision.Title,
+		Lines:        diff.Lines(fromRevision.Body, toRevision.Body),
+	}, nil
+}
+
+// Rollback restores an earlier revision by recording its content as a new
+// one. Only the post's author, taken from its first revision, and roles
+// with the post:rollback permission may do so. It does not know whether the
+// post is locked or deleted; PostService.Rollback checks that first.
+func (s *RevisionService) Rollback(ctx context.Context, actorID int64, targetType string, targetID int64, number int, summary string) (*domain.Revision, error) {
+	target, err := s.Get(ctx, targetType, targetID, number)
+	if err != nil {
+		return nil, err
+	}
+	moderator, err := s.permissions.UserHas(ctx, actorID, domain.PermissionPostRollback)
+	if err != nil {
+		return nil, err
+	}
+	if !moderator {
+		first, err := s.Get(ctx, targetType, targetID, 1)
+		if err != nil {
+			return nil, err
+		}
+		if first.AuthorID != actorID {
+			return nil, ErrRollbackForbidden
+		}
+	}
+
+	latest, err := s.repo.Latest(ctx, targetType, targetID)
+	if err != nil {
+		s.log.Error("failed to load latest revision", "target_type", targetType, "target_id", targetID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if latest.Number == number || latest.Title == target.Title && latest.Body == target.Body {
+		return nil, ErrRollbackToCurrent
+	}
+
+	if summary == "" {
+		summary = "Rolled back to revision " + strconv.Itoa(number)
+	}
+	revision, err := s.record(ctx, &domain.Revision{
+		TargetType: targetType,
+		TargetID:   targetID,
+		AuthorID:   actorID,
+		Title:      target.Title,
+		Body:       target.Body,
+		Summary:    summary,
+		RollbackOf: number,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("post rolled back", "target_type", targetType, "target_id", targetID, "revision", number, "actor_id", actorID)
+	return revision, nil
+}
+
+func (s *RevisionService) Authors(ctx context.Context, revisions []*domain.Revision) (map[int64]*domain.User, error) {
+	authors := make(map[int64]*domain.User)
+	for _, r := range revisions {
+		if r.AuthorID == 0 || authors[r.AuthorID] != nil {
+			continue
+		}
+		author, err := s.users.GetByID(ctx, r.AuthorID)
+		if err != nil {
+			s.log.Error("failed to load revision author", "author_id", r.AuthorID, "error", err)
+			return nil, fmt.Errorf("database error: %v", err)
+		}
+		if author != nil {
+			authors[r.AuthorID] = author
+		}
+	}
+	return authors, nil
+}
+
+func validRevisionTarget(targetType string) bool {
+	return targetType == domain.RevisionTargetQuestion || targetType == domain.RevisionTargetAnswer
+}
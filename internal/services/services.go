@@ -2,33 +2,84 @@ package services
 
 import (
 	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/internal/mail"
+	"github.com/RofaBR/Go-Usof/internal/markdown"
 	"github.com/RofaBR/Go-Usof/internal/repositories"
 	"github.com/RofaBR/Go-Usof/pkg/logger"
 )
 
 type Service struct {
-	User     *UserService
-	Token    *TokenService
-	Email    *SMTPSender
-	Image    *CloudinaryService
-	OAuth2   *OAuth2Service
-	Category *CategoryService
+	User              *UserService
+	Token             *TokenService
+	Email             *MailService
+	MailOutbox        *MailOutbox
+	Image             *ImageService
+	OAuth2            *OAuth2Service
+	Category          *CategoryService
+	LoginGuard        *LoginGuardService
+	Password          *PasswordPolicyService
+	Hasher            *PasswordHashService
+	Username          *UsernameService
+	Attachment        *AttachmentService
+	Notification      *NotificationService
+	Hub               *NotificationHub
+	NotificationEmail *NotificationEmailService
+	Follow            *FollowService
+	Bookmark          *BookmarkService
+	Revision          *RevisionService
+	Markdown          *MarkdownService
+	Moderation        *ModerationService
+	Permission        *PermissionService
+	Post              *PostService
+	Comment           *CommentService
 }
 
-func NewServices(log *logger.Logger, repos *repositories.Repository, config *config.Config) *Service {
+func NewServices(log *logger.Logger, repos *repositories.Repository, storage domain.ObjectStorage, transport domain.MailTransport, renderer *mail.Renderer, md *markdown.Renderer, config *config.Config) *Service {
 	tokenSvc := NewTokenService(repos.Token, config.JWT)
-	emailSvc := NewSMTPSender(config.Sender)
-	cloudinarySvc := NewCloudinaryService(config.CloudinaryURL)
-	userSvc := NewUserService(repos.User, log)
-	oauth2Svc := NewOAuth2Service(&config.OAuth2, repos.User, log)
+	mailOutbox := NewMailOutbox(repos.EmailOutbox, transport, config.MailOutbox, log)
+	emailSvc := NewMailService(mailOutbox, renderer, config.BaseURL, log)
+	imageSvc := NewImageService(storage, config.Image, log)
+	loginGuardSvc := NewLoginGuardService(repos.LoginAttempt, emailSvc, config.LoginGuard, log)
+	passwordPolicySvc := NewDefaultPasswordPolicy(config.Password, log)
+	passwordHashSvc := NewDefaultPasswordHashService(config.PasswordHash)
+	usernameSvc := NewUsernameService(repos.User, config.Username, log)
+	userSvc := NewUserService(repos.User, loginGuardSvc, passwordPolicySvc, passwordHashSvc, usernameSvc, log)
+	oauth2Svc := NewOAuth2Service(&config.OAuth2, repos.User, passwordHashSvc, usernameSvc, log)
 	CategorySvc := NewCategoryService(repos.Category, log)
+	attachmentSvc := NewAttachmentService(repos.Attachment, imageSvc, config.Attachment, log)
+	notificationEmailSvc := NewNotificationEmailService(repos.Notification, repos.NotificationPreference, repos.User, emailSvc, config.Notification, config.BaseURL, log)
+	notificationSvc := NewNotificationService(repos.Notification, repos.User, repos.NotificationBroker, notificationEmailSvc, log)
+	markdownSvc := NewMarkdownService(md, config.Markdown, log)
+	permissionSvc := NewPermissionService(repos.Permission, repos.PermissionCache, repos.User, tokenSvc, config.Permission, log)
+	followSvc := NewFollowService(repos.Follow, repos.Activity, repos.User, repos.Category, repos.Post, log)
+	revisionSvc := NewRevisionService(repos.Revision, repos.User, markdownSvc, permissionSvc, log)
+	moderationSvc := NewModerationService(repos.Flag, repos.ModerationAction, repos.Post, repos.Comment, repos.User, notificationSvc, permissionSvc, tokenSvc, log)
+	postSvc := NewPostService(repos.Post, repos.Vote, repos.Category, repos.User, revisionSvc, moderationSvc, attachmentSvc, notificationSvc, followSvc, markdownSvc, log)
 
 	return &Service{
-		User:     userSvc,
-		Token:    tokenSvc,
-		Email:    emailSvc,
-		Image:    cloudinarySvc,
-		OAuth2:   oauth2Svc,
-		Category: CategorySvc,
+		User:              userSvc,
+		Token:             tokenSvc,
+		Email:             emailSvc,
+		MailOutbox:        mailOutbox,
+		Image:             imageSvc,
+		OAuth2:            oauth2Svc,
+		Category:          CategorySvc,
+		LoginGuard:        loginGuardSvc,
+		Password:          passwordPolicySvc,
+		Hasher:            passwordHashSvc,
+		Username:          usernameSvc,
+		Attachment:        attachmentSvc,
+		Notification:      notificationSvc,
+		Hub:               NewNotificationHub(repos.NotificationBroker, log),
+		NotificationEmail: notificationEmailSvc,
+		Follow:            followSvc,
+		Bookmark:          NewBookmarkService(repos.Bookmark, repos.BookmarkCollection, log),
+		Revision:          revisionSvc,
+		Markdown:          markdownSvc,
+		Moderation:        moderationSvc,
+		Permission:        permissionSvc,
+		Post:              postSvc,
+		Comment:           NewCommentService(repos.Comment, repos.User, postSvc, notificationSvc, followSvc, log),
 	}
 }
@@ -25,13 +25,14 @@ func NewTokenService(repo domain.TokenRepository, cfg config.JWTConfig) *TokenSe
 }
 
 func (t *TokenService) GenerateTokenPair(ctx context.Context, user *domain.User) (*domain.TokenPair, error) {
+	now := time.Now()
+	refreshStr := uuid.New().String()
 	accessJTI := uuid.New().String()
-	accessToken, err := t.generateAccessToken(user, accessJTI)
+	accessToken, err := t.generateAccessToken(user, accessJTI, refreshStr, now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
-	refreshStr := uuid.New().String()
 	refreshToken, err := t.generateRefreshToken(user, refreshStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
@@ -40,7 +41,7 @@ func (t *TokenService) GenerateTokenPair(ctx context.Context, user *domain.User)
 	metadata := domain.RefreshTokenMetadata{
 		UserID:           strconv.FormatInt(user.ID, 10),
 		JTI:              refreshStr,
-		CreatedAt:        time.Now(),
+		CreatedAt:        now,
 		ExpiresAt:        time.Now().Add(time.Duration(t.config.RefreshTTL) * 24 * time.Hour),
 		AbsoluteExpireAt: time.Now().Add(30 * 24 * time.Hour),
 	}
@@ -112,12 +113,25 @@ func (t *TokenService) parseToken(tokenString, secret string) (*domain.TokenClai
 		userID = strconv.FormatInt(int64(val), 10)
 	}
 
+	var authTime time.Time
+	if val, ok := claims["auth_time"].(float64); ok {
+		authTime = time.Unix(int64(val), 0)
+	}
+
+	var expiresAt time.Time
+	if val, ok := claims["exp"].(float64); ok {
+		expiresAt = time.Unix(int64(val), 0)
+	}
+
 	return &domain.TokenClaims{
-		UserID: userID,
-		Email:  getStringClaim(claims, "email"),
-		Role:   getStringClaim(claims, "role"),
-		JTI:    getStringClaim(claims, "jti"),
-		Type:   getStringClaim(claims, "type"),
+		UserID:    userID,
+		Email:     getStringClaim(claims, "email"),
+		Role:      getStringClaim(claims, "role"),
+		JTI:       getStringClaim(claims, "jti"),
+		Type:      getStringClaim(claims, "type"),
+		SessionID: getStringClaim(claims, "sid"),
+		AuthTime:  authTime,
+		ExpiresAt: expiresAt,
 	}, nil
 }
 
@@ -128,14 +142,16 @@ func getStringClaim(claims jwt.MapClaims, key string) string {
 	return ""
 }
 
-func (t *TokenService) generateAccessToken(user *domain.User, jti string) (string, error) {
+func (t *TokenService) generateAccessToken(user *domain.User, jti, sessionID string, authTime time.Time) (string, error) {
 	claims := jwt.MapClaims{
-		"user_id": user.ID,
-		"role":    user.Role,
-		"type":    "access",
-		"exp":     time.Now().Add(time.Duration(t.config.AccessTTL) * time.Minute).Unix(),
-		"iat":     time.Now().Unix(),
-		"jti":     jti,
+		"user_id":   user.ID,
+		"role":      user.Role,
+		"type":      "access",
+		"exp":       time.Now().Add(time.Duration(t.config.AccessTTL) * time.Minute).Unix(),
+		"iat":       time.Now().Unix(),
+		"jti":       jti,
+		"sid":       sessionID,
+		"auth_time": authTime.Unix(),
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(t.config.AccessSecret))
@@ -161,6 +177,22 @@ func (t *TokenService) RevokeToken(ctx context.Context, refreshToken string) err
 	return t.repo.DeleteRefreshToken(ctx, claims.JTI)
 }
 
+// RevokeOtherSessions deletes every refresh token of the user except the one
+// backing the current session. Access tokens already issued to the other
+// sessions stay valid until they expire.
+func (t *TokenService) RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) error {
+	return t.repo.DeleteUserRefreshTokens(ctx, userID, currentSessionID)
+}
+
+// IsRecentlyAuthenticated reports whether the user presented credentials
+// within the configured re-authentication window.
+func (t *TokenService) IsRecentlyAuthenticated(claims *domain.TokenClaims) bool {
+	if claims.AuthTime.IsZero() {
+		return false
+	}
+	return time.Since(claims.AuthTime) <= time.Duration(t.config.ReauthWindow)*time.Minute
+}
+
 func (t *TokenService) RefreshAccessToken(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
 	claims, err := t.ValidateRefreshToken(ctx, refreshToken)
 	if err != nil {
@@ -196,14 +228,15 @@ func (t *TokenService) RefreshAccessToken(ctx context.Context, refreshToken stri
 		Role:  claims.Role,
 	}
 	accessJTI := uuid.New().String()
-	accessToken, err := t.generateAccessToken(user, accessJTI)
+	accessToken, err := t.generateAccessToken(user, accessJTI, claims.JTI, metadata.CreatedAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 	return &domain.TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    int64(t.config.AccessTTL * 60),
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresIn:        int64(t.config.AccessTTL * 60),
+		RefreshExpiresIn: int64(ttl.Seconds()),
 	}, nil
 }
 
@@ -244,3 +277,120 @@ func (t *TokenService) ValidateVerificationToken(ctx context.Context, token stri
 func (t *TokenService) DeleteVerificationToken(ctx context.Context, token string) error {
 	return t.repo.DeleteVerificationToken(ctx, token)
 }
+
+// passwordResetTTL is kept short because a reset link grants full access to
+// the account.
+const passwordResetTTL = time.Hour
+
+func (t *TokenService) GeneratePasswordResetToken(ctx context.Context, user *domain.User) (string, error) {
+	token := uuid.New().String()
+
+	metadata := &domain.PasswordResetTokenMetadata{
+		UserID:    user.ID,
+		Email:     user.Email,
+		Token:     token,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(passwordResetTTL),
+	}
+
+	if err := t.repo.StorePasswordResetToken(ctx, metadata, passwordResetTTL); err != nil {
+		return "", fmt.Errorf("failed to store password reset token: %w", err)
+	}
+
+	return token, nil
+}
+
+// ValidatePasswordResetToken checks a token without using it up.
+func (t *TokenService) ValidatePasswordResetToken(ctx context.Context, token string) (*domain.PasswordResetTokenMetadata, error) {
+	metadata, err := t.repo.GetPasswordResetToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+	return checkPasswordResetToken(metadata)
+}
+
+func (t *TokenService) ConsumePasswordResetToken(ctx context.Context, token string) (*domain.PasswordResetTokenMetadata, error) {
+	metadata, err := t.repo.ConsumePasswordResetToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume password reset token: %w", err)
+	}
+	return checkPasswordResetToken(metadata)
+}
+
+func checkPasswordResetToken(metadata *domain.PasswordResetTokenMetadata) (*domain.PasswordResetTokenMetadata, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf("password reset token not found or expired")
+	}
+	if time.Now().After(metadata.ExpiresAt) {
+		return nil, fmt.Errorf("password reset token expired")
+	}
+	return metadata, nil
+}
+
+// emailChangeTTL bounds how long a new address has to be confirmed.
+const emailChangeTTL = 24 * time.Hour
+
+func (t *TokenService) GenerateEmailChangeToken(ctx context.Context, user *domain.User, newEmail string) (string, error) {
+	token := uuid.New().String()
+
+	metadata := &domain.EmailChangeTokenMetadata{
+		UserID:    user.ID,
+		OldEmail:  user.Email,
+		NewEmail:  newEmail,
+		Token:     token,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(emailChangeTTL),
+	}
+
+	if err := t.repo.StoreEmailChangeToken(ctx, metadata, emailChangeTTL); err != nil {
+		return "", fmt.Errorf("failed to store email change token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (t *TokenService) ConsumeEmailChangeToken(ctx context.Context, token string) (*domain.EmailChangeTokenMetadata, error) {
+	metadata, err := t.repo.ConsumeEmailChangeToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume email change token: %w", err)
+	}
+	if metadata == nil {
+		return nil, fmt.Errorf("email change token not found or expired")
+	}
+	if time.Now().After(metadata.ExpiresAt) {
+		return nil, fmt.Errorf("email change token expired")
+	}
+	return metadata, nil
+}
+
+// streamTicketTTL is just long enough for a client to open the stream after
+// asking for the ticket.
+const streamTicketTTL = 30 * time.Second
+
+// IssueStreamTicket returns a random single-use ticket standing in for the
+// access token on GET /notifications/stream. EventSource can't send headers,
+// and a ticket in the URL is harmless once used, unlike an access token that
+// ends up in access logs and browser history.
+func (t *TokenService) IssueStreamTicket(ctx context.Context, claims *domain.TokenClaims) (string, error) {
+	ticket := uuid.New().String()
+	if err := t.repo.StoreStreamTicket(ctx, ticket, claims, streamTicketTTL); err != nil {
+		return "", fmt.Errorf("failed to store stream ticket: %w", err)
+	}
+	return ticket, nil
+}
+
+// RedeemStreamTicket uses up ticket and returns the claims of the access
+// token it was issued for.
+func (t *TokenService) RedeemStreamTicket(ctx context.Context, ticket string) (*domain.TokenClaims, error) {
+	claims, err := t.repo.ConsumeStreamTicket(ctx, ticket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeem stream ticket: %w", err)
+	}
+	if claims == nil {
+		return nil, fmt.Errorf("stream ticket not found or expired")
+	}
+	if !claims.ExpiresAt.IsZero() && time.Now().After(claims.ExpiresAt) {
+		return nil, fmt.Errorf("stream ticket outlived its access token")
+	}
+	return claims, nil
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+type fakeTokenRepo struct {
+	domain.TokenRepository
+	tickets map[string]domain.TokenClaims
+	revoked []string
+}
+
+func (r *fakeTokenRepo) DeleteUserRefreshTokens(_ context.Context, userID, _ string) error {
+	r.revoked = append(r.revoked, userID)
+	return nil
+}
+
+func (r *fakeTokenRepo) StoreStreamTicket(_ context.Context, ticket string, claims *domain.TokenClaims, _ time.Duration) error {
+	r.tickets[ticket] = *claims
+	return nil
+}
+
+func (r *fakeTokenRepo) ConsumeStreamTicket(_ context.Context, ticket string) (*domain.TokenClaims, error) {
+	claims, ok := r.tickets[ticket]
+	if !ok {
+		return nil, nil
+	}
+	delete(r.tickets, ticket)
+	return &claims, nil
+}
+
+func TestStreamTicketIsSingleUse(t *testing.T) {
+	svc := NewTokenService(&fakeTokenRepo{tickets: map[string]domain.TokenClaims{}}, config.JWTConfig{})
+	ctx := context.Background()
+
+	ticket, err := svc.IssueStreamTicket(ctx, &domain.TokenClaims{UserID: "7", ExpiresAt: time.Now().Add(time.Minute)})
+	if err != nil {
+		t.Fatalf("IssueStreamTicket: %v", err)
+	}
+
+	claims, err := svc.RedeemStreamTicket(ctx, ticket)
+	if err != nil {
+		t.Fatalf("RedeemStreamTicket: %v", err)
+	}
+	if claims.UserID != "7" {
+		t.Errorf("ticket redeemed for user %q, want 7", claims.UserID)
+	}
+	if _, err := svc.RedeemStreamTicket(ctx, ticket); err == nil {
+		t.Error("ticket redeemed twice")
+	}
+}
+
+func TestStreamTicketExpiresWithAccessToken(t *testing.T) {
+	svc := NewTokenService(&fakeTokenRepo{tickets: map[string]domain.TokenClaims{}}, config.JWTConfig{})
+	ctx := context.Background()
+
+	ticket, err := svc.IssueStreamTicket(ctx, &domain.TokenClaims{UserID: "7", ExpiresAt: time.Now().Add(-time.Second)})
+	if err != nil {
+		t.Fatalf("IssueStreamTicket: %v", err)
+	}
+	if _, err := svc.RedeemStreamTicket(ctx, ticket); err == nil {
+		t.Error("ticket of an expired access token accepted")
+	}
+}
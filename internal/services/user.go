@@ -4,21 +4,42 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/RofaBR/Go-Usof/internal/domain"
 	"github.com/RofaBR/Go-Usof/pkg/logger"
-	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrEmailTaken              = errors.New("email already taken")
+	ErrInvalidCurrentPassword  = errors.New("current password is incorrect")
+	ErrReauthRequired          = errors.New("recent sign-in required")
+	ErrPasswordUnchanged       = errors.New("new password must differ from the current one")
+	ErrInvalidCredentials      = errors.New("invalid credentials")
+	ErrEmailNotVerified        = errors.New("email not verified")
+	ErrUserBanned              = errors.New("account is banned")
+	ErrInvalidResetToken       = errors.New("invalid or expired password reset token")
+	ErrEmailUnchanged          = errors.New("new email must differ from the current one")
+	ErrInvalidEmailChangeToken = errors.New("invalid or expired email change token")
 )
 
 type UserService struct {
-	repo domain.UserRepository
-	log  *logger.Logger
+	repo      domain.UserRepository
+	guard     *LoginGuardService
+	policy    *PasswordPolicyService
+	hasher    *PasswordHashService
+	usernames *UsernameService
+	log       *logger.Logger
 }
 
-func NewUserService(repo domain.UserRepository, log *logger.Logger) *UserService {
+func NewUserService(repo domain.UserRepository, guard *LoginGuardService, policy *PasswordPolicyService, hasher *PasswordHashService, usernames *UsernameService, log *logger.Logger) *UserService {
 	return &UserService{
-		repo: repo,
-		log:  log,
+		repo:      repo,
+		guard:     guard,
+		policy:    policy,
+		hasher:    hasher,
+		usernames: usernames,
+		log:       log,
 	}
 }
 
@@ -31,17 +52,32 @@ func (s *UserService) Create(ctx context.Context, user *domain.User) error {
 	}
 	if existing != nil {
 		s.log.Warn("user creation failed: email already exists", "email", user.Email)
-		return errors.New("email already taken")
+		return ErrEmailTaken
+	}
+
+	if err := s.usernames.CheckAvailable(ctx, user.Login, 0); err != nil {
+		s.log.Warn("user creation failed: login unavailable", "login", user.Login, "error", err)
+		return err
+	}
+
+	if err := s.policy.Validate(ctx, user.Password, user); err != nil {
+		s.log.Warn("user creation failed: password rejected by policy", "email", user.Email, "error", err)
+		return err
 	}
 
-	hashed, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	hashed, err := s.hasher.Hash(user.Password)
 	if err != nil {
 		s.log.Error("failed to hash password", "error", err)
 		return errors.New("failed to hash password")
 	}
-	user.Password = string(hashed)
+	user.Password = hashed
+	user.HasPassword = true
 
 	if err := s.repo.Create(ctx, user); err != nil {
+		if taken := takenError(err); taken != nil {
+			s.log.Warn("user creation failed: lost a race for email or login", "email", user.Email, "login", user.Login)
+			return taken
+		}
 		s.log.Error("failed to create user in database", "email", user.Email, "error", err)
 		return fmt.Errorf("database error: %v", err)
 	}
@@ -86,8 +122,156 @@ func (s *UserService) GetByID(ctx context.Context, id int64) (*domain.User, erro
 	return user, nil
 }
 
-func (s *UserService) ValidateCredentials(ctx context.Context, email, password string) (*domain.User, error) {
-	s.log.Info("validating user credentials", "email", email)
+// Activity counts the questions, answers and comments shown on a user's
+// public profile.
+func (s *UserService) Activity(ctx context.Context, id int64) (*domain.UserActivity, error) {
+	activity, err := s.repo.Activity(ctx, id)
+	if err != nil {
+		s.log.Error("failed to count user activity", "user_id", id, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	return activity, nil
+}
+
+// UpdateProfile applies the non-nil fields of update.
+func (s *UserService) UpdateProfile(ctx context.Context, userID int64, update domain.ProfileUpdate) (*domain.User, error) {
+	s.log.Info("updating profile", "user_id", userID)
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get user for profile update", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil {
+		s.log.Warn("profile update failed: user not found", "user_id", userID)
+		return nil, errors.New("user not found")
+	}
+
+	if update.FullName == nil && update.Avatar == nil {
+		return user, nil
+	}
+	if update.FullName != nil {
+		fullName := strings.TrimSpace(*update.FullName)
+		update.FullName = &fullName
+		user.FullName = fullName
+	}
+	if update.Avatar != nil {
+		user.Avatar = *update.Avatar
+	}
+
+	if err := s.repo.UpdateProfile(ctx, userID, update); err != nil {
+		s.log.Error("failed to update profile", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+
+	s.log.Info("profile updated successfully", "user_id", userID)
+	return user, nil
+}
+
+// RequestEmailChange checks that userID may move to newEmail. Like a
+// password change it needs the current password, or a recent sign-in for
+// accounts without one. Nothing is changed yet: the caller sends a
+// confirmation link to newEmail and the account keeps its email until
+// ConfirmEmailChange runs.
+func (s *UserService) RequestEmailChange(ctx context.Context, userID int64, newEmail, currentPassword string, recentlyAuthenticated bool) (*domain.User, error) {
+	s.log.Info("requesting email change", "user_id", userID)
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get user for email change", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil {
+		s.log.Warn("email change failed: user not found", "user_id", userID)
+		return nil, errors.New("user not found")
+	}
+
+	if err := s.checkCurrentPassword(user, currentPassword, recentlyAuthenticated); err != nil {
+		s.log.Warn("email change failed: identity not confirmed", "user_id", userID, "error", err)
+		return nil, err
+	}
+	if strings.EqualFold(newEmail, user.Email) {
+		return nil, ErrEmailUnchanged
+	}
+	if err := s.checkEmailAvailable(ctx, newEmail); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ConfirmEmailChange applies a change requested with RequestEmailChange once
+// the new address has been confirmed. The link proves the user reads the
+// new address, so it is verified right away.
+func (s *UserService) ConfirmEmailChange(ctx context.Context, change *domain.EmailChangeTokenMetadata) (*domain.User, error) {
+	s.log.Info("confirming email change", "user_id", change.UserID)
+
+	user, err := s.repo.GetByID(ctx, change.UserID)
+	if err != nil {
+		s.log.Error("failed to get user for email change", "user_id", change.UserID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil || !strings.EqualFold(user.Email, change.OldEmail) {
+		s.log.Warn("email change failed: account changed since the link was sent", "user_id", change.UserID)
+		return nil, ErrInvalidEmailChangeToken
+	}
+	if err := s.checkEmailAvailable(ctx, change.NewEmail); err != nil {
+		return nil, err
+	}
+
+	user.Email = change.NewEmail
+	user.EmailVerified = true
+	if err := s.repo.Update(ctx, user); err != nil {
+		if taken := takenError(err); taken != nil {
+			return nil, taken
+		}
+		s.log.Error("failed to change email", "user_id", user.ID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+
+	s.log.Info("email changed successfully", "user_id", user.ID)
+	return user, nil
+}
+
+func (s *UserService) checkEmailAvailable(ctx context.Context, email string) error {
+	existing, err := s.repo.GetByEmail(ctx, email)
+	if err != nil {
+		s.log.Error("failed to check existing user", "email", email, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if existing != nil {
+		s.log.Warn("email already taken", "email", email)
+		return ErrEmailTaken
+	}
+	return nil
+}
+
+// checkCurrentPassword confirms the identity of a signed-in user before a
+// sensitive change. Accounts created through OAuth never had a usable
+// password, so for them a recent sign-in stands in for it.
+func (s *UserService) checkCurrentPassword(user *domain.User, currentPassword string, recentlyAuthenticated bool) error {
+	if user.HasPassword {
+		if ok, _ := s.hasher.Verify(currentPassword, user.Password); !ok {
+			return ErrInvalidCurrentPassword
+		}
+		return nil
+	}
+	if !recentlyAuthenticated {
+		return ErrReauthRequired
+	}
+	return nil
+}
+
+func (s *UserService) ValidateCredentials(ctx context.Context, email, password, ip string) (*domain.User, error) {
+	s.log.Info("validating user credentials", "email", email, "ip", ip)
+
+	if err := s.guard.Check(ctx, email, ip); err != nil {
+		var lockoutErr *LockoutError
+		if !errors.As(err, &lockoutErr) {
+			s.log.Error("failed to check login guard", "email", email, "ip", ip, "error", err)
+		}
+		return nil, err
+	}
 
 	user, err := s.repo.GetByEmail(ctx, email)
 	if err != nil {
@@ -97,24 +281,155 @@ func (s *UserService) ValidateCredentials(ctx context.Context, email, password s
 
 	if user == nil {
 		s.log.Warn("credential validation failed: user not found", "email", email)
-		return nil, errors.New("invalid credentials")
+		s.registerLoginFailure(ctx, email, ip, false)
+		return nil, ErrInvalidCredentials
 	}
 
 	if !user.EmailVerified {
 		s.log.Warn("credential validation failed: email not verified", "email", email, "user_id", user.ID)
-		return nil, errors.New("email not verified")
+		return nil, ErrEmailNotVerified
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	ok, err := s.hasher.Verify(password, user.Password)
 	if err != nil {
+		s.log.Warn("failed to verify password hash", "email", email, "user_id", user.ID, "error", err)
+	}
+	if !ok {
 		s.log.Warn("credential validation failed: invalid password", "email", email, "user_id", user.ID)
-		return nil, errors.New("invalid credentials")
+		s.registerLoginFailure(ctx, email, ip, true)
+		return nil, ErrInvalidCredentials
+	}
+
+	if user.BannedAt != nil {
+		s.log.Warn("credential validation failed: user banned", "email", email, "user_id", user.ID)
+		return nil, ErrUserBanned
+	}
+
+	if err := s.guard.RegisterSuccess(ctx, email); err != nil {
+		s.log.Warn("failed to reset login failures", "email", email, "error", err)
+	}
+
+	if s.hasher.NeedsRehash(user.Password) {
+		s.rehash(ctx, user, password)
 	}
 
 	s.log.Info("credentials validated successfully", "email", email, "user_id", user.ID)
 	return user, nil
 }
 
+// rehash upgrades a hash made with an older algorithm or parameters while the
+// plaintext is at hand. Failures are logged only; the login itself succeeded.
+// The hash is only replaced if it is still the one just verified, so a
+// password changed in the meantime is not overwritten.
+func (s *UserService) rehash(ctx context.Context, user *domain.User, password string) {
+	hashed, err := s.hasher.Hash(password)
+	if err != nil {
+		s.log.Error("failed to rehash password", "user_id", user.ID, "error", err)
+		return
+	}
+
+	replaced, err := s.repo.ReplacePasswordHash(ctx, user.ID, user.Password, hashed)
+	if err != nil {
+		s.log.Error("failed to store rehashed password", "user_id", user.ID, "error", err)
+		return
+	}
+	if !replaced {
+		s.log.Info("password changed before its hash could be upgraded", "user_id", user.ID)
+		return
+	}
+	user.Password = hashed
+	s.log.Info("password hash upgraded", "user_id", user.ID)
+}
+
+func (s *UserService) registerLoginFailure(ctx context.Context, email, ip string, accountExists bool) {
+	if err := s.guard.RegisterFailure(ctx, email, ip, accountExists); err != nil {
+		s.log.Error("failed to record login failure", "email", email, "ip", ip, "error", err)
+	}
+}
+
+// ChangePassword replaces the user's password after checkCurrentPassword.
+func (s *UserService) ChangePassword(ctx context.Context, userID int64, currentPassword, newPassword string, recentlyAuthenticated bool) error {
+	s.log.Info("changing password", "user_id", userID)
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get user for password change", "user_id", userID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+	if user == nil {
+		s.log.Warn("password change failed: user not found", "user_id", userID)
+		return errors.New("user not found")
+	}
+
+	if err := s.checkCurrentPassword(user, currentPassword, recentlyAuthenticated); err != nil {
+		s.log.Warn("password change failed: identity not confirmed", "user_id", userID, "error", err)
+		return err
+	}
+	if user.HasPassword && currentPassword == newPassword {
+		return ErrPasswordUnchanged
+	}
+
+	if err := s.policy.Validate(ctx, newPassword, user); err != nil {
+		s.log.Warn("password change failed: password rejected by policy", "user_id", userID, "error", err)
+		return err
+	}
+
+	hashed, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		s.log.Error("failed to hash password", "error", err)
+		return errors.New("failed to hash password")
+	}
+	if err := s.repo.UpdatePassword(ctx, userID, hashed, false); err != nil {
+		s.log.Error("failed to update password", "user_id", userID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+
+	s.log.Info("password changed successfully", "user_id", userID)
+	return nil
+}
+
+// CheckPasswordReset returns the user a password reset link was sent to once
+// newPassword passes the policy. A link issued before the email was changed
+// is rejected. Nothing is changed, so the caller can use up the token before
+// calling ResetPassword.
+func (s *UserService) CheckPasswordReset(ctx context.Context, reset *domain.PasswordResetTokenMetadata, newPassword string) (*domain.User, error) {
+	user, err := s.repo.GetByID(ctx, reset.UserID)
+	if err != nil {
+		s.log.Error("failed to get user for password reset", "user_id", reset.UserID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil || !strings.EqualFold(user.Email, reset.Email) {
+		s.log.Warn("password reset failed: account changed since the link was sent", "user_id", reset.UserID)
+		return nil, ErrInvalidResetToken
+	}
+
+	if err := s.policy.Validate(ctx, newPassword, user); err != nil {
+		s.log.Warn("password reset failed: password rejected by policy", "user_id", user.ID, "error", err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// ResetPassword sets newPassword for a user CheckPasswordReset returned. The
+// link was sent to the account's email, so using it also verifies that
+// address.
+func (s *UserService) ResetPassword(ctx context.Context, user *domain.User, newPassword string) error {
+	s.log.Info("resetting password", "user_id", user.ID)
+
+	hashed, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		s.log.Error("failed to hash password", "error", err)
+		return errors.New("failed to hash password")
+	}
+	if err := s.repo.UpdatePassword(ctx, user.ID, hashed, true); err != nil {
+		s.log.Error("failed to update password", "user_id", user.ID, "error", err)
+		return fmt.Errorf("database error: %v", err)
+	}
+
+	s.log.Info("password reset successfully", "user_id", user.ID)
+	return nil
+}
+
 func (s *UserService) MarkEmailVerified(ctx context.Context, email string) error {
 	s.log.Info("marking email as verified", "email", email)
 
@@ -166,3 +481,33 @@ func (s *UserService) Delete(ctx context.Context, id int64) error {
 	s.log.Info("user deleted successfully", "user_id", id)
 	return nil
 }
+
+// usersByID loads the users with ids in one query, keyed by ID. IDs without
+// a user are left out.
+func usersByID(ctx context.Context, repo domain.UserRepository, ids []int64) (map[int64]*domain.User, error) {
+	users, err := repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*domain.User, len(users))
+	for _, user := range users {
+		byID[user.ID] = user
+	}
+	return byID, nil
+}
+
+// takenError maps a unique violation on the users table to ErrEmailTaken or
+// ErrLoginTaken, and returns nil for any other error.
+func takenError(err error) error {
+	var unique *domain.UniqueViolationError
+	if !errors.As(err, &unique) {
+		return nil
+	}
+	switch unique.Constraint {
+	case "users_email_key":
+		return ErrEmailTaken
+	case "users_login_lower_key":
+		return ErrLoginTaken
+	}
+	return nil
+}
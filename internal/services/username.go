@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+var (
+	ErrLoginInvalid   = errors.New("login must be 3-20 letters or digits with at least one letter")
+	ErrLoginReserved  = errors.New("login is reserved")
+	ErrLoginTaken     = errors.New("login already taken")
+	ErrLoginUnchanged = errors.New("new login must differ from the current one")
+)
+
+// A login needs at least one letter so it can never be mistaken for a user
+// ID in /api/users/{id|login}.
+var (
+	loginPattern = regexp.MustCompile(`^[A-Za-z0-9]{3,20}$`)
+	loginLetter  = regexp.MustCompile(`[A-Za-z]`)
+)
+
+// reservedLogins cannot be registered regardless of configuration; some of
+// them would shadow routes such as /api/users/me.
+var reservedLogins = []string{
+	"admin", "administrator", "root", "system", "support", "help", "api",
+	"me", "moderator", "mod", "staff", "usof", "null", "undefined",
+	"settings", "login", "logout", "register", "auth", "user", "users",
+	"anonymous", "deleted",
+}
+
+// LoginCooldownError is returned when a login was changed too recently.
+type LoginCooldownError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginCooldownError) Error() string {
+	return fmt.Sprintf("login was changed recently, try again in %s", e.RetryAfter.Round(time.Hour))
+}
+
+// UsernameService owns the rules for logins: format, reserved names,
+// case-insensitive uniqueness and the redirect period for old logins.
+type UsernameService struct {
+	repo     domain.UserRepository
+	config   config.UsernameConfig
+	reserved map[string]struct{}
+	log      *logger.Logger
+}
+
+func NewUsernameService(repo domain.UserRepository, cfg config.UsernameConfig, log *logger.Logger) *UsernameService {
+	reserved := make(map[string]struct{}, len(reservedLogins)+len(cfg.Reserved))
+	for _, name := range append(reservedLogins, cfg.Reserved...) {
+		reserved[strings.ToLower(name)] = struct{}{}
+	}
+	return &UsernameService{
+		repo:     repo,
+		config:   cfg,
+		reserved: reserved,
+		log:      log,
+	}
+}
+
+// CheckAvailable reports whether userID may use login. Pass 0 for accounts
+// that do not exist yet. Logins given up by another user stay held for the
+// redirect period so their old profile links keep working.
+func (s *UsernameService) CheckAvailable(ctx context.Context, login string, userID int64) error {
+	if !loginPattern.MatchString(login) || !loginLetter.MatchString(login) {
+		return ErrLoginInvalid
+	}
+	if _, ok := s.reserved[strings.ToLower(login)]; ok {
+		return ErrLoginReserved
+	}
+
+	owner, err := s.repo.GetByLogin(ctx, login)
+	if err != nil {
+		return fmt.Errorf("database error: %v", err)
+	}
+	if owner != nil && owner.ID != userID {
+		return ErrLoginTaken
+	}
+
+	previous, err := s.repo.GetByPreviousLogin(ctx, login, s.redirectSince())
+	if err != nil {
+		return fmt.Errorf("database error: %v", err)
+	}
+	if previous != nil && previous.ID != userID {
+		return ErrLoginTaken
+	}
+	return nil
+}
+
+func (s *UsernameService) Change(ctx context.Context, userID int64, login string) (*domain.User, error) {
+	s.log.Info("changing login", "user_id", userID, "login", login)
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get user for login change", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+	if user.Login == login {
+		return nil, ErrLoginUnchanged
+	}
+
+	if !user.LoginChangedAt.IsZero() {
+		next := user.LoginChangedAt.Add(time.Duration(s.config.ChangeCooldown) * 24 * time.Hour)
+		if wait := time.Until(next); wait > 0 {
+			s.log.Warn("login change rejected: cooldown", "user_id", userID, "retry_after", wait)
+			return nil, &LoginCooldownError{RetryAfter: wait}
+		}
+	}
+
+	if err := s.CheckAvailable(ctx, login, userID); err != nil {
+		s.log.Warn("login change rejected", "user_id", userID, "login", login, "error", err)
+		return nil, err
+	}
+
+	if err := s.repo.ChangeLogin(ctx, userID, login); err != nil {
+		if errors.Is(err, domain.ErrUniqueViolation) {
+			s.log.Warn("login change rejected: login taken concurrently", "user_id", userID, "login", login)
+			return nil, ErrLoginTaken
+		}
+		s.log.Error("failed to change login", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+
+	s.log.Info("login changed successfully", "user_id", userID, "old_login", user.Login, "login", login)
+	user.Login = login
+	user.LoginChangedAt = time.Now()
+	return user, nil
+}
+
+// Resolve finds a user by login. When the login was given up within the
+// redirect period it returns the current owner and redirected=true.
+func (s *UsernameService) Resolve(ctx context.Context, login string) (user *domain.User, redirected bool, err error) {
+	user, err = s.repo.GetByLogin(ctx, login)
+	if err != nil {
+		return nil, false, fmt.Errorf("database error: %v", err)
+	}
+	if user != nil {
+		return user, false, nil
+	}
+
+	user, err = s.repo.GetByPreviousLogin(ctx, login, s.redirectSince())
+	if err != nil {
+		return nil, false, fmt.Errorf("database error: %v", err)
+	}
+	return user, user != nil, nil
+}
+
+// Generate derives a free login from seed (an email or display name) for
+// accounts created without one, e.g. through OAuth2.
+func (s *UsernameService) Generate(ctx context.Context, seed string) (string, error) {
+	local, _, _ := strings.Cut(seed, "@")
+	var b strings.Builder
+	for _, r := range local {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	base := b.String()
+	if len(base) > 14 {
+		base = base[:14]
+	}
+	if len(base) < 3 || !loginLetter.MatchString(base) {
+		base = "user" + base
+	}
+
+	candidate := base
+	for range 10 {
+		err := s.CheckAvailable(ctx, candidate, 0)
+		if err == nil {
+			return candidate, nil
+		}
+		if !errors.Is(err, ErrLoginTaken) && !errors.Is(err, ErrLoginReserved) {
+			return "", err
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+		if err != nil {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s%d", base, n.Int64())
+	}
+	return "", errors.New("failed to generate a free login")
+}
+
+func (s *UsernameService) redirectSince() time.Time {
+	return time.Now().Add(-time.Duration(s.config.RedirectPeriod) * 24 * time.Hour)
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/pkg/logger"
+)
+
+func TestCheckAvailableRejectsBeforeLookup(t *testing.T) {
+	// A nil repository panics if the check reaches the database.
+	s := NewUsernameService(nil, config.UsernameConfig{Reserved: []string{"Billing"}}, logger.New("error"))
+
+	for _, tc := range []struct {
+		login string
+		want  error
+	}{
+		{"ab", ErrLoginInvalid},
+		{"abcdefghijklmnopqrstu", ErrLoginInvalid},
+		{"john.doe", ErrLoginInvalid},
+		{"12345", ErrLoginInvalid},
+		{"Admin", ErrLoginReserved},
+		{"billing", ErrLoginReserved},
+	} {
+		if err := s.CheckAvailable(context.Background(), tc.login, 0); !errors.Is(err, tc.want) {
+			t.Errorf("CheckAvailable(%q) = %v, want %v", tc.login, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RofaBR/Go-Usof/internal/config"
+	"github.com/RofaBR/Go-Usof/internal/domain"
+)
+
+// New returns the storage driver selected by cfg.Driver.
+func New(ctx context.Context, cfg config.StorageConfig) (domain.ObjectStorage, error) {
+	switch cfg.Driver {
+	case "local":
+		return NewLocalStorage(cfg.LocalDir, cfg.PublicURL)
+	case "s3":
+		return NewS3Storage(ctx, cfg.S3, cfg.PublicURL)
+	case "cloudinary":
+		return NewCloudinaryStorage(cfg.CloudinaryURL)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
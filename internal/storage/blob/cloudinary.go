@@ -0,0 +1,64 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+// CloudinaryStorage keeps existing Cloudinary deployments working. Images are
+// processed before upload, so no Cloudinary transformations are applied.
+type CloudinaryStorage struct {
+	cld *cloudinary.Cloudinary
+}
+
+func NewCloudinaryStorage(url string) (*CloudinaryStorage, error) {
+	cld, err := cloudinary.NewFromURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cloudinary url: %w", err)
+	}
+	return &CloudinaryStorage{cld: cld}, nil
+}
+
+func (s *CloudinaryStorage) Put(ctx context.Context, key string, body io.Reader, _ int64, _ string) error {
+	_, err := s.cld.Upload.Upload(ctx, body, uploader.UploadParams{
+		PublicID:   publicID(key),
+		Overwrite:  api.Bool(true),
+		Invalidate: api.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+	return nil
+}
+
+func (s *CloudinaryStorage) Delete(ctx context.Context, key string) error {
+	_, err := s.cld.Upload.Destroy(ctx, uploader.DestroyParams{PublicID: publicID(key)})
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+func (s *CloudinaryStorage) URL(key string) string {
+	image, err := s.cld.Image(publicID(key))
+	if err != nil {
+		return ""
+	}
+	url, err := image.String()
+	if err != nil {
+		return ""
+	}
+	return url
+}
+
+// publicID drops the extension, Cloudinary derives the format itself.
+func publicID(key string) string {
+	return strings.TrimSuffix(key, path.Ext(key))
+}
@@ -0,0 +1,267 @@
+// Package diff compares texts line by line and renders the result as a
+// unified diff or as side-by-side rows.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Kind int
+
+const (
+	Equal Kind = iota
+	Delete
+	Insert
+)
+
+// maxCells bounds the LCS table. Texts whose differing middle is larger are
+// shown as fully replaced instead of aligned.
+const maxCells = 2_000_000
+
+// Line is one line of a diff. Old and New are its 1-based line numbers in
+// the old and new text, 0 where it does not appear.
+type Line struct {
+	Kind Kind
+	Text string
+	Old  int
+	New  int
+}
+
+// Lines returns the line-level edit script turning a into b.
+func Lines(a, b string) []Line {
+	oldLines, newLines := split(a), split(b)
+
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	result := make([]Line, 0, len(oldLines)+len(newLines))
+	for i := 0; i < prefix; i++ {
+		result = append(result, Line{Kind: Equal, Text: oldLines[i], Old: i + 1, New: i + 1})
+	}
+	result = append(result, middle(oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix], prefix, prefix)...)
+	for i := suffix; i > 0; i-- {
+		o, n := len(oldLines)-i, len(newLines)-i
+		result = append(result, Line{Kind: Equal, Text: oldLines[o], Old: o + 1, New: n + 1})
+	}
+	return result
+}
+
+// middle aligns the parts of both texts between their common prefix and
+// suffix using a longest common subsequence table.
+func middle(a, b []string, oldOffset, newOffset int) []Line {
+	if len(a)*len(b) > maxCells {
+		return replace(a, b, oldOffset, newOffset)
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	width := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			} else {
+				lcs[i*width+j] = max(lcs[(i+1)*width+j], lcs[i*width+j+1])
+			}
+		}
+	}
+
+	result := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			result = append(result, Line{Kind: Equal, Text: a[i], Old: oldOffset + i + 1, New: newOffset + j + 1})
+			i++
+			j++
+		case j == len(b) || i < len(a) && lcs[(i+1)*width+j] >= lcs[i*width+j+1]:
+			result = append(result, Line{Kind: Delete, Text: a[i], Old: oldOffset + i + 1})
+			i++
+		default:
+			result = append(result, Line{Kind: Insert, Text: b[j], New: newOffset + j + 1})
+			j++
+		}
+	}
+	return result
+}
+
+func replace(a, b []string, oldOffset, newOffset int) []Line {
+	result := make([]Line, 0, len(a)+len(b))
+	for i, text := range a {
+		result = append(result, Line{Kind: Delete, Text: text, Old: oldOffset + i + 1})
+	}
+	for j, text := range b {
+		result = append(result, Line{Kind: Insert, Text: text, New: newOffset + j + 1})
+	}
+	return result
+}
+
+// Changed reports whether lines contain any insertion or deletion.
+func Changed(lines []Line) bool {
+	for _, l := range lines {
+		if l.Kind != Equal {
+			return true
+		}
+	}
+	return false
+}
+
+// Unified renders lines in unified diff format with the given number of
+// context lines around each change.
+func Unified(oldName, newName string, lines []Line, context int) string {
+	if !Changed(lines) {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+
+	for start := 0; start < len(lines); {
+		// Find the next change and extend the hunk while further changes
+		// are close enough for their contexts to touch.
+		first := start
+		for first < len(lines) && lines[first].Kind == Equal {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+		last := first
+		for next := last + 1; next < len(lines); next++ {
+			if lines[next].Kind == Equal {
+				continue
+			}
+			if next-last-1 > 2*context {
+				break
+			}
+			last = next
+		}
+
+		from := max(first-context, start)
+		to := min(last+context+1, len(lines))
+		writeHunk(&sb, lines, from, to)
+		start = to
+	}
+	return sb.String()
+}
+
+func writeHunk(sb *strings.Builder, lines []Line, from, to int) {
+	var oldStart, oldCount, newStart, newCount int
+	for _, l := range lines[from:to] {
+		if l.Kind != Insert {
+			if oldCount == 0 {
+				oldStart = l.Old
+			}
+			oldCount++
+		}
+		if l.Kind != Delete {
+			if newCount == 0 {
+				newStart = l.New
+			}
+			newCount++
+		}
+	}
+	// An empty range is given as the line before it.
+	if oldCount == 0 {
+		oldStart = lineBefore(lines, from, func(l Line) int { return l.Old })
+	}
+	if newCount == 0 {
+		newStart = lineBefore(lines, from, func(l Line) int { return l.New })
+	}
+
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+	for _, l := range lines[from:to] {
+		switch l.Kind {
+		case Equal:
+			sb.WriteString(" ")
+		case Delete:
+			sb.WriteString("-")
+		case Insert:
+			sb.WriteString("+")
+		}
+		sb.WriteString(l.Text)
+		sb.WriteString("\n")
+	}
+}
+
+func lineBefore(lines []Line, index int, number func(Line) int) int {
+	for i := index - 1; i >= 0; i-- {
+		if n := number(lines[i]); n != 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+func hunkRange(start, count int) string {
+	if count == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// Row is one row of a side-by-side diff. Kind is "equal", "delete",
+// "insert" or "change"; line numbers are 0 on the side a row is empty.
+type Row struct {
+	Kind    string `json:"kind"`
+	OldLine int    `json:"old_line,omitempty"`
+	OldText string `json:"old_text"`
+	NewLine int    `json:"new_line,omitempty"`
+	NewText string `json:"new_text"`
+}
+
+// SideBySide pairs deleted lines with the inserted lines that replace them.
+func SideBySide(lines []Line) []Row {
+	rows := make([]Row, 0, len(lines))
+	for i := 0; i < len(lines); {
+		if lines[i].Kind == Equal {
+			l := lines[i]
+			rows = append(rows, Row{Kind: "equal", OldLine: l.Old, OldText: l.Text, NewLine: l.New, NewText: l.Text})
+			i++
+			continue
+		}
+
+		var deleted, inserted []Line
+		for ; i < len(lines) && lines[i].Kind != Equal; i++ {
+			if lines[i].Kind == Delete {
+				deleted = append(deleted, lines[i])
+			} else {
+				inserted = append(inserted, lines[i])
+			}
+		}
+		for k := 0; k < max(len(deleted), len(inserted)); k++ {
+			var row Row
+			if k < len(deleted) {
+				row.OldLine, row.OldText = deleted[k].Old, deleted[k].Text
+			}
+			if k < len(inserted) {
+				row.NewLine, row.NewText = inserted[k].New, inserted[k].Text
+			}
+			switch {
+			case k >= len(inserted):
+				row.Kind = "delete"
+			case k >= len(deleted):
+				row.Kind = "insert"
+			default:
+				row.Kind = "change"
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func split(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,113 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	got := Lines("a\nb\nc\n", "a\nx\nc\nd\n")
+	want := []Line{
+		{Kind: Equal, Text: "a", Old: 1, New: 1},
+		{Kind: Delete, Text: "b", Old: 2},
+		{Kind: Insert, Text: "x", New: 2},
+		{Kind: Equal, Text: "c", Old: 3, New: 3},
+		{Kind: Insert, Text: "d", New: 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines:\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestLinesEmpty(t *testing.T) {
+	if got := Lines("", ""); len(got) != 0 {
+		t.Errorf("Lines of empty texts = %+v, want none", got)
+	}
+
+	got := Lines("", "a\nb")
+	want := []Line{
+		{Kind: Insert, Text: "a", New: 1},
+		{Kind: Insert, Text: "b", New: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines from empty:\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	if Changed(Lines("a\nb", "a\nb\n")) {
+		t.Error("a trailing newline counts as a change")
+	}
+	if !Changed(Lines("a", "b")) {
+		t.Error("different texts reported unchanged")
+	}
+}
+
+func TestUnified(t *testing.T) {
+	old := "1\n2\n3\n4\n5\n6\n7\n8\n9\n"
+	new := "1\ntwo\n3\n4\n5\n6\n7\n8\nnine\n"
+
+	got := Unified("a", "b", Lines(old, new), 1)
+	want := "--- a\n+++ b\n" +
+		"@@ -1,3 +1,3 @@\n 1\n-2\n+two\n 3\n" +
+		"@@ -8,2 +8,2 @@\n 8\n-9\n+nine\n"
+	if got != want {
+		t.Errorf("Unified:\n%s\nwant:\n%s", got, want)
+	}
+
+	// With enough context both changes share one hunk.
+	merged := Unified("a", "b", Lines(old, new), 3)
+	if want := "--- a\n+++ b\n@@ -1,9 +1,9 @@\n"; merged[:len(want)] != want {
+		t.Errorf("Unified with context 3 starts with %q, want %q", merged, want)
+	}
+
+	if got := Unified("a", "b", Lines(old, old), 3); got != "" {
+		t.Errorf("Unified of equal texts = %q, want empty", got)
+	}
+}
+
+func TestUnifiedPureInsertion(t *testing.T) {
+	got := Unified("a", "b", Lines("1\n2\n", "1\nnew\n2\n"), 0)
+	want := "--- a\n+++ b\n@@ -1,0 +2 @@\n+new\n"
+	if got != want {
+		t.Errorf("Unified:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestSideBySide(t *testing.T) {
+	got := SideBySide(Lines("a\nb\nc\n", "a\nx\ny\n"))
+	want := []Row{
+		{Kind: "equal", OldLine: 1, OldText: "a", NewLine: 1, NewText: "a"},
+		{Kind: "change", OldLine: 2, OldText: "b", NewLine: 2, NewText: "x"},
+		{Kind: "change", OldLine: 3, OldText: "c", NewLine: 3, NewText: "y"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SideBySide:\ngot  %+v\nwant %+v", got, want)
+	}
+
+	got = SideBySide(Lines("a\nb\n", "a\n"))
+	want = []Row{
+		{Kind: "equal", OldLine: 1, OldText: "a", NewLine: 1, NewText: "a"},
+		{Kind: "delete", OldLine: 2, OldText: "b"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SideBySide deletion:\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestLinesFallsBackToReplaceForHugeInputs(t *testing.T) {
+	a := make([]byte, 0, 4*3000)
+	b := make([]byte, 0, 4*3000)
+	for i := range 3000 {
+		a = append(a, byte('a'+i%26), '\n')
+		b = append(b, byte('A'+i%26), '\n')
+	}
+
+	lines := Lines(string(a), string(b))
+	if len(lines) != 6000 {
+		t.Fatalf("got %d lines, want 6000", len(lines))
+	}
+	if lines[0].Kind != Delete || lines[2999].Kind != Delete || lines[3000].Kind != Insert {
+		t.Error("huge inputs are not shown as a full replacement")
+	}
+}